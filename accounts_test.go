@@ -0,0 +1,90 @@
+package eff
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAccountsPartialFailure(t *testing.T) {
+	errConflict := errors.New("code already exists")
+	var mu sync.Mutex
+	var inputs []AccountInput
+	stub := clientFunc(func(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+		in := req.Variables.(*__CreateAccountInput).Input
+		mu.Lock()
+		inputs = append(inputs, in)
+		mu.Unlock()
+		if in.Code == "GL.0003" {
+			return errConflict
+		}
+		resp.Data.(*CreateAccountResponse).CreateAccount = CreateAccountCreateAccount{AccountId: in.AccountId, Code: in.Code}
+		return nil
+	})
+
+	fixed := uuid.New()
+	specs := []AccountSpec{{AccountID: fixed, Code: "GL.0000", Name: "Cash", NormalBalanceType: DebitOrCreditDebit, Description: "cash on hand"}}
+	for i := 1; i < 10; i++ {
+		specs = append(specs, AccountSpec{Code: fmt.Sprintf("GL.%04d", i)})
+	}
+	ids, err := CreateAccounts(context.Background(), stub, specs)
+
+	var failure *CreateAccountsError
+	require.ErrorAs(t, err, &failure)
+	require.Equal(t, map[string]error{"GL.0003": errConflict}, failure.Failed)
+	require.ErrorIs(t, err, errConflict)
+	require.EqualError(t, err, "creating accounts: 1 failed: account GL.0003: code already exists")
+	require.Len(t, ids, 9)
+	require.Equal(t, fixed, ids["GL.0000"])
+	require.NotContains(t, ids, "GL.0003")
+
+	require.Len(t, inputs, 10)
+	for _, in := range inputs {
+		require.NotEqual(t, uuid.Nil, in.AccountId)
+		require.Equal(t, StatusActive, in.Status)
+		if in.Code == "GL.0000" {
+			require.Equal(t, "Cash", in.Name)
+			require.Equal(t, DebitOrCreditDebit, in.NormalBalanceType)
+			require.Equal(t, Ptr("cash on hand"), in.Description)
+			continue
+		}
+		require.Equal(t, in.Code, in.Name)
+		require.Equal(t, DebitOrCreditCredit, in.NormalBalanceType)
+		require.Nil(t, in.Description)
+	}
+
+	_, err = CreateAccounts(context.Background(), stub, []AccountSpec{{Code: "A"}, {Code: "A"}})
+	require.EqualError(t, err, "creating accounts: duplicate code A")
+	_, err = CreateAccounts(context.Background(), stub, []AccountSpec{{Name: "no code"}})
+	require.EqualError(t, err, "creating accounts: spec 0 has no code")
+	require.Len(t, inputs, 10, "invalid specs must not be sent")
+}
+
+func TestCreateAccounts(t *testing.T) {
+	ctx, client := newLiveClient(t)
+
+	var specs []AccountSpec
+	for i := range 10 {
+		specs = append(specs, AccountSpec{Code: fmt.Sprintf("GL.%04d", i), Metadata: map[string]any{"index": i}})
+	}
+	ids, err := CreateAccounts(ctx, client, specs)
+	require.NoError(t, err)
+	require.Len(t, ids, 10)
+	for _, spec := range specs {
+		require.NotEqual(t, uuid.Nil, ids[spec.Code], spec.Code)
+	}
+
+	// GL.0000 exists now; the new code is still created.
+	ids, err = CreateAccounts(ctx, client, []AccountSpec{{Code: "GL.0000"}, {Code: "GL.0010"}})
+	var failure *CreateAccountsError
+	require.ErrorAs(t, err, &failure)
+	require.Contains(t, failure.Failed, "GL.0000")
+	require.Len(t, failure.Failed, 1)
+	require.Contains(t, ids, "GL.0010")
+}
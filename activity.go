@@ -0,0 +1,320 @@
+package eff
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// DefaultActivityIndex is the name CreateActivityIndex gives the activity
+// index, and the index ActivityForPeriod and ActivityQueryMulti read.
+const DefaultActivityIndex = "activity"
+
+// ActivityIndex is the index metadata CreateActivityIndex returns: its
+// Name, DefaultActivityIndex, and On, IndexOnEnumEntry. Store it on a Client
+// with WithActivityIndex.
+type ActivityIndex = CreateActivityIndexSchemaSchemaMutationCreateIndex
+
+// ActivityForPeriod runs ActivityQuery for the settled entries of accountID
+// in the month period covers. period must be a whole month as returned by
+// MonthPeriod, since the activity index is partitioned by month.
+func ActivityForPeriod(ctx context.Context, client graphql.Client, journalID, accountID uuid.UUID, period DateRange) (*ActivityQueryResponse, error) {
+	return activityForPeriod(ctx, client, DefaultActivityIndex, journalID, accountID, period)
+}
+
+func activityForPeriod(ctx context.Context, client graphql.Client, index string, journalID, accountID uuid.UUID, period DateRange) (*ActivityQueryResponse, error) {
+	if month, err := MonthPeriod(period.Month()); err != nil || month != period {
+		return nil, fmt.Errorf("activity for %s: not a calendar month", period)
+	}
+	journal, account, month := journalID.String(), accountID.String(), period.Month()
+	if index == DefaultActivityIndex {
+		return ActivityQuery(ctx, client, &journal, &account, &month)
+	}
+	return activityQueryOn(ctx, client, index, &journal, &account, &month)
+}
+
+// activityQueryOn is ActivityQuery on the named index: it sends
+// ActivityIndexQuery for the same first page of 100 entries and decodes
+// the result as an ActivityQueryResponse, whose nodes select a subset of
+// ActivityIndexQuery's.
+func activityQueryOn(ctx context.Context, client graphql.Client, index string, journalID, accountID, period *string) (*ActivityQueryResponse, error) {
+	req := &graphql.Request{
+		OpName: "ActivityIndexQuery",
+		Query:  ActivityIndexQuery_Operation,
+		Variables: &__ActivityIndexQueryInput{
+			Index:     index,
+			JournalId: journalID,
+			AccountId: accountID,
+			Period:    period,
+			First:     100,
+		},
+	}
+	data := &ActivityQueryResponse{}
+	if err := client.MakeRequest(ctx, req, &graphql.Response{Data: data}); err != nil {
+		return data, err
+	}
+	return data, nil
+}
+
+// CountEntries returns how many settled entries of accountID in journalID
+// the activity index holds for the month period covers; like
+// ActivityForPeriod, period must be a whole month. Twisp connections have no
+// total count, so it pages through the index selecting only entry IDs,
+// which is much lighter than ActivityQuery's node bodies; the index's
+// order makes those pages disjoint (see CreateActivityIndex).
+func CountEntries(ctx context.Context, client graphql.Client, journalID, accountID uuid.UUID, period DateRange) (int, error) {
+	if month, err := MonthPeriod(period.Month()); err != nil || month != period {
+		return 0, fmt.Errorf("counting entries for %s: not a calendar month", period)
+	}
+	var n int
+	var after *string
+	for {
+		resp, err := CountActivityEntries(ctx, client, DefaultActivityIndex, journalID.String(), accountID.String(), period.Month(), listPageSize, after)
+		if err != nil {
+			return 0, fmt.Errorf("counting entries for %s: %w", period, err)
+		}
+		page := resp.Entries
+		n += len(page.Nodes)
+		if !page.PageInfo.HasNextPage || page.PageInfo.EndCursor == nil || len(page.Nodes) == 0 {
+			return n, nil
+		}
+		after = page.PageInfo.EndCursor
+	}
+}
+
+// ActivityEntry is one node of an ActivityQueryMulti result. Unlike
+// ActivityQuery nodes it carries its entry and journal IDs.
+type ActivityEntry = ActivityIndexQueryEntriesEntryConnectionNodesEntry
+
+// ActivityQueryMulti runs the activity query for accountID and month across
+// every journal in journalIDs and merges the results, journal by journal in
+// the order given. Each node keeps its JournalId; an entry returned more
+// than once, e.g. because a journal is listed twice, appears only once.
+//
+// Twisp's FilterValue has no "in" operator: schema.graphql lists it under
+// "Not yet implemented". So this pages through the index once per distinct
+// journal instead of matching every journal in one query.
+func ActivityQueryMulti(ctx context.Context, client graphql.Client, journalIDs []string, accountID *string, month *string) ([]*ActivityEntry, error) {
+	return activityQueryMulti(ctx, client, DefaultActivityIndex, journalIDs, accountID, month)
+}
+
+func activityQueryMulti(ctx context.Context, client graphql.Client, index string, journalIDs []string, accountID *string, month *string) ([]*ActivityEntry, error) {
+	if len(journalIDs) == 0 {
+		return nil, errors.New("activity query: no journals")
+	}
+	var nodes []*ActivityEntry
+	queried := make(map[string]bool, len(journalIDs))
+	seen := make(map[uuid.UUID]bool)
+	for _, id := range journalIDs {
+		if queried[id] {
+			continue
+		}
+		queried[id] = true
+		var after *string
+		for {
+			resp, err := ActivityIndexQuery(ctx, client, index, &id, accountID, month, 100, after)
+			if err != nil {
+				return nil, fmt.Errorf("querying activity in journal %s: %w", id, err)
+			}
+			page := resp.Entries
+			for _, n := range page.Nodes {
+				if n == nil || seen[n.EntryId] {
+					continue
+				}
+				seen[n.EntryId] = true
+				nodes = append(nodes, n)
+			}
+			if !page.PageInfo.HasNextPage || page.PageInfo.EndCursor == nil || len(page.Nodes) == 0 {
+				break
+			}
+			after = page.PageInfo.EndCursor
+		}
+	}
+	return nodes, nil
+}
+
+// DiffActivity compares two activity responses, ignoring the order of
+// connection nodes, and returns a unified diff of their normalized JSON, or
+// "" if they are equal. Nodes are sorted by their JSON encoding, the same
+// normalization RequireActivityGolden applies.
+func DiffActivity(want, got *ActivityQueryResponse) string {
+	w, err := normalizeNodes(want)
+	if err != nil {
+		return fmt.Sprintf("normalizing want: %v", err)
+	}
+	g, err := normalizeNodes(got)
+	if err != nil {
+		return fmt.Sprintf("normalizing got: %v", err)
+	}
+	if string(w) == string(g) {
+		return ""
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(w) + "\n"),
+		B:        difflib.SplitLines(string(g) + "\n"),
+		FromFile: "want",
+		ToFile:   "got",
+		Context:  3,
+	})
+	if err != nil {
+		return fmt.Sprintf("diffing activity: %v", err)
+	}
+	return diff
+}
+
+// SortEntriesByEffective sorts activity nodes in place by their "effective"
+// metadata date, breaking ties on "statementDate". Nodes whose dates are
+// missing or malformed sort after all dated nodes; the sort is stable, so
+// otherwise-equal nodes keep their relative order.
+func SortEntriesByEffective(nodes []*ActivityQueryEntriesEntryConnectionNodesEntry) {
+	slices.SortStableFunc(nodes, func(a, b *ActivityQueryEntriesEntryConnectionNodesEntry) int {
+		if c := compareMetaDate(a, b, "effective"); c != 0 {
+			return c
+		}
+		return compareMetaDate(a, b, "statementDate")
+	})
+}
+
+// compareMetaDate orders two nodes by the date stored under key, placing
+// nodes without a valid date last.
+func compareMetaDate(a, b *ActivityQueryEntriesEntryConnectionNodesEntry, key string) int {
+	at, aok := metaDate(a, key)
+	bt, bok := metaDate(b, key)
+	switch {
+	case aok && bok:
+		return at.Compare(bt)
+	case aok:
+		return -1
+	case bok:
+		return 1
+	}
+	return 0
+}
+
+func metaDate(n *ActivityQueryEntriesEntryConnectionNodesEntry, key string) (time.Time, bool) {
+	if n == nil || n.Metadata == nil {
+		return time.Time{}, false
+	}
+	s, ok := (*n.Metadata)[key].(string)
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := time.Parse("2006-01-02", s)
+	return t, err == nil
+}
+
+// AccountCodes returns the account codes of every leg of n's transaction,
+// in the order Twisp lists them, e.g. ["ERNIE.CHECKING", "BERT.CHECKING"]
+// for a transfer between the Setup accounts. A nil node or missing legs
+// yield nil.
+func (n *ActivityQueryEntriesEntryConnectionNodesEntry) AccountCodes() []string {
+	if n == nil {
+		return nil
+	}
+	var codes []string
+	for _, leg := range n.Transaction.Entries.Nodes {
+		if leg != nil {
+			codes = append(codes, leg.Account.Code)
+		}
+	}
+	return codes
+}
+
+// ActivityMeta is activity entry metadata with its well-known dates
+// parsed. A date missing from the metadata is left zero.
+type ActivityMeta struct {
+	Effective     Date
+	StatementDate Date
+	// Extra holds every other key, unchanged.
+	Extra map[string]any
+}
+
+// DecodeActivityMetadata parses the "effective" and "statementDate" keys of
+// an activity node's metadata into Dates and keeps the remaining keys in
+// Extra. A date key holding anything but a "YYYY-MM-DD" string is an error.
+func DecodeActivityMetadata(m map[string]any) (ActivityMeta, error) {
+	meta := ActivityMeta{Extra: map[string]any{}}
+	for key, v := range m {
+		var dst *Date
+		switch key {
+		case "effective":
+			dst = &meta.Effective
+		case "statementDate":
+			dst = &meta.StatementDate
+		default:
+			meta.Extra[key] = v
+			continue
+		}
+		s, ok := v.(string)
+		if !ok {
+			return ActivityMeta{}, fmt.Errorf("decoding activity metadata: %s is %T, not a date string", key, v)
+		}
+		t, err := time.Parse(time.DateOnly, s)
+		if err != nil {
+			return ActivityMeta{}, fmt.Errorf("decoding activity metadata: %s: %w", key, err)
+		}
+		*dst = Date{t}
+	}
+	return meta, nil
+}
+
+// ValidateActivityMetadata checks metadata bound for an activity entry
+// before it is posted: "effective" and "statementDate" must both be present
+// as "YYYY-MM-DD" strings, and every other value must be a JSON value
+// (nil, a string, a bool, a number, or a []any or map[string]any of them).
+// Fixtures posting malformed metadata otherwise only fail once the activity
+// index files the entry under the wrong month, or not at all.
+func ValidateActivityMetadata(m map[string]any) error {
+	for _, key := range []string{"effective", "statementDate"} {
+		v, ok := m[key]
+		if !ok {
+			return fmt.Errorf("validating activity metadata: %s is missing", key)
+		}
+		s, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("validating activity metadata: %s is %T, not a date string", key, v)
+		}
+		if _, err := time.Parse(time.DateOnly, s); err != nil {
+			return fmt.Errorf("validating activity metadata: %s: %w", key, err)
+		}
+	}
+	for key, v := range m {
+		if err := validateMetadataValue(key, v); err != nil {
+			return fmt.Errorf("validating activity metadata: %w", err)
+		}
+	}
+	return nil
+}
+
+// validateMetadataValue reports an error if v, found at path, isn't a JSON
+// value.
+func validateMetadataValue(path string, v any) error {
+	switch v := v.(type) {
+	case nil, string, bool, json.Number,
+		int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return nil
+	case []any:
+		for i, e := range v {
+			if err := validateMetadataValue(fmt.Sprintf("%s[%d]", path, i), e); err != nil {
+				return err
+			}
+		}
+		return nil
+	case map[string]any:
+		for k, e := range v {
+			if err := validateMetadataValue(path+"."+k, e); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("%s is %T, not a JSON value", path, v)
+	}
+}
@@ -0,0 +1,390 @@
+package eff
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+
+	"github.com/stretchr/testify/require"
+)
+
+func activityNode(meta map[string]any, units string) *ActivityQueryEntriesEntryConnectionNodesEntry {
+	n := &ActivityQueryEntriesEntryConnectionNodesEntry{
+		Amount: ActivityQueryEntriesEntryConnectionNodesEntryAmountMoney{Units: Decimal(units)},
+	}
+	if meta != nil {
+		n.Metadata = &meta
+	}
+	return n
+}
+
+func TestAccountCodes(t *testing.T) {
+	var n ActivityQueryEntriesEntryConnectionNodesEntry
+	require.NoError(t, json.Unmarshal([]byte(`{"amount": {"units": "1.00"}, "transaction": {"entries": {"nodes": [
+		{"account": {"code": "ERNIE.CHECKING"}},
+		{"account": {"code": "BERT.CHECKING"}}
+	]}}}`), &n))
+	require.Equal(t, []string{"ERNIE.CHECKING", "BERT.CHECKING"}, n.AccountCodes())
+
+	require.Nil(t, (*ActivityQueryEntriesEntryConnectionNodesEntry)(nil).AccountCodes())
+	require.Nil(t, activityNode(nil, "1.00").AccountCodes())
+	n.Transaction.Entries.Nodes[0] = nil
+	require.Equal(t, []string{"BERT.CHECKING"}, n.AccountCodes())
+}
+
+func TestSortEntriesByEffective(t *testing.T) {
+	want := []*ActivityQueryEntriesEntryConnectionNodesEntry{
+		activityNode(map[string]any{"effective": "2026-01-01", "statementDate": "2026-01-01"}, "1.00"),
+		activityNode(map[string]any{"effective": "2026-01-24", "statementDate": "2026-01-24"}, "2.00"),
+		activityNode(map[string]any{"effective": "2026-01-24", "statementDate": "2026-02-15"}, "5.00"),
+		activityNode(map[string]any{"effective": "2026-01-24"}, "3.00"),
+		activityNode(map[string]any{"effective": "2026-02-15", "statementDate": "2026-02-15"}, "1.00"),
+		activityNode(map[string]any{"effective": "not-a-date"}, "7.00"),
+		activityNode(map[string]any{"effective": 20260101}, "8.00"),
+		activityNode(nil, "9.00"),
+		nil,
+	}
+
+	rng := rand.New(rand.NewPCG(3, 4))
+	for range 20 {
+		got := append([]*ActivityQueryEntriesEntryConnectionNodesEntry(nil), want...)
+		rng.Shuffle(len(got), func(i, j int) { got[i], got[j] = got[j], got[i] })
+		SortEntriesByEffective(got)
+		require.Equal(t, want[:5], got[:5])
+		require.ElementsMatch(t, want[5:], got[5:], "undated nodes sort last")
+	}
+
+	// Undated nodes keep their input order.
+	undated := append([]*ActivityQueryEntriesEntryConnectionNodesEntry(nil), want[5:]...)
+	SortEntriesByEffective(undated)
+	require.Equal(t, want[5:], undated)
+}
+
+func TestDiffActivity(t *testing.T) {
+	a := activityNode(map[string]any{"effective": "2026-01-24", "statementDate": "2026-01-24"}, "1.00")
+	b := activityNode(map[string]any{"effective": "2026-01-24", "statementDate": "2026-01-24"}, "2.00")
+	c := activityNode(map[string]any{"effective": "2026-01-01", "statementDate": "2026-01-01"}, "1.00")
+	resp := func(nodes ...*ActivityQueryEntriesEntryConnectionNodesEntry) *ActivityQueryResponse {
+		return &ActivityQueryResponse{Entries: ActivityQueryEntriesEntryConnection{Nodes: nodes}}
+	}
+
+	require.Empty(t, DiffActivity(resp(a, b, c), resp(c, b, a)))
+	require.Empty(t, DiffActivity(resp(), resp()))
+
+	diff := DiffActivity(resp(a, b), resp(b, c))
+	require.Contains(t, diff, "--- want")
+	require.Contains(t, diff, "+++ got")
+	require.Contains(t, diff, `-          "effective": "2026-01-24",`)
+	require.Contains(t, diff, `+          "effective": "2026-01-01",`)
+}
+
+func TestActivityForPeriod(t *testing.T) {
+	var period *string
+	stub := clientFunc(func(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+		period = req.Variables.(*__ActivityQueryInput).Period
+		return nil
+	})
+
+	jan, err := MonthPeriod("2026-01")
+	require.NoError(t, err)
+	_, err = ActivityForPeriod(context.Background(), stub, journalID, account1ID, jan)
+	require.NoError(t, err)
+	require.Equal(t, "2026-01", *period)
+
+	partial := DateRange{Start: NewDate(2026, time.January, 1), End: NewDate(2026, time.January, 15)}
+	_, err = ActivityForPeriod(context.Background(), stub, journalID, account1ID, partial)
+	require.Error(t, err)
+
+	_, err = NewClient(stub, journalID).ActivityQuery(context.Background(), account1ID, "2026-1")
+	require.Error(t, err)
+}
+
+func TestCreateActivityIndex(t *testing.T) {
+	var ops, indexes []string
+	stub := clientFunc(func(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+		ops = append(ops, req.OpName)
+		switch v := req.Variables.(type) {
+		case *__ActivityQueryInput:
+			indexes = append(indexes, DefaultActivityIndex)
+			return nil
+		case *__ActivityIndexQueryInput:
+			indexes = append(indexes, v.Index)
+			return json.Unmarshal([]byte(`{"entries": {"nodes": [{"entryId": "`+uuid.NewString()+`", "amount": {"units": "1.00"}}]}}`), resp.Data)
+		default:
+			require.Equal(t, "CreateActivityIndex", req.OpName)
+			require.Contains(t, req.Query, `{alias:"entryId",value:"document.entry_id",sort:DESC}`, "entries need a unique sort")
+			return json.Unmarshal([]byte(`{"schema": {"createIndex": {"name": "activity_v2", "on": "Entry"}}}`), resp.Data)
+		}
+	})
+
+	resp, err := CreateActivityIndex(context.Background(), stub)
+	require.NoError(t, err)
+	idx := resp.Schema.CreateIndex
+	require.Equal(t, ActivityIndex{Name: "activity_v2", On: IndexOnEnumEntry}, idx)
+
+	c := NewClient(stub, journalID)
+	_, err = c.ActivityQuery(context.Background(), account1ID, "2026-01")
+	require.NoError(t, err)
+
+	got, err := c.WithActivityIndex(idx).ActivityQuery(context.Background(), account1ID, "2026-01")
+	require.NoError(t, err)
+	require.Len(t, got.Entries.Nodes, 1)
+	require.Equal(t, Decimal("1.00"), got.Entries.Nodes[0].Amount.Units)
+
+	_, err = c.WithActivityIndex(idx).ActivityQueryMulti(context.Background(), []string{journalID.String()}, nil, Ptr("2026-01"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"CreateActivityIndex", "ActivityQuery", "ActivityIndexQuery", "ActivityIndexQuery"}, ops)
+	require.Equal(t, []string{DefaultActivityIndex, "activity_v2", "activity_v2"}, indexes)
+}
+
+func TestCountEntriesPaging(t *testing.T) {
+	var afters []*string
+	stub := clientFunc(func(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+		require.NotContains(t, req.Query, "metadata", "only entry IDs should be selected")
+		in := req.Variables.(*__CountActivityEntriesInput)
+		require.Equal(t, "2026-01", in.Period)
+		afters = append(afters, in.After)
+		if in.After == nil {
+			return json.Unmarshal([]byte(`{"entries": {
+				"nodes": [{"entryId": "`+uuid.NewString()+`"}, {"entryId": "`+uuid.NewString()+`"}],
+				"pageInfo": {"hasNextPage": true, "endCursor": "c1"}
+			}}`), resp.Data)
+		}
+		return json.Unmarshal([]byte(`{"entries": {
+			"nodes": [{"entryId": "`+uuid.NewString()+`"}],
+			"pageInfo": {"hasNextPage": false, "endCursor": "c2"}
+		}}`), resp.Data)
+	})
+
+	jan, err := MonthPeriod("2026-01")
+	require.NoError(t, err)
+	n, err := CountEntries(context.Background(), stub, journalID, account1ID, jan)
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+	require.Equal(t, []*string{nil, Ptr("c1")}, afters)
+
+	partial := DateRange{Start: NewDate(2026, time.January, 1), End: NewDate(2026, time.January, 15)}
+	_, err = CountEntries(context.Background(), stub, journalID, account1ID, partial)
+	require.Error(t, err)
+}
+
+func TestCountEntries(t *testing.T) {
+	ctx, client := newLiveClient(t)
+
+	_, err := CreateActivityIndex(ctx, client)
+	require.NoError(t, err)
+	_, err = Setup(ctx, client, journalID, tranCodeID, account1ID, account2ID)
+	require.NoError(t, err)
+
+	for _, day := range []int{5, 12, 28} {
+		_, err = Transfer(ctx, client, journalID, account2ID, account1ID, "1.00", NewDate(2026, time.January, day))
+		require.NoError(t, err)
+	}
+	_, err = Transfer(ctx, client, journalID, account2ID, account1ID, "1.00", NewDate(2026, time.February, 2))
+	require.NoError(t, err)
+
+	jan, err := MonthPeriod("2026-01")
+	require.NoError(t, err)
+	n, err := CountEntries(ctx, client, journalID, account1ID, jan)
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+
+	feb, err := MonthPeriod("2026-02")
+	require.NoError(t, err)
+	n, err = CountEntries(ctx, client, journalID, account1ID, feb)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+}
+
+func TestActivityPagingStable(t *testing.T) {
+	ctx, client := newLiveClient(t)
+
+	_, err := CreateActivityIndex(ctx, client)
+	require.NoError(t, err)
+	_, err = Setup(ctx, client, journalID, tranCodeID, account1ID, account2ID)
+	require.NoError(t, err)
+
+	// Transfers from account1 to itself put both legs, which share a
+	// created time, in account1's partition, all on one effective date.
+	reqs := make([]TransferReq, 7)
+	for i := range reqs {
+		reqs[i] = TransferReq{From: account1ID, To: account1ID, Amount: "1.00", Effective: NewDate(2026, time.January, 15)}
+	}
+	require.NoError(t, BulkPostTransactions(ctx, client, journalID, reqs, BulkOptions{}).Err())
+
+	page := func(first int, after *string) *CountActivityEntriesResponse {
+		resp, err := CountActivityEntries(ctx, client, DefaultActivityIndex, journalID.String(), account1ID.String(), "2026-01", first, after)
+		require.NoError(t, err)
+		return resp
+	}
+	var want []uuid.UUID
+	for _, n := range page(listPageSize, nil).Entries.Nodes {
+		want = append(want, n.EntryId)
+	}
+	require.Len(t, want, 2*len(reqs))
+
+	// An odd page size splits the tied pairs across page boundaries.
+	var got []uuid.UUID
+	var after *string
+	for {
+		resp := page(3, after)
+		for _, n := range resp.Entries.Nodes {
+			got = append(got, n.EntryId)
+		}
+		info := resp.Entries.PageInfo
+		if !info.HasNextPage || info.EndCursor == nil {
+			break
+		}
+		after = info.EndCursor
+	}
+	require.Equal(t, want, got, "paging must neither repeat nor skip entries")
+}
+
+func TestDecodeActivityMetadata(t *testing.T) {
+	// The backdated adjustment: effective in January, on February's statement.
+	meta, err := DecodeActivityMetadata(map[string]any{
+		"effective":     "2026-01-24",
+		"statementDate": "2026-02-15",
+		"ref":           "ADJ-1",
+		"attempt":       float64(2),
+	})
+	require.NoError(t, err)
+	require.Equal(t, ActivityMeta{
+		Effective:     NewDate(2026, time.January, 24),
+		StatementDate: NewDate(2026, time.February, 15),
+		Extra:         map[string]any{"ref": "ADJ-1", "attempt": float64(2)},
+	}, meta)
+	require.True(t, meta.Effective.Before(meta.StatementDate.Time))
+
+	meta, err = DecodeActivityMetadata(nil)
+	require.NoError(t, err)
+	require.True(t, meta.Effective.IsZero())
+	require.Empty(t, meta.Extra)
+
+	_, err = DecodeActivityMetadata(map[string]any{"effective": "24/01/2026"})
+	require.Error(t, err)
+	_, err = DecodeActivityMetadata(map[string]any{"statementDate": 20260215})
+	require.ErrorContains(t, err, "statementDate is int")
+}
+
+func TestValidateActivityMetadata(t *testing.T) {
+	valid := map[string]any{
+		"effective":     "2026-01-24",
+		"statementDate": "2026-02-15",
+		"ref":           "ADJ-1",
+		"attempt":       2,
+		"tags":          []any{"backdated", float64(1), nil},
+		"source":        map[string]any{"system": "core", "retried": true},
+	}
+	require.NoError(t, ValidateActivityMetadata(valid))
+
+	with := func(key string, v any) map[string]any {
+		m := maps.Clone(valid)
+		m[key] = v
+		return m
+	}
+	without := func(key string) map[string]any {
+		m := maps.Clone(valid)
+		delete(m, key)
+		return m
+	}
+	rename := func(from, to string) map[string]any {
+		m := without(from)
+		m[to] = valid[from]
+		return m
+	}
+	for name, tt := range map[string]struct {
+		meta map[string]any
+		want string
+	}{
+		"nil":                   {nil, "effective is missing"},
+		"missing statementDate": {without("statementDate"), "statementDate is missing"},
+		"misspelled key":        {rename("statementDate", "statement_date"), "statementDate is missing"},
+		"bad date format":       {with("effective", "24/01/2026"), `effective: parsing time "24/01/2026"`},
+		"date with time":        {with("statementDate", "2026-02-15T00:00:00Z"), "statementDate: parsing time"},
+		"date as number":        {with("statementDate", 20260215), "statementDate is int, not a date string"},
+		"date as Date":          {with("effective", NewDate(2026, time.January, 24)), "effective is eff.Date, not a date string"},
+		"struct value":          {with("ref", struct{ ID int }{1}), "ref is struct { ID int }, not a JSON value"},
+		"nested func":           {with("source", map[string]any{"hook": func() {}}), "source.hook is func(), not a JSON value"},
+		"typed slice element":   {with("tags", []any{uuid.Nil}), "tags[0] is uuid.UUID, not a JSON value"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateActivityMetadata(tt.meta)
+			require.ErrorContains(t, err, "validating activity metadata: "+tt.want)
+		})
+	}
+}
+
+func TestActivityQueryMultiMerge(t *testing.T) {
+	shared, a, b := uuid.New(), uuid.New(), uuid.New()
+	j1, j2 := uuid.NewString(), uuid.NewString()
+	// Pages by journal and cursor; j1's activity spans two pages.
+	pages := map[string]string{
+		j1: `{"nodes": [{"entryId": "` + a.String() + `", "journalId": "` + j1 + `"}],
+			"pageInfo": {"hasNextPage": true, "endCursor": "c1"}}`,
+		j1 + "@c1": `{"nodes": [{"entryId": "` + shared.String() + `", "journalId": "` + j1 + `"}],
+			"pageInfo": {"hasNextPage": false, "endCursor": "c2"}}`,
+		j2: `{"nodes": [{"entryId": "` + shared.String() + `", "journalId": "` + j1 + `"},
+			{"entryId": "` + b.String() + `", "journalId": "` + j2 + `"}]}`,
+	}
+	var queried []string
+	stub := clientFunc(func(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+		vars := req.Variables.(*__ActivityIndexQueryInput)
+		key := *vars.JournalId
+		if vars.After != nil {
+			key += "@" + *vars.After
+		}
+		queried = append(queried, key)
+		return json.Unmarshal([]byte(`{"entries": `+pages[key]+`}`), resp.Data)
+	})
+
+	got, err := ActivityQueryMulti(context.Background(), stub, []string{j1, j2, j1}, nil, Ptr("2026-01"))
+	require.NoError(t, err)
+	require.Equal(t, []string{j1, j1 + "@c1", j2}, queried, "each journal is paged through once")
+	var ids []uuid.UUID
+	for _, n := range got {
+		ids = append(ids, n.EntryId)
+	}
+	require.Equal(t, []uuid.UUID{a, shared, b}, ids)
+	require.Equal(t, j2, got[2].JournalId.String())
+
+	_, err = ActivityQueryMulti(context.Background(), stub, nil, nil, nil)
+	require.Error(t, err)
+}
+
+func TestActivityQueryMulti(t *testing.T) {
+	ctx, client := newLiveClient(t)
+
+	_, err := CreateActivityIndex(ctx, client)
+	require.NoError(t, err)
+	_, err = Setup(ctx, client, journalID, tranCodeID, account1ID, account2ID)
+	require.NoError(t, err)
+	retail, err := RetailBankingJournal(ctx, client)
+	require.NoError(t, err)
+
+	// SIMPLE posts into whichever journal it is given.
+	effective := NewDate(2026, time.January, 10)
+	_, err = Transfer(ctx, client, journalID, account2ID, account1ID, "1.00", effective)
+	require.NoError(t, err)
+	_, err = Transfer(ctx, client, retail.JournalID, account2ID, account1ID, "2.00", effective)
+	require.NoError(t, err)
+
+	journals := []string{journalID.String(), retail.JournalID.String(), journalID.String()}
+	nodes, err := ActivityQueryMulti(ctx, client, journals, Ptr(account1ID.String()), Ptr("2026-01"))
+	require.NoError(t, err)
+	require.Len(t, nodes, 2)
+
+	units := map[uuid.UUID]Decimal{}
+	for _, n := range nodes {
+		units[n.JournalId] = n.Amount.Units
+	}
+	require.Len(t, units, 2)
+	require.True(t, units[journalID].Equal("1.00"), "sample journal: %s", units[journalID])
+	require.True(t, units[retail.JournalID].Equal("2.00"), "retail journal: %s", units[retail.JournalID])
+}
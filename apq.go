@@ -0,0 +1,166 @@
+package eff
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+)
+
+// WithPersistedQueries sends Apollo automatic persisted queries (APQ): each
+// request carries only the SHA-256 hash of its document, and the full
+// document is sent once per document when the server reports a cache miss.
+// Operation documents dominate request size, so once they are registered
+// request bodies shrink to the hash plus variables, to about a third of
+// their size for an ActivityQuery with all three filters set (see
+// TestWithPersistedQueries). The first call per document costs an extra
+// round trip.
+//
+// If the server says it doesn't support persisted queries, or fails the
+// hash-only request for lacking a document, the client resends the full
+// document and stops hashing for the rest of its life, so the option is
+// safe to enable against any Twisp. Other failures, such as a validation
+// error or a 5xx, are returned as they are, without a resend.
+func WithPersistedQueries() ClientOption {
+	return func(c *clientConfig) { c.persistedQueries = true }
+}
+
+// persistedQueryTransport implements the client side of the APQ handshake.
+type persistedQueryTransport struct {
+	base        http.RoundTripper
+	unsupported atomic.Bool
+}
+
+type persistedQueryExtension struct {
+	Version    int    `json:"version"`
+	Sha256Hash string `json:"sha256Hash"`
+}
+
+func (t *persistedQueryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.unsupported.Load() || req.Body == nil || req.GetBody == nil {
+		return t.base.RoundTrip(req)
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	payload, err := io.ReadAll(body)
+	body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	var query string
+	if json.Unmarshal(payload, &fields) != nil || json.Unmarshal(fields["query"], &query) != nil || query == "" {
+		return t.base.RoundTrip(req)
+	}
+	sum := sha256.Sum256([]byte(query))
+	ext, err := json.Marshal(map[string]persistedQueryExtension{
+		"persistedQuery": {Version: 1, Sha256Hash: hex.EncodeToString(sum[:])},
+	})
+	if err != nil {
+		return nil, err
+	}
+	fields["extensions"] = ext
+	full, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	delete(fields, "query")
+	hashed, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.base.RoundTrip(withBody(req, hashed))
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	drainClose(resp)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	switch persistedQueryStatus(respBody) {
+	case apqMiss:
+		// Register the document along with its hash.
+		return t.base.RoundTrip(withBody(req, full))
+	case apqUnsupported:
+		// The server said it can't do APQ: send the original body, and
+		// stop hashing.
+		t.unsupported.Store(true)
+		return t.base.RoundTrip(req)
+	}
+	// Anything else, success or failure, is the server's answer to the
+	// request, and sending the document too wouldn't change it.
+	return resp, nil
+}
+
+type apqResult int
+
+const (
+	apqAnswered apqResult = iota
+	apqMiss
+	apqUnsupported
+)
+
+// missingQueryErrors are the messages servers without APQ support fail a
+// request that has no document with: gqlgen, graphql-js and Apollo Server.
+var missingQueryErrors = []string{
+	"no operation provided",
+	"must provide query string",
+	"must contain a non-empty `query`",
+}
+
+// persistedQueryStatus classifies the response to a hash-only request by
+// its errors, whatever its status. Apollo servers report an unknown hash
+// as PersistedQueryNotFound, and no APQ support as
+// PersistedQueryNotSupported; servers that ignore the extension fail with
+// a missing-query error. Any other response answers the request.
+func persistedQueryStatus(body []byte) apqResult {
+	var r struct {
+		Errors []struct {
+			Message    string `json:"message"`
+			Extensions struct {
+				Code string `json:"code"`
+			} `json:"extensions"`
+		} `json:"errors"`
+	}
+	if json.Unmarshal(body, &r) != nil {
+		return apqAnswered
+	}
+	for _, e := range r.Errors {
+		switch {
+		case e.Message == "PersistedQueryNotFound" || e.Extensions.Code == "PERSISTED_QUERY_NOT_FOUND":
+			return apqMiss
+		case e.Message == "PersistedQueryNotSupported" || e.Extensions.Code == "PERSISTED_QUERY_NOT_SUPPORTED":
+			return apqUnsupported
+		}
+		msg := strings.ToLower(e.Message)
+		for _, missing := range missingQueryErrors {
+			if strings.Contains(msg, strings.ToLower(missing)) {
+				return apqUnsupported
+			}
+		}
+	}
+	return apqAnswered
+}
+
+// withBody returns a shallow clone of req that sends payload as its body.
+func withBody(req *http.Request, payload []byte) *http.Request {
+	r := req.Clone(req.Context())
+	r.Body = io.NopCloser(bytes.NewReader(payload))
+	r.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(payload)), nil
+	}
+	r.ContentLength = int64(len(payload))
+	r.Header.Del("Content-Length")
+	return r
+}
@@ -0,0 +1,157 @@
+package eff
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// apqServer is a stub GraphQL endpoint. With persisted set it implements
+// the server side of APQ; otherwise, like a server without APQ support, it
+// rejects requests that have no document.
+type apqServer struct {
+	persisted bool
+
+	mu     sync.Mutex
+	known  map[string]string
+	bodies []int // size of each request body
+}
+
+func (s *apqServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	payload, _ := io.ReadAll(r.Body)
+	var req struct {
+		Query      string `json:"query"`
+		Extensions struct {
+			PersistedQuery *persistedQueryExtension `json:"persistedQuery"`
+		} `json:"extensions"`
+	}
+	if err := json.Unmarshal(payload, &req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	s.bodies = append(s.bodies, len(payload))
+	if pq := req.Extensions.PersistedQuery; s.persisted && pq != nil {
+		if req.Query == "" {
+			req.Query = s.known[pq.Sha256Hash]
+			if req.Query == "" {
+				s.mu.Unlock()
+				fmt.Fprint(w, `{"errors": [{"message": "PersistedQueryNotFound", "extensions": {"code": "PERSISTED_QUERY_NOT_FOUND"}}]}`)
+				return
+			}
+		} else {
+			sum := sha256.Sum256([]byte(req.Query))
+			if hex.EncodeToString(sum[:]) != pq.Sha256Hash {
+				s.mu.Unlock()
+				fmt.Fprint(w, `{"errors": [{"message": "provided sha does not match query"}]}`)
+				return
+			}
+			s.known[pq.Sha256Hash] = req.Query
+		}
+	}
+	s.mu.Unlock()
+
+	if req.Query == "" {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"errors": [{"message": "no operation provided"}]}`)
+		return
+	}
+	fmt.Fprint(w, `{"data": {"entries": {"nodes": []}}}`)
+}
+
+func (s *apqServer) sizes() []int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]int(nil), s.bodies...)
+}
+
+func TestWithPersistedQueries(t *testing.T) {
+	stub := &apqServer{persisted: true, known: map[string]string{}}
+	srv := httptest.NewServer(stub)
+	t.Cleanup(srv.Close)
+
+	journal, account, month := journalID.String(), account1ID.String(), "2026-01"
+	client := (&TwispContainer{GraphQLEndpoint: srv.URL}).NewGraphQLClient(tenantHeader(), WithPersistedQueries())
+	for range 3 {
+		_, err := ActivityQuery(context.Background(), client, &journal, &account, &month)
+		require.NoError(t, err)
+	}
+	sizes := stub.sizes()
+	// Miss, register, then two hits.
+	require.Len(t, sizes, 4)
+	hashed, full := sizes[0], sizes[1]
+	require.Equal(t, []int{hashed, full, hashed, hashed}, sizes)
+	t.Logf("ActivityQuery request body: %d bytes hashed, %d bytes with the document", hashed, full)
+	require.Less(t, hashed*3, full)
+}
+
+func TestWithPersistedQueriesUnsupported(t *testing.T) {
+	stub := &apqServer{}
+	srv := httptest.NewServer(stub)
+	t.Cleanup(srv.Close)
+
+	journal, account, month := journalID.String(), account1ID.String(), "2026-01"
+	client := (&TwispContainer{GraphQLEndpoint: srv.URL}).NewGraphQLClient(tenantHeader(), WithPersistedQueries())
+	for range 3 {
+		_, err := ActivityQuery(context.Background(), client, &journal, &account, &month)
+		require.NoError(t, err)
+	}
+	// One rejected hash-only attempt, then plain requests only.
+	sizes := stub.sizes()
+	require.Len(t, sizes, 4)
+	require.Equal(t, sizes[1], sizes[3])
+	t.Logf("ActivityQuery request body without APQ: %d bytes", sizes[1])
+}
+
+func TestWithPersistedQueriesFailures(t *testing.T) {
+	var mu sync.Mutex
+	var hashOnly []bool
+	failNext := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Query string `json:"query"`
+		}
+		payload, _ := io.ReadAll(r.Body)
+		require.NoError(t, json.Unmarshal(payload, &req))
+		mu.Lock()
+		hashOnly = append(hashOnly, req.Query == "")
+		fail := failNext
+		failNext = 0
+		mu.Unlock()
+		switch fail {
+		case http.StatusServiceUnavailable:
+			http.Error(w, "upstream unavailable", http.StatusServiceUnavailable)
+		case http.StatusUnprocessableEntity:
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"errors": [{"message": "Cannot query field \"nope\" on type \"Query\"."}], "data": null}`)
+		default:
+			// A server with APQ that already knows every document.
+			fmt.Fprint(w, `{"data": {"entries": {"nodes": []}}}`)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	client := (&TwispContainer{GraphQLEndpoint: srv.URL}).NewGraphQLClient(tenantHeader(), WithPersistedQueries(), WithNoRetry())
+	query := func(fail int) error {
+		mu.Lock()
+		failNext = fail
+		mu.Unlock()
+		_, err := ActivityQuery(context.Background(), client, nil, nil, nil)
+		return err
+	}
+
+	require.Error(t, query(http.StatusServiceUnavailable))
+	require.Error(t, query(http.StatusUnprocessableEntity))
+	require.NoError(t, query(0))
+	require.Equal(t, []bool{true, true, true}, hashOnly,
+		"failures are returned without resending the document, and don't turn hashing off")
+}
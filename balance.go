@@ -0,0 +1,502 @@
+package eff
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+)
+
+// Layers holds an account's normal balance per ledger layer.
+type Layers struct {
+	Settled Decimal
+	Pending Decimal
+	// Available combines the settled and pending layers.
+	Available Decimal
+}
+
+// BalanceLayers fetches the settled, pending and available balances of an
+// account in one query, cumulative through asOf. An account with no entries
+// reports zero on every layer.
+func BalanceLayers(ctx context.Context, client graphql.Client, accountID, journalID uuid.UUID, asOf Date) (Layers, error) {
+	resp, err := AccountBalanceLayers(ctx, client, accountID, journalID, asOf)
+	if err != nil {
+		return Layers{}, fmt.Errorf("querying balance layers: %w", err)
+	}
+	b := resp.Balance
+	if b == nil {
+		return Layers{Settled: "0", Pending: "0", Available: "0"}, nil
+	}
+	return Layers{
+		Settled:   b.Settled.NormalBalance.Units,
+		Pending:   b.Pending.NormalBalance.Units,
+		Available: b.Available.NormalBalance.Units,
+	}, nil
+}
+
+// BalanceReq identifies one balance in a BatchBalances call. A zero AsOf
+// reads the current balance; otherwise the balance is cumulative through
+// AsOf. An empty Currency uses Twisp's default, USD.
+type BalanceReq struct {
+	AccountID uuid.UUID
+	JournalID uuid.UUID
+	AsOf      Date
+	Currency  CurrencyCode
+}
+
+// BatchBalances fetches the settled normal balance for every request in a
+// single round trip, using one aliased balance field per request. Results
+// are returned in request order; accounts with no entries report zero.
+//
+// Under WithPartialData, a response in which only some balances failed
+// yields the others along with the error; failed balances are left empty.
+func BatchBalances(ctx context.Context, client graphql.Client, reqs []BalanceReq) ([]Decimal, error) {
+	if len(reqs) == 0 {
+		return nil, nil
+	}
+
+	var params, fields strings.Builder
+	vars := make(map[string]any, 4*len(reqs))
+	aliases := make([]string, len(reqs))
+	for i, r := range reqs {
+		aliases[i] = balanceAlias(i, r.AccountID)
+		fmt.Fprintf(&params, " $a%d: UUID!, $j%d: UUID!", i, i)
+		vars[fmt.Sprintf("a%d", i)] = r.AccountID
+		vars[fmt.Sprintf("j%d", i)] = r.JournalID
+
+		var args string
+		if r.Currency != "" {
+			fmt.Fprintf(&params, ", $c%d: CurrencyCode!", i)
+			vars[fmt.Sprintf("c%d", i)] = r.Currency
+			args += fmt.Sprintf(", currency: $c%d", i)
+		}
+		if !r.AsOf.IsZero() {
+			fmt.Fprintf(&params, ", $d%d: Date!", i)
+			vars[fmt.Sprintf("d%d", i)] = &r.AsOf
+			args += fmt.Sprintf(", effective: { cumulative: $d%d }", i)
+		}
+		fmt.Fprintf(&fields,
+			"  %s: balance(accountId: $a%d, journalId: $j%d%s) { available(layer: SETTLED) { normalBalance { units } } }\n",
+			aliases[i], i, i, args)
+	}
+	doc := fmt.Sprintf("query BatchBalances(%s) {\n%s}", strings.TrimPrefix(params.String(), " "), fields.String())
+
+	type balance struct {
+		Available struct {
+			NormalBalance struct {
+				Units Decimal `json:"units"`
+			} `json:"normalBalance"`
+		} `json:"available"`
+	}
+	data := map[string]*balance{}
+	err := client.MakeRequest(ctx,
+		&graphql.Request{OpName: "BatchBalances", Query: doc, Variables: vars},
+		&graphql.Response{Data: &data},
+	)
+	if err != nil && !acceptPartial(ctx, err) {
+		return nil, fmt.Errorf("querying batch balances: %w", err)
+	}
+	failed := failedFields(err)
+
+	out := make([]Decimal, len(reqs))
+	for i, alias := range aliases {
+		switch b := data[alias]; {
+		case b != nil:
+			out[i] = b.Available.NormalBalance.Units
+		case !failed[alias]:
+			out[i] = "0"
+		}
+	}
+	if err != nil {
+		return out, fmt.Errorf("querying batch balances: %w", err)
+	}
+	return out, nil
+}
+
+// balanceAlias derives a GraphQL-safe field alias from an account ID. The
+// index keeps aliases unique when the same account is requested twice.
+func balanceAlias(i int, accountID uuid.UUID) string {
+	return fmt.Sprintf("b%d_%s", i, strings.ReplaceAll(accountID.String(), "-", ""))
+}
+
+// NetActivity returns the net movement of accountID's settled normal
+// balance over period, both ends inclusive: the sum of its settled entries
+// in journalID whose transactions are effective within period, each signed
+// by whether it moves the account's normal balance up or down. As with
+// Twisp's effective balances, entries count toward the period containing
+// their effective date, so a backdated adjustment carried on a later
+// statement still moves the period it is effective in. The sum is exact, at
+// the largest scale among the amounts. Twisp can't filter an account's
+// entries by effective date, so every entry of the account in journalID is
+// read, a page at a time.
+func NetActivity(ctx context.Context, client graphql.Client, accountID, journalID uuid.UUID, period DateRange) (Decimal, error) {
+	if period.End.Before(period.Start.Time) {
+		return "", fmt.Errorf("net activity for %s: end before start", period)
+	}
+	var acc DecimalAcc
+	var after *string
+	for {
+		resp, err := NetActivityEntries(ctx, client, accountID, journalID.String(), listPageSize, after)
+		if err != nil {
+			return "", fmt.Errorf("net activity for %s: %w", period, err)
+		}
+		if resp.Account == nil {
+			return "", fmt.Errorf("net activity for %s: account %s: %w", period, accountID, ErrNotFound)
+		}
+		page := resp.Account.Entries
+		for _, e := range page.Nodes {
+			if e == nil || e.Layer != LayerSettled {
+				continue
+			}
+			if eff := e.Transaction.Effective; eff.Before(period.Start.Time) || eff.After(period.End.Time) {
+				continue
+			}
+			if _, _, ok := e.Amount.Units.unscaled(); !ok {
+				return "", fmt.Errorf("net activity for %s: invalid amount %q", period, e.Amount.Units)
+			}
+			if e.Direction == resp.Account.NormalBalanceType {
+				acc.Add(e.Amount.Units)
+			} else {
+				acc.Add(Decimal("0").Sub(e.Amount.Units))
+			}
+		}
+		// An empty page can't advance the cursor, whatever hasNextPage says.
+		if !page.PageInfo.HasNextPage || page.PageInfo.EndCursor == nil || len(page.Nodes) == 0 {
+			return acc.Decimal(acc.scale), nil
+		}
+		after = page.PageInfo.EndCursor
+	}
+}
+
+// eventuallyBackoff bounds the delay between EventuallyBalance polls; it
+// doubles from the first value up to the second.
+var eventuallyBackoff = [2]time.Duration{50 * time.Millisecond, time.Second}
+
+// EventuallyBalance polls the settled normal balance of accountID as of
+// asOf (or the current balance for a zero asOf) until it numerically
+// equals want, backing off between reads, and returns the matching value.
+// Use it for the first read after a posting, which can lag index
+// propagation. If the balance doesn't settle within timeout (or ctx ends
+// first) it returns the last value observed along with an error.
+func EventuallyBalance(ctx context.Context, client graphql.Client, accountID, journalID uuid.UUID, asOf Date, want Decimal, timeout time.Duration) (Decimal, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var got Decimal
+	var lastErr error
+	delay := eventuallyBackoff[0]
+	for {
+		balances, err := BatchBalances(ctx, client, []BalanceReq{{AccountID: accountID, JournalID: journalID, AsOf: asOf}})
+		if err == nil {
+			got, lastErr = balances[0], nil
+			if got.Equal(want) {
+				return got, nil
+			}
+		} else {
+			lastErr = err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return got, errors.Join(
+				fmt.Errorf("balance of %s: got %q, want %s: %w", accountID, got, want, ctx.Err()),
+				lastErr,
+			)
+		}
+		delay = min(2*delay, eventuallyBackoff[1])
+	}
+}
+
+// ErrMixedCurrencies is returned when amounts in different currencies would
+// have to be added together.
+var ErrMixedCurrencies = errors.New("cannot sum balances across currencies")
+
+// CurrencyBalances maps each currency an account holds to its balance.
+type CurrencyBalances map[CurrencyCode]Decimal
+
+// Sum returns the single balance of a one-currency account. It fails with
+// ErrMixedCurrencies rather than adding amounts in different currencies.
+func (b CurrencyBalances) Sum() (Decimal, error) {
+	switch len(b) {
+	case 0:
+		return "0", nil
+	case 1:
+		for _, d := range b {
+			return d, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %v", ErrMixedCurrencies, slices.Sorted(maps.Keys(b)))
+}
+
+// BalancesByCurrency fetches the settled balance of an account in each of
+// currencies, cumulative through asOf, in a single round trip.
+func BalancesByCurrency(ctx context.Context, client graphql.Client, accountID, journalID uuid.UUID, asOf Date, currencies ...CurrencyCode) (CurrencyBalances, error) {
+	reqs := make([]BalanceReq, len(currencies))
+	for i, c := range currencies {
+		reqs[i] = BalanceReq{AccountID: accountID, JournalID: journalID, AsOf: asOf, Currency: c}
+	}
+	amounts, err := BatchBalances(ctx, client, reqs)
+	if err != nil {
+		return nil, err
+	}
+	out := make(CurrencyBalances, len(currencies))
+	for i, c := range currencies {
+		out[c] = amounts[i]
+	}
+	return out, nil
+}
+
+// LayerBalance is one layer of an account's balance in one currency.
+type LayerBalance struct {
+	// Normal is the layer's own normal balance.
+	Normal Decimal
+	// Available combines this layer with every layer above it, as Twisp's
+	// available(layer:) does: settled alone, settled plus pending, or all
+	// three.
+	Available Decimal
+}
+
+// Snapshot holds an account's balances keyed by currency, then layer.
+type Snapshot map[CurrencyCode]map[Layer]LayerBalance
+
+// AccountSnapshot fetches every layer of accountID's balance in every
+// currency it holds in journalID, cumulative through asOf, or the current
+// balances for a zero asOf. The amounts come from a single document with
+// one aliased balance field per currency; Twisp can't list the currencies
+// an account holds as of a date, so they are first looked up (up to
+// listPageSize) from its current balances. An account with no entries has
+// an empty snapshot.
+func AccountSnapshot(ctx context.Context, client graphql.Client, accountID, journalID uuid.UUID, asOf Date) (Snapshot, error) {
+	cur, err := AccountCurrencies(ctx, client, accountID.String(), journalID.String(), listPageSize)
+	if err != nil {
+		return nil, fmt.Errorf("listing currencies of account %s: %w", accountID, err)
+	}
+	snap := Snapshot{}
+	if len(cur.Balances.Nodes) == 0 {
+		return snap, nil
+	}
+
+	params := "$a: UUID!, $j: UUID!"
+	vars := map[string]any{"a": accountID, "j": journalID}
+	var effective string
+	if !asOf.IsZero() {
+		params += ", $d: Date!"
+		vars["d"] = &asOf
+		effective = ", effective: { cumulative: $d }"
+	}
+	var fields strings.Builder
+	currencies := make([]CurrencyCode, len(cur.Balances.Nodes))
+	for i, n := range cur.Balances.Nodes {
+		currencies[i] = n.Currency
+		params += fmt.Sprintf(", $c%d: CurrencyCode!", i)
+		vars[fmt.Sprintf("c%d", i)] = n.Currency
+		fmt.Fprintf(&fields, `  c%d: balance(accountId: $a, journalId: $j, currency: $c%d%s) {
+    settled { normalBalance { units } }
+    pending { normalBalance { units } }
+    encumbrance { normalBalance { units } }
+    availablePending: available(layer: PENDING) { normalBalance { units } }
+    availableEncumbrance: available(layer: ENCUMBRANCE) { normalBalance { units } }
+  }
+`, i, i, effective)
+	}
+	doc := fmt.Sprintf("query AccountSnapshot(%s) {\n%s}", params, fields.String())
+
+	type amount struct {
+		NormalBalance struct {
+			Units Decimal `json:"units"`
+		} `json:"normalBalance"`
+	}
+	type balance struct {
+		Settled              amount `json:"settled"`
+		Pending              amount `json:"pending"`
+		Encumbrance          amount `json:"encumbrance"`
+		AvailablePending     amount `json:"availablePending"`
+		AvailableEncumbrance amount `json:"availableEncumbrance"`
+	}
+	data := map[string]*balance{}
+	err = client.MakeRequest(ctx,
+		&graphql.Request{OpName: "AccountSnapshot", Query: doc, Variables: vars},
+		&graphql.Response{Data: &data},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying snapshot of account %s: %w", accountID, err)
+	}
+
+	for i, c := range currencies {
+		b := data[fmt.Sprintf("c%d", i)]
+		if b == nil {
+			// No entries through asOf.
+			zero := LayerBalance{Normal: "0", Available: "0"}
+			snap[c] = map[Layer]LayerBalance{LayerSettled: zero, LayerPending: zero, LayerEncumbrance: zero}
+			continue
+		}
+		snap[c] = map[Layer]LayerBalance{
+			LayerSettled:     {Normal: b.Settled.NormalBalance.Units, Available: b.Settled.NormalBalance.Units},
+			LayerPending:     {Normal: b.Pending.NormalBalance.Units, Available: b.AvailablePending.NormalBalance.Units},
+			LayerEncumbrance: {Normal: b.Encumbrance.NormalBalance.Units, Available: b.AvailableEncumbrance.NormalBalance.Units},
+		}
+	}
+	return snap, nil
+}
+
+// PostRequest describes a transfer posted through a scenario's "transfer"
+// tran code (see RetailBankingJournal).
+type PostRequest struct {
+	// TransactionID is generated when zero.
+	TransactionID uuid.UUID
+	TranCode      string
+	From          uuid.UUID
+	To            uuid.UUID
+	Amount        Decimal
+	Effective     Date
+}
+
+// PostAndMeasure posts req and returns how far it moved the settled balance
+// of accountID as of req.Effective. Both reads are pinned to the posting's
+// created timestamp (before it, and just after it), so postings committed
+// later by other callers don't leak into the delta.
+func PostAndMeasure(ctx context.Context, client graphql.Client, accountID, journalID uuid.UUID, req PostRequest) (delta Decimal, err error) {
+	txID := req.TransactionID
+	if txID == uuid.Nil {
+		txID = newRandomID()
+	}
+	resp, err := PostTransfer(ctx, client, txID, req.TranCode, req.From, req.To, req.Amount, req.Effective)
+	if err != nil {
+		return "", fmt.Errorf("posting transaction %s: %w", txID, err)
+	}
+
+	created := resp.PostTransaction.Created
+	before, err := balanceAtCutoff(ctx, client, accountID, journalID, req.Effective, created)
+	if err != nil {
+		return "", err
+	}
+	after, err := balanceAtCutoff(ctx, client, accountID, journalID, req.Effective, NextCutoffAfter(created))
+	if err != nil {
+		return "", err
+	}
+	return after.Sub(before), nil
+}
+
+// CutoffBalances is a set of settled balances all read at one cutoff.
+type CutoffBalances struct {
+	Cutoff   Timestamp
+	Balances map[uuid.UUID]Decimal
+}
+
+// ConsistentSnapshot reads the settled balances of accountIDs in journalID
+// in parallel, all counting only records modified before one cutoff taken
+// from the package clock when it is called. Reads that each took their own
+// "now" could straddle a posting and see only one of its legs; these can't,
+// so the balances are those of a single moment. Each balance is cumulative
+// through the cutoff's UTC date, so entries effective later are left out.
+//
+// If any read fails, ConsistentSnapshot returns the joined errors and no
+// balances.
+func ConsistentSnapshot(ctx context.Context, client graphql.Client, accountIDs []uuid.UUID, journalID uuid.UUID) (CutoffBalances, error) {
+	cutoff := Timestamp{now().UTC().Truncate(time.Millisecond)}
+	asOf := NewDate(cutoff.Date())
+	balances := make([]Decimal, len(accountIDs))
+	errs := make([]error, len(accountIDs))
+	var wg sync.WaitGroup
+	for i, id := range accountIDs {
+		wg.Go(func() {
+			balances[i], errs[i] = balanceAtCutoff(ctx, client, id, journalID, asOf, cutoff)
+		})
+	}
+	wg.Wait()
+	if err := errors.Join(errs...); err != nil {
+		return CutoffBalances{}, fmt.Errorf("snapshot at %s: %w", cutoff.Format(time.RFC3339Nano), err)
+	}
+	snap := CutoffBalances{Cutoff: cutoff, Balances: make(map[uuid.UUID]Decimal, len(accountIDs))}
+	for i, id := range accountIDs {
+		snap.Balances[id] = balances[i]
+	}
+	return snap, nil
+}
+
+// DiffSnapshots returns the accounts whose balance differs between before
+// and after, each with its change after.Sub(before), for asserting that an
+// operation moved exactly the expected accounts. An account missing from
+// one side counts as zero there; accounts with no change, including those
+// whose balances differ only in scale, are left out.
+func DiffSnapshots(before, after map[uuid.UUID]Decimal) map[uuid.UUID]Decimal {
+	diff := make(map[uuid.UUID]Decimal)
+	add := func(id uuid.UUID) {
+		b, a := cmp.Or(before[id], "0"), cmp.Or(after[id], "0")
+		if !a.Equal(b) {
+			diff[id] = a.Sub(b)
+		}
+	}
+	for id := range before {
+		add(id)
+	}
+	for id := range after {
+		if _, ok := before[id]; !ok {
+			add(id)
+		}
+	}
+	return diff
+}
+
+// balanceAtCutoff reads the settled balance through asOf counting only
+// records modified before cutoff.
+func balanceAtCutoff(ctx context.Context, client graphql.Client, accountID, journalID uuid.UUID, asOf Date, cutoff Timestamp) (Decimal, error) {
+	resp, err := BalanceAtCutoff(ctx, client, accountID, journalID, asOf, cutoff.Format(time.RFC3339Nano))
+	if err != nil {
+		return "", fmt.Errorf("querying balance at %s: %w", cutoff.Format(time.RFC3339Nano), err)
+	}
+	if resp.Balance == nil {
+		return "0", nil
+	}
+	return resp.Balance.Available.NormalBalance.Units, nil
+}
+
+// BalanceAsOfSequence returns the settled available balance of accountID
+// as it stood at ledger position seq, i.e. after the seq-th posting to the
+// account in journalID (Twisp's balance record version, starting at 1).
+// Position 0 is the zero balance before any posting.
+//
+// Unlike a "modified < cutoff" bound, a sequence position doesn't depend on
+// clocks or timestamp precision: two transactions committed within the same
+// millisecond still occupy distinct positions, in commit order. It returns
+// ErrNotFound if the account has fewer than seq postings.
+func BalanceAsOfSequence(ctx context.Context, client graphql.Client, accountID, journalID uuid.UUID, seq int64) (Decimal, error) {
+	if seq == 0 {
+		return "0", nil
+	}
+	var after *string
+	for {
+		resp, err := BalanceVersions(ctx, client, accountID, journalID, listPageSize, after)
+		if err != nil {
+			return "", fmt.Errorf("querying balance at sequence %d: %w", seq, err)
+		}
+		b := resp.Balance
+		if b == nil || seq < 0 || int64(b.Version) < seq {
+			return "", fmt.Errorf("balance at sequence %d: %w", seq, ErrNotFound)
+		}
+		if int64(b.Version) == seq {
+			return b.Available.NormalBalance.Units, nil
+		}
+		for _, n := range b.History.Nodes {
+			if n != nil && int64(n.Version) == seq {
+				return n.Available.NormalBalance.Units, nil
+			}
+		}
+		page := b.History.PageInfo
+		if !page.HasNextPage || page.EndCursor == nil {
+			return "", fmt.Errorf("balance at sequence %d: %w", seq, ErrNotFound)
+		}
+		after = page.EndCursor
+	}
+}
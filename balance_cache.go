@@ -0,0 +1,79 @@
+package eff
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WithBalanceCache returns a copy of c whose BalanceAtCutoff memoizes
+// results for ttl. Only cutoffs already in the past (by the package Clock)
+// are cached: nothing can be committed before them any more, so the result
+// is fixed. Cutoffs at or after now always go to the server.
+func (c *Client) WithBalanceCache(ttl time.Duration) *Client {
+	cp := *c
+	cp.balances = &balanceCache{ttl: ttl, entries: map[balanceKey]cachedBalance{}}
+	return &cp
+}
+
+// BalanceAtCutoff returns the settled available balance of accountID in the
+// default journal as of asOf, counting only transactions committed before
+// cutoff.
+func (c *Client) BalanceAtCutoff(ctx context.Context, accountID uuid.UUID, asOf Date, cutoff Timestamp) (Decimal, error) {
+	journalID, err := c.defaultJournal()
+	if err != nil {
+		return "", err
+	}
+	key := balanceKey{account: accountID, journal: journalID, asOf: asOf.Format(time.DateOnly), cutoff: cutoff.UnixNano()}
+	cacheable := c.balances != nil && cutoff.Before(now())
+	if cacheable {
+		if v, ok := c.balances.get(key); ok {
+			return v, nil
+		}
+	}
+	v, err := balanceAtCutoff(ctx, c, accountID, journalID, asOf, cutoff)
+	if err != nil {
+		return "", err
+	}
+	if cacheable {
+		c.balances.put(key, v)
+	}
+	return v, nil
+}
+
+type balanceKey struct {
+	account, journal uuid.UUID
+	asOf             string
+	cutoff           int64
+}
+
+type cachedBalance struct {
+	value   Decimal
+	expires time.Time
+}
+
+// balanceCache is shared by copies of the Client it was created for.
+type balanceCache struct {
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[balanceKey]cachedBalance
+}
+
+func (b *balanceCache) get(key balanceKey) (Decimal, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	e, ok := b.entries[key]
+	if !ok || !now().Before(e.expires) {
+		delete(b.entries, key)
+		return "", false
+	}
+	return e.value, true
+}
+
+func (b *balanceCache) put(key balanceKey, v Decimal) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.entries[key] = cachedBalance{value: v, expires: now().Add(b.ttl)}
+}
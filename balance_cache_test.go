@@ -0,0 +1,59 @@
+package eff
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithBalanceCache(t *testing.T) {
+	nowAt := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	restore := WithClock(FixedClock(nowAt))
+	t.Cleanup(func() { restore() })
+
+	var calls int
+	stub := clientFunc(func(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+		calls++
+		return json.Unmarshal([]byte(`{"balance": {"available": {"normalBalance": {"units": "3.00"}}}}`), resp.Data)
+	})
+	client := NewClient(stub, journalID).WithBalanceCache(time.Minute)
+	ctx := context.Background()
+	asOf := NewDate(2026, time.January, 31)
+	past := Timestamp{nowAt.Add(-time.Hour)}
+
+	for range 2 {
+		got, err := client.BalanceAtCutoff(ctx, account1ID, asOf, past)
+		require.NoError(t, err)
+		require.Equal(t, Decimal("3.00"), got)
+	}
+	require.Equal(t, 1, calls, "a past cutoff is served from the cache")
+
+	_, err := client.BalanceAtCutoff(ctx, account2ID, asOf, past)
+	require.NoError(t, err)
+	_, err = client.BalanceAtCutoff(ctx, account1ID, NewDate(2026, time.February, 28), past)
+	require.NoError(t, err)
+	_, err = client.BalanceAtCutoff(ctx, account1ID, asOf, Timestamp{past.Add(time.Millisecond)})
+	require.NoError(t, err)
+	require.Equal(t, 4, calls, "account, as-of date and cutoff are all part of the key")
+
+	for range 2 {
+		_, err = client.BalanceAtCutoff(ctx, account1ID, asOf, OpenCutoff())
+		require.NoError(t, err)
+	}
+	require.Equal(t, 6, calls, "future cutoffs are never cached")
+
+	// Uncached clients and expired entries go to the server.
+	_, err = NewClient(stub, journalID).BalanceAtCutoff(ctx, account1ID, asOf, past)
+	require.NoError(t, err)
+	require.Equal(t, 7, calls)
+
+	restore()
+	restore = WithClock(FixedClock(nowAt.Add(2 * time.Minute)))
+	_, err = client.BalanceAtCutoff(ctx, account1ID, asOf, past)
+	require.NoError(t, err)
+	require.Equal(t, 8, calls, "entries expire after the TTL")
+}
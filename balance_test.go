@@ -0,0 +1,497 @@
+package eff
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBalanceLayers(t *testing.T) {
+	ctx, client := newLiveClient(t)
+
+	s, err := RetailBankingJournal(ctx, client)
+	require.NoError(t, err)
+
+	effective := NewDate(2026, time.January, 2)
+	cash, checking := s.Account("cash").ID, s.Account("checking").ID
+
+	_, err = PostTransfer(ctx, client, uuid.New(), s.TranCode("transfer"), cash, checking, Decimal("10.00"), effective)
+	require.NoError(t, err)
+	_, err = PostPendingTransfer(ctx, client, uuid.New(), s.TranCode("transfer"), cash, checking, Decimal("2.50"), effective)
+	require.NoError(t, err)
+
+	layers, err := BalanceLayers(ctx, client, checking, s.JournalID, effective)
+	require.NoError(t, err)
+	require.Equal(t, Decimal("10.00"), layers.Settled)
+	require.Equal(t, Decimal("2.50"), layers.Pending)
+	require.Equal(t, Decimal("12.50"), layers.Available)
+	require.NotEqual(t, layers.Settled, layers.Pending)
+}
+
+func TestBatchBalances(t *testing.T) {
+	ctx, client := newLiveClient(t)
+
+	s, err := RetailBankingJournal(ctx, client)
+	require.NoError(t, err)
+
+	effective := NewDate(2026, time.January, 2)
+	cash, checking := s.Account("cash").ID, s.Account("checking").ID
+	_, err = PostTransfer(ctx, client, uuid.New(), s.TranCode("transfer"), cash, checking, Decimal("7.00"), effective)
+	require.NoError(t, err)
+
+	got, err := BatchBalances(ctx, client, []BalanceReq{
+		{AccountID: cash, JournalID: s.JournalID, AsOf: effective},
+		{AccountID: checking, JournalID: s.JournalID, AsOf: effective},
+	})
+	require.NoError(t, err)
+
+	for i, id := range []uuid.UUID{cash, checking} {
+		layers, err := BalanceLayers(ctx, client, id, s.JournalID, effective)
+		require.NoError(t, err)
+		require.Equal(t, layers.Settled, got[i])
+	}
+}
+
+func TestBatchBalancesSingleRequest(t *testing.T) {
+	var calls int
+	stub := clientFunc(func(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+		calls++
+		require.Contains(t, req.Query, balanceAlias(0, account1ID)+": balance(")
+		require.Contains(t, req.Query, balanceAlias(1, account2ID)+": balance(")
+		require.NotContains(t, req.Query, account1ID.String(), "IDs must be passed as variables")
+		require.Contains(t, req.Query, "currency: $c1")
+		require.Equal(t, "EUR", req.Variables.(map[string]any)["c1"])
+		return json.Unmarshal([]byte(`{
+			"`+balanceAlias(1, account2ID)+`": {"available": {"normalBalance": {"units": "-3.00"}}},
+			"`+balanceAlias(0, account1ID)+`": {"available": {"normalBalance": {"units": "3.00"}}}
+		}`), resp.Data)
+	})
+
+	got, err := BatchBalances(context.Background(), stub, []BalanceReq{
+		{AccountID: account1ID, JournalID: journalID},
+		{AccountID: account2ID, JournalID: journalID, AsOf: NewDate(2026, time.January, 31), Currency: "EUR"},
+		{AccountID: account1ID, JournalID: uuid.New()},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, calls)
+	require.Equal(t, []Decimal{"3.00", "-3.00", "0"}, got)
+}
+
+func TestAccountSnapshotSingleDocument(t *testing.T) {
+	var ops []string
+	stub := clientFunc(func(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+		ops = append(ops, req.OpName)
+		if req.OpName == "AccountCurrencies" {
+			return json.Unmarshal([]byte(`{"balances": {"nodes": [{"currency": "USD"}, {"currency": "EUR"}]}}`), resp.Data)
+		}
+		require.Contains(t, req.Query, "c0: balance(")
+		require.Contains(t, req.Query, "c1: balance(")
+		require.Contains(t, req.Query, "effective: { cumulative: $d }")
+		require.Equal(t, "EUR", req.Variables.(map[string]any)["c1"])
+		return json.Unmarshal([]byte(`{
+			"c0": {
+				"settled": {"normalBalance": {"units": "10.00"}},
+				"pending": {"normalBalance": {"units": "2.50"}},
+				"encumbrance": {"normalBalance": {"units": "1.00"}},
+				"availablePending": {"normalBalance": {"units": "12.50"}},
+				"availableEncumbrance": {"normalBalance": {"units": "13.50"}}
+			},
+			"c1": null
+		}`), resp.Data)
+	})
+
+	snap, err := AccountSnapshot(context.Background(), stub, account1ID, journalID, NewDate(2026, time.January, 31))
+	require.NoError(t, err)
+	require.Equal(t, []string{"AccountCurrencies", "AccountSnapshot"}, ops)
+	zero := LayerBalance{Normal: "0", Available: "0"}
+	require.Equal(t, Snapshot{
+		"USD": {
+			LayerSettled:     {Normal: "10.00", Available: "10.00"},
+			LayerPending:     {Normal: "2.50", Available: "12.50"},
+			LayerEncumbrance: {Normal: "1.00", Available: "13.50"},
+		},
+		"EUR": {LayerSettled: zero, LayerPending: zero, LayerEncumbrance: zero},
+	}, snap)
+}
+
+func TestAccountSnapshot(t *testing.T) {
+	ctx, client := newLiveClient(t)
+
+	s, err := RetailBankingJournal(ctx, client)
+	require.NoError(t, err)
+
+	effective := NewDate(2026, time.January, 2)
+	cash, checking := s.Account("cash").ID, s.Account("checking").ID
+
+	snap, err := AccountSnapshot(ctx, client, checking, s.JournalID, effective)
+	require.NoError(t, err)
+	require.Empty(t, snap)
+
+	_, err = PostTransfer(ctx, client, uuid.New(), s.TranCode("transfer"), cash, checking, Decimal("10.00"), effective)
+	require.NoError(t, err)
+	_, err = PostPendingTransfer(ctx, client, uuid.New(), s.TranCode("transfer"), cash, checking, Decimal("2.50"), effective)
+	require.NoError(t, err)
+
+	snap, err = AccountSnapshot(ctx, client, checking, s.JournalID, effective)
+	require.NoError(t, err)
+	require.Len(t, snap, 1)
+	usd := snap["USD"]
+	require.Len(t, usd, 3)
+	require.Equal(t, LayerBalance{Normal: "10.00", Available: "10.00"}, usd[LayerSettled])
+	require.Equal(t, LayerBalance{Normal: "2.50", Available: "12.50"}, usd[LayerPending])
+	require.True(t, usd[LayerEncumbrance].Normal.Equal("0"))
+	require.True(t, usd[LayerEncumbrance].Available.Equal("12.50"))
+}
+
+func TestBalancesByCurrency(t *testing.T) {
+	ctx, client := newLiveClient(t)
+
+	s, err := SetupMultiCurrency(ctx, client, "USD", "EUR")
+	require.NoError(t, err)
+
+	effective := NewDate(2026, time.January, 2)
+	cash, checking := s.Account("cash").ID, s.Account("checking").ID
+	_, err = PostTransferInCurrency(ctx, client, uuid.New(), s.TranCode("transfer"), cash, checking, Decimal("10.00"), "USD", effective)
+	require.NoError(t, err)
+	_, err = PostTransferInCurrency(ctx, client, uuid.New(), s.TranCode("transfer"), cash, checking, Decimal("4.00"), "EUR", effective)
+	require.NoError(t, err)
+
+	balances, err := BalancesByCurrency(ctx, client, checking, s.JournalID, effective, s.Currencies...)
+	require.NoError(t, err)
+	require.Equal(t, CurrencyBalances{"USD": "10.00", "EUR": "4.00"}, balances)
+
+	_, err = balances.Sum()
+	require.ErrorIs(t, err, ErrMixedCurrencies)
+}
+
+func TestCurrencyBalancesSum(t *testing.T) {
+	sum, err := CurrencyBalances{"USD": "10.00"}.Sum()
+	require.NoError(t, err)
+	require.Equal(t, Decimal("10.00"), sum)
+
+	sum, err = CurrencyBalances{}.Sum()
+	require.NoError(t, err)
+	require.Equal(t, Decimal("0"), sum)
+
+	_, err = CurrencyBalances{"USD": "10.00", "EUR": "4.00"}.Sum()
+	require.ErrorIs(t, err, ErrMixedCurrencies)
+	require.ErrorContains(t, err, "[EUR USD]")
+}
+
+func TestPostAndMeasure(t *testing.T) {
+	ctx, client := newLiveClient(t)
+
+	s, err := RetailBankingJournal(ctx, client)
+	require.NoError(t, err)
+
+	req := PostRequest{
+		TranCode:  s.TranCode("transfer"),
+		From:      s.Account("cash").ID,
+		To:        s.Account("checking").ID,
+		Amount:    "1.00",
+		Effective: NewDate(2026, time.January, 2),
+	}
+	for range 2 {
+		delta, err := PostAndMeasure(ctx, client, s.Account("checking").ID, s.JournalID, req)
+		require.NoError(t, err)
+		require.Equal(t, 0, delta.Cmp("1.00"), "delta %s", delta)
+	}
+}
+
+func TestBalanceAsOfSequencePaging(t *testing.T) {
+	pages := map[string]string{
+		"": `{"balance": {"version": 3, "available": {"normalBalance": {"units": "3.00"}},
+			"history": {"nodes": [
+				{"version": 3, "available": {"normalBalance": {"units": "3.00"}}},
+				{"version": 2, "available": {"normalBalance": {"units": "2.00"}}}
+			], "pageInfo": {"hasNextPage": true, "endCursor": "c1"}}}}`,
+		"c1": `{"balance": {"version": 3, "available": {"normalBalance": {"units": "3.00"}},
+			"history": {"nodes": [
+				{"version": 1, "available": {"normalBalance": {"units": "1.00"}}}
+			], "pageInfo": {"hasNextPage": false}}}}`,
+	}
+	var calls int
+	stub := clientFunc(func(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+		calls++
+		var cursor string
+		if after := req.Variables.(*__BalanceVersionsInput).After; after != nil {
+			cursor = *after
+		}
+		return json.Unmarshal([]byte(pages[cursor]), resp.Data)
+	})
+	ctx := context.Background()
+
+	for seq, want := range map[int64]Decimal{0: "0", 1: "1.00", 2: "2.00", 3: "3.00"} {
+		got, err := BalanceAsOfSequence(ctx, stub, account1ID, journalID, seq)
+		require.NoError(t, err)
+		require.Equal(t, want, got, "seq %d", seq)
+	}
+
+	calls = 0
+	_, err := BalanceAsOfSequence(ctx, stub, account1ID, journalID, 4)
+	require.ErrorIs(t, err, ErrNotFound)
+	require.Equal(t, 1, calls, "a position past the current version needs no paging")
+}
+
+func TestBalanceAsOfSequence(t *testing.T) {
+	ctx, client := newLiveClient(t)
+
+	_, err := Setup(ctx, client, journalID, tranCodeID, account1ID, account2ID)
+	require.NoError(t, err)
+	// Same effective date, so only the sequence tells the postings apart.
+	for range 3 {
+		_, err := PostTransaction(ctx, client, uuid.New(), NewDate(2026, time.January, 15))
+		require.NoError(t, err)
+	}
+
+	got, err := BalanceAsOfSequence(ctx, client, account1ID, journalID, 2)
+	require.NoError(t, err)
+	require.Equal(t, Decimal("2.00"), got)
+
+	got, err = BalanceAsOfSequence(ctx, client, account1ID, journalID, 3)
+	require.NoError(t, err)
+	require.Equal(t, Decimal("3.00"), got)
+
+	_, err = BalanceAsOfSequence(ctx, client, account1ID, journalID, 4)
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestNetActivitySum(t *testing.T) {
+	entry := func(direction, layer, units, effective string) string {
+		return `{"direction": "` + direction + `", "layer": "` + layer + `", "amount": {"units": "` + units +
+			`"}, "transaction": {"effective": "` + effective + `"}}`
+	}
+	pages := map[string]string{
+		"": `{"account": {"normalBalanceType": "CREDIT", "entries": {"nodes": [` +
+			entry("CREDIT", "SETTLED", "1.00", "2026-01-01") + `,` +
+			entry("CREDIT", "SETTLED", "2.50", "2026-01-31") + `,` +
+			entry("CREDIT", "PENDING", "9.00", "2026-01-10") + `,` +
+			entry("CREDIT", "SETTLED", "7.00", "2025-12-31") +
+			`], "pageInfo": {"hasNextPage": true, "endCursor": "c1"}}}}`,
+		"c1": `{"account": {"normalBalanceType": "CREDIT", "entries": {"nodes": [` +
+			entry("DEBIT", "SETTLED", "0.125", "2026-01-15") + `,` +
+			entry("CREDIT", "SETTLED", "4.00", "2026-02-01") +
+			`], "pageInfo": {"hasNextPage": false}}}}`,
+	}
+	stub := clientFunc(func(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+		var cursor string
+		if after := req.Variables.(*__NetActivityEntriesInput).After; after != nil {
+			cursor = *after
+		}
+		return json.Unmarshal([]byte(pages[cursor]), resp.Data)
+	})
+
+	// Settled January entries only, debits against the credit-normal account.
+	net, err := NetActivity(context.Background(), stub, account1ID, journalID, Must(MonthPeriod("2026-01")))
+	require.NoError(t, err)
+	require.Equal(t, Decimal("3.375"), net)
+
+	missing := clientFunc(func(_ context.Context, _ *graphql.Request, resp *graphql.Response) error {
+		return json.Unmarshal([]byte(`{"account": null}`), resp.Data)
+	})
+	_, err = NetActivity(context.Background(), missing, account1ID, journalID, Must(MonthPeriod("2026-01")))
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestNetActivity(t *testing.T) {
+	ctx, client := newLiveClient(t)
+
+	_, err := Setup(ctx, client, journalID, tranCodeID, account1ID, account2ID)
+	require.NoError(t, err)
+	for _, effective := range []Date{
+		NewDate(2026, time.January, 1),
+		NewDate(2026, time.January, 15),
+		NewDate(2026, time.January, 31),
+		NewDate(2026, time.February, 15),
+	} {
+		_, err := PostTransaction(ctx, client, uuid.New(), effective)
+		require.NoError(t, err)
+	}
+
+	jan, err := MonthPeriod("2026-01")
+	require.NoError(t, err)
+	feb, err := MonthPeriod("2026-02")
+	require.NoError(t, err)
+
+	net, err := NetActivity(ctx, client, account1ID, journalID, jan)
+	require.NoError(t, err)
+	require.True(t, net.Equal("3.00"), "january: %s", net)
+	net, err = NetActivity(ctx, client, account2ID, journalID, jan)
+	require.NoError(t, err)
+	require.True(t, net.Equal("-3.00"), "january, debited side: %s", net)
+
+	// The 5.00 adjustment is effective in January but carried on February's
+	// statement; it still counts toward January.
+	_, err = PostTransactionWithStatementDate(ctx, client, uuid.New(), NewDate(2026, time.January, 24), NewDate(2026, time.February, 15))
+	require.NoError(t, err)
+
+	net, err = NetActivity(ctx, client, account1ID, journalID, jan)
+	require.NoError(t, err)
+	require.True(t, net.Equal("8.00"), "january with adjustment: %s", net)
+	net, err = NetActivity(ctx, client, account1ID, journalID, feb)
+	require.NoError(t, err)
+	require.True(t, net.Equal("1.00"), "february: %s", net)
+
+	_, err = NetActivity(ctx, client, account1ID, journalID, DateRange{Start: feb.End, End: feb.Start})
+	require.Error(t, err)
+}
+
+func TestEventuallyBalance(t *testing.T) {
+	defer func(b [2]time.Duration) { eventuallyBackoff = b }(eventuallyBackoff)
+	eventuallyBackoff = [2]time.Duration{time.Millisecond, 4 * time.Millisecond}
+
+	// The balance lags: the first reads still show the pre-posting value.
+	var reads int
+	stub := clientFunc(func(_ context.Context, _ *graphql.Request, resp *graphql.Response) error {
+		reads++
+		units := "0.00"
+		if reads > 3 {
+			units = "5.00"
+		}
+		return json.Unmarshal([]byte(`{"`+balanceAlias(0, account1ID)+`": {"available": {"normalBalance": {"units": "`+units+`"}}}}`), resp.Data)
+	})
+
+	got, err := EventuallyBalance(context.Background(), stub, account1ID, journalID, Date{}, "5", time.Second)
+	require.NoError(t, err)
+	require.Equal(t, Decimal("5.00"), got)
+	require.Equal(t, 4, reads)
+
+	got, err = EventuallyBalance(context.Background(), stub, account1ID, journalID, Date{}, "7.00", 20*time.Millisecond)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, Decimal("5.00"), got, "the last observed value is returned for diagnostics")
+	require.ErrorContains(t, err, `got "5.00", want 7.00`)
+}
+
+func TestEventuallyBalanceLive(t *testing.T) {
+	ctx, client := newLiveClient(t)
+
+	_, err := Setup(ctx, client, journalID, tranCodeID, account1ID, account2ID)
+	require.NoError(t, err)
+	_, err = Transfer(ctx, client, journalID, account2ID, account1ID, "5.00", NewDate(2026, time.January, 10))
+	require.NoError(t, err)
+
+	got, err := EventuallyBalance(ctx, client, account1ID, journalID, NewDate(2026, time.January, 31), "5", 10*time.Second)
+	require.NoError(t, err)
+	require.True(t, got.Equal("5.00"), "balance %s", got)
+}
+
+func TestConsistentSnapshotSharesCutoff(t *testing.T) {
+	t.Cleanup(WithClock(FixedClock(time.Date(2026, time.March, 2, 9, 30, 0, 123456789, time.UTC))))
+	var mu sync.Mutex
+	seen := map[uuid.UUID]*__BalanceAtCutoffInput{}
+	stub := clientFunc(func(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+		vars := req.Variables.(*__BalanceAtCutoffInput)
+		mu.Lock()
+		seen[vars.AccountId] = vars
+		mu.Unlock()
+		units := "1.00"
+		if vars.AccountId == account2ID {
+			units = "-1.00"
+		}
+		return json.Unmarshal([]byte(`{"balance": {"available": {"normalBalance": {"units": "`+units+`"}}}}`), resp.Data)
+	})
+
+	snap, err := ConsistentSnapshot(context.Background(), stub, []uuid.UUID{account1ID, account2ID}, journalID)
+	require.NoError(t, err)
+	require.Equal(t, map[uuid.UUID]Decimal{account1ID: "1.00", account2ID: "-1.00"}, snap.Balances)
+	require.Equal(t, "2026-03-02T09:30:00.123Z", snap.Cutoff.Format(time.RFC3339Nano))
+	for _, vars := range seen {
+		require.Equal(t, "2026-03-02T09:30:00.123Z", vars.Cutoff)
+		require.Equal(t, NewDate(2026, time.March, 2), vars.AsOf)
+	}
+	require.Len(t, seen, 2)
+}
+
+func TestConsistentSnapshot(t *testing.T) {
+	ctx, client := newLiveClient(t)
+	_, err := Setup(ctx, client, journalID, tranCodeID, account1ID, account2ID)
+	require.NoError(t, err)
+
+	ids, err := CreateAccounts(ctx, client, []AccountSpec{{Code: "SNAP.A"}, {Code: "SNAP.B"}})
+	require.NoError(t, err)
+	accountA, accountB := ids["SNAP.A"], ids["SNAP.B"]
+
+	// One writer alternates between two transfers that share no account,
+	// account2 to account1 and then SNAP.B to SNAP.A, each 1.00 into a
+	// credit-normal account, and commits them one after the other. At any
+	// single moment account1 has therefore received as many transfers as
+	// SNAP.A, or one more. Reads that each took their own "now" could see
+	// account1 before a transfer and SNAP.A after the next one; only a
+	// shared cutoff rules that out.
+	today := NewDate(time.Now().UTC().Date())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range 15 {
+			for _, pair := range [][2]uuid.UUID{{account2ID, account1ID}, {accountB, accountA}} {
+				if _, err := Transfer(ctx, client, journalID, pair[0], pair[1], "1.00", today); !assert.NoError(t, err) {
+					return
+				}
+			}
+		}
+	}()
+	var snaps []CutoffBalances
+	for reading := true; reading; {
+		select {
+		case <-done:
+			reading = false
+		default:
+		}
+		snap, err := ConsistentSnapshot(ctx, client, []uuid.UUID{account1ID, accountA}, journalID)
+		require.NoError(t, err)
+		snaps = append(snaps, snap)
+	}
+
+	prev := Decimal("0")
+	for _, snap := range snaps {
+		first, second := snap.Balances[account1ID], snap.Balances[accountA]
+		lead := first.Sub(second)
+		require.True(t, lead.Equal("0") || lead.Equal("1.00"),
+			"at %s: account1 has %s and SNAP.A %s", snap.Cutoff.Format(time.RFC3339Nano), first, second)
+		require.GreaterOrEqual(t, first.Cmp(prev), 0, "snapshots must not go back in time")
+		prev = first
+	}
+}
+
+func TestDiffSnapshots(t *testing.T) {
+	closed := uuid.MustParse("0198c0de-0000-7000-8000-0000000000c1")
+	opened := uuid.MustParse("0198c0de-0000-7000-8000-0000000000c2")
+	before := map[uuid.UUID]Decimal{account1ID: "10.00", account2ID: "5.00", closed: "1.50"}
+	after := map[uuid.UUID]Decimal{account1ID: "7.50", account2ID: "5.0", opened: "2.00"}
+	require.Equal(t, map[uuid.UUID]Decimal{
+		account1ID: "-2.50",
+		closed:     "-1.50",
+		opened:     "2.00",
+	}, DiffSnapshots(before, after))
+
+	require.Empty(t, DiffSnapshots(before, before))
+	require.Empty(t, DiffSnapshots(nil, map[uuid.UUID]Decimal{account1ID: "0.00"}))
+}
+
+func TestDiffSnapshotsTransfer(t *testing.T) {
+	ctx, client := newLiveClient(t)
+	s, err := RetailBankingJournal(ctx, client)
+	require.NoError(t, err)
+	checking, savings, cash := s.Account("checking").ID, s.Account("savings").ID, s.Account("cash").ID
+	accounts := []uuid.UUID{checking, savings, cash}
+
+	before, err := ConsistentSnapshot(ctx, client, accounts, s.JournalID)
+	require.NoError(t, err)
+	_, err = PostTransfer(ctx, client, uuid.New(), s.TranCode("transfer"), savings, checking,
+		"25.00", NewDate(time.Now().UTC().Date()))
+	require.NoError(t, err)
+	after, err := ConsistentSnapshot(ctx, client, accounts, s.JournalID)
+	require.NoError(t, err)
+
+	diff := DiffSnapshots(before.Balances, after.Balances)
+	require.Len(t, diff, 2, "only checking and savings may move: %v", diff)
+	require.True(t, diff[checking].Equal("25.00"), "checking moved %s", diff[checking])
+	require.True(t, diff[savings].Equal("-25.00"), "savings moved %s", diff[savings])
+}
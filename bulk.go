@@ -0,0 +1,129 @@
+package eff
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+)
+
+// TransferReq is one posting in a BulkPostTransactions call; see Transfer.
+type TransferReq struct {
+	From      uuid.UUID
+	To        uuid.UUID
+	Amount    Decimal
+	Effective Date
+}
+
+// BulkOptions tunes BulkPostTransactions. The zero value posts with
+// DefaultBulkConcurrency and no progress reporting.
+type BulkOptions struct {
+	// Concurrency caps the number of postings in flight at once.
+	Concurrency int
+	// Progress, if set, is called after each item completes with its index
+	// and error (nil on success). Calls come from the posting goroutines and
+	// may overlap; the counters on r already include the completed item.
+	Progress func(index int, err error, r *BulkResult)
+}
+
+// DefaultBulkConcurrency is used when BulkOptions.Concurrency is not positive.
+const DefaultBulkConcurrency = 8
+
+// BulkResult summarizes a BulkPostTransactions run. The counters are safe to
+// read while the run is in progress, e.g. from a Progress callback. Errors
+// and TransactionIDs are indexed like the request slice; read them only
+// after BulkPostTransactions returns.
+type BulkResult struct {
+	// Errors holds the error for each request, nil for those that posted.
+	Errors []error
+	// TransactionIDs holds the posted transaction ID for each request,
+	// uuid.Nil for those that failed.
+	TransactionIDs []uuid.UUID
+
+	succeeded atomic.Int64
+	failed    atomic.Int64
+	inFlight  atomic.Int64
+}
+
+// Succeeded reports how many requests have posted so far.
+func (r *BulkResult) Succeeded() int { return int(r.succeeded.Load()) }
+
+// Failed reports how many requests have failed so far.
+func (r *BulkResult) Failed() int { return int(r.failed.Load()) }
+
+// InFlight reports how many requests are currently being posted.
+func (r *BulkResult) InFlight() int { return int(r.inFlight.Load()) }
+
+// Err joins the per-request errors, each prefixed with its index, or returns
+// nil if every request posted.
+func (r *BulkResult) Err() error {
+	var errs []error
+	for i, err := range r.Errors {
+		if err != nil {
+			errs = append(errs, fmt.Errorf("request %d: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// BulkPostTransactions posts every request in journalID with Transfer,
+// running up to opts.Concurrency postings at once. It always waits for all
+// started postings and returns a complete result; a failing request does not
+// stop the others. Requests not started before ctx is done fail with the
+// context's error.
+func BulkPostTransactions(ctx context.Context, client graphql.Client, journalID uuid.UUID, reqs []TransferReq, opts BulkOptions) *BulkResult {
+	limit := opts.Concurrency
+	if limit <= 0 {
+		limit = DefaultBulkConcurrency
+	}
+	r := &BulkResult{
+		Errors:         make([]error, len(reqs)),
+		TransactionIDs: make([]uuid.UUID, len(reqs)),
+	}
+
+	sem := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+	for i, req := range reqs {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			r.finish(i, uuid.Nil, ctx.Err(), opts.Progress)
+			continue
+		}
+		// select picks at random when both cases are ready; never start a
+		// posting after cancellation.
+		if err := ctx.Err(); err != nil {
+			<-sem
+			r.finish(i, uuid.Nil, err, opts.Progress)
+			continue
+		}
+		r.inFlight.Add(1)
+		wg.Go(func() {
+			defer func() { <-sem }()
+			txID, err := Transfer(ctx, client, journalID, req.From, req.To, req.Amount, req.Effective)
+			r.inFlight.Add(-1)
+			r.finish(i, txID, err, opts.Progress)
+		})
+	}
+	wg.Wait()
+	return r
+}
+
+// finish records the outcome of request i. Each index is written by exactly
+// one goroutine.
+func (r *BulkResult) finish(i int, txID uuid.UUID, err error, progress func(int, error, *BulkResult)) {
+	r.TransactionIDs[i] = txID
+	r.Errors[i] = err
+	if err != nil {
+		r.failed.Add(1)
+	} else {
+		r.succeeded.Add(1)
+	}
+	if progress != nil {
+		progress(i, err, r)
+	}
+}
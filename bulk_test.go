@@ -0,0 +1,94 @@
+package eff
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBulkPostTransactions(t *testing.T) {
+	const n, limit = 200, 8
+	var inFlight, peak atomic.Int32
+	stub := clientFunc(func(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+		cur := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if cur <= p || peak.CompareAndSwap(p, cur) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		vars := req.Variables.(*__PostSimpleTransferInput)
+		if vars.Amount == "13.00" {
+			return errors.New("unlucky")
+		}
+		return json.Unmarshal([]byte(`{"postTransaction": {"transactionId": "`+vars.TransactionId.String()+`"}}`), resp.Data)
+	})
+
+	reqs := make([]TransferReq, n)
+	wantFailed := 0
+	for i := range reqs {
+		amount := Decimal("1.00")
+		switch {
+		case i%10 == 3:
+			amount = "13.00" // rejected by the server
+			wantFailed++
+		case i%25 == 7:
+			amount = "0" // rejected before sending
+			wantFailed++
+		}
+		reqs[i] = TransferReq{From: account2ID, To: account1ID, Amount: amount, Effective: NewDate(2026, time.January, 10)}
+	}
+
+	var calls atomic.Int32
+	r := BulkPostTransactions(context.Background(), stub, journalID, reqs, BulkOptions{
+		Concurrency: limit,
+		Progress: func(_ int, _ error, r *BulkResult) {
+			calls.Add(1)
+			assert.LessOrEqual(t, r.InFlight(), limit)
+			assert.LessOrEqual(t, r.Succeeded()+r.Failed(), n)
+		},
+	})
+
+	require.EqualValues(t, n, calls.Load())
+	require.Equal(t, n-wantFailed, r.Succeeded())
+	require.Equal(t, wantFailed, r.Failed())
+	require.Zero(t, r.InFlight())
+	require.LessOrEqual(t, peak.Load(), int32(limit))
+	for i, err := range r.Errors {
+		if reqs[i].Amount == "1.00" {
+			require.NoError(t, err, i)
+			require.NotEqual(t, uuid.Nil, r.TransactionIDs[i], i)
+		} else {
+			require.Error(t, err, i)
+			require.Equal(t, uuid.Nil, r.TransactionIDs[i], i)
+		}
+	}
+	require.ErrorContains(t, r.Err(), "request 3: ")
+}
+
+func TestBulkPostTransactionsCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	stub := clientFunc(func(context.Context, *graphql.Request, *graphql.Response) error {
+		cancel()
+		return nil
+	})
+	reqs := make([]TransferReq, 5)
+	for i := range reqs {
+		reqs[i] = TransferReq{From: account2ID, To: account1ID, Amount: "1.00"}
+	}
+
+	r := BulkPostTransactions(ctx, stub, journalID, reqs, BulkOptions{Concurrency: 1})
+	require.Equal(t, 1, r.Succeeded())
+	require.Equal(t, 4, r.Failed())
+	require.ErrorIs(t, r.Errors[4], context.Canceled)
+}
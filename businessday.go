@@ -0,0 +1,57 @@
+package eff
+
+import (
+	"slices"
+	"time"
+)
+
+// BusinessDayConfig describes which days count as business days: every
+// weekday that isn't one of Holidays.
+type BusinessDayConfig struct {
+	Holidays []Date
+}
+
+// IsWeekend reports whether d falls on a Saturday or Sunday.
+func (d Date) IsWeekend() bool {
+	wd := d.Weekday()
+	return wd == time.Saturday || wd == time.Sunday
+}
+
+// AddBusinessDays is BusinessDayConfig{}.AddBusinessDays(d, n): it counts
+// weekdays only, with no holidays.
+func (d Date) AddBusinessDays(n int) Date {
+	return BusinessDayConfig{}.AddBusinessDays(d, n)
+}
+
+// IsBusinessDay reports whether d is neither a weekend day nor a holiday.
+func (c BusinessDayConfig) IsBusinessDay(d Date) bool {
+	if d.IsWeekend() {
+		return false
+	}
+	return !slices.ContainsFunc(c.Holidays, func(h Date) bool {
+		return sameDay(h, d)
+	})
+}
+
+// AddBusinessDays returns the date n business days after d, or before it
+// for negative n, so a Friday plus one is the following Monday. d itself
+// need not be a business day. n == 0 returns d unchanged.
+func (c BusinessDayConfig) AddBusinessDays(d Date, n int) Date {
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	for n > 0 {
+		d = Date{d.AddDate(0, 0, step)}
+		if c.IsBusinessDay(d) {
+			n--
+		}
+	}
+	return d
+}
+
+func sameDay(a, b Date) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
@@ -0,0 +1,52 @@
+package eff
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsWeekend(t *testing.T) {
+	require.False(t, NewDate(2026, time.January, 30).IsWeekend()) // Friday
+	require.True(t, NewDate(2026, time.January, 31).IsWeekend())
+	require.True(t, NewDate(2026, time.February, 1).IsWeekend())
+	require.False(t, NewDate(2026, time.February, 2).IsWeekend())
+}
+
+func TestAddBusinessDays(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		from Date
+		n    int
+		want Date
+	}{
+		{"weekday", NewDate(2026, time.January, 13), 2, NewDate(2026, time.January, 15)},
+		{"over weekend and month end", NewDate(2026, time.January, 30), 1, NewDate(2026, time.February, 2)},
+		{"from saturday", NewDate(2026, time.January, 31), 1, NewDate(2026, time.February, 2)},
+		{"over year end", NewDate(2026, time.December, 31), 1, NewDate(2027, time.January, 1)},
+		{"full week", NewDate(2026, time.January, 14), 5, NewDate(2026, time.January, 21)},
+		{"backwards over weekend", NewDate(2026, time.February, 2), -1, NewDate(2026, time.January, 30)},
+		{"zero", NewDate(2026, time.January, 31), 0, NewDate(2026, time.January, 31)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.from.AddBusinessDays(tt.n))
+		})
+	}
+}
+
+func TestAddBusinessDaysHolidays(t *testing.T) {
+	cfg := BusinessDayConfig{Holidays: []Date{
+		NewDate(2027, time.January, 1),
+		NewDate(2026, time.December, 25),
+	}}
+
+	// Thursday Dec 31 → Friday Jan 1 is a holiday → Monday Jan 4.
+	require.Equal(t, NewDate(2027, time.January, 4), cfg.AddBusinessDays(NewDate(2026, time.December, 31), 1))
+	// Thursday Dec 24 → Friday Dec 25 is a holiday → Monday Dec 28.
+	require.Equal(t, NewDate(2026, time.December, 28), cfg.AddBusinessDays(NewDate(2026, time.December, 24), 1))
+	require.Equal(t, NewDate(2026, time.December, 24), cfg.AddBusinessDays(NewDate(2026, time.December, 28), -1))
+
+	require.False(t, cfg.IsBusinessDay(NewDate(2027, time.January, 1)))
+	require.True(t, cfg.IsBusinessDay(NewDate(2027, time.January, 4)))
+}
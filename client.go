@@ -0,0 +1,128 @@
+package eff
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+)
+
+// ErrNoDefaultJournal is returned by Client methods when the client was
+// built without a default journal.
+var ErrNoDefaultJournal = errors.New("client has no default journal")
+
+// Client wraps a graphql.Client with per-client defaults so callers don't
+// repeat the journal ID on every call. The embedded client still works with
+// every generated operation. A Client is immutable and safe for concurrent
+// use as long as the wrapped client is.
+type Client struct {
+	graphql.Client
+	journalID uuid.UUID
+	balances  *balanceCache // set by WithBalanceCache
+	activity  string        // set by WithActivityIndex
+}
+
+// NewClient wraps base with journalID as the default journal.
+func NewClient(base graphql.Client, journalID uuid.UUID) *Client {
+	return &Client{Client: base, journalID: journalID}
+}
+
+// NewClient creates a Client for this container whose requests carry headers
+// and default to journalID.
+func (tc *TwispContainer) NewClient(journalID uuid.UUID, headers http.Header, opts ...ClientOption) *Client {
+	return NewClient(tc.NewGraphQLClient(headers, opts...), journalID)
+}
+
+// JournalID returns the client's default journal.
+func (c *Client) JournalID() uuid.UUID { return c.journalID }
+
+func (c *Client) defaultJournal() (uuid.UUID, error) {
+	if c.journalID == uuid.Nil {
+		return uuid.Nil, ErrNoDefaultJournal
+	}
+	return c.journalID, nil
+}
+
+// PostTransaction posts a SIMPLE transaction into the default journal.
+func (c *Client) PostTransaction(ctx context.Context, txID uuid.UUID, effective Date) (*PostTransactionToJournalResponse, error) {
+	journalID, err := c.defaultJournal()
+	if err != nil {
+		return nil, err
+	}
+	return PostTransactionToJournal(ctx, c, txID, journalID, effective)
+}
+
+// PostTransactionWithMetadata is PostTransactionWithMetadata posting into
+// the default journal.
+func (c *Client) PostTransactionWithMetadata(ctx context.Context, txID uuid.UUID, effective Date, metadata map[string]any) (*PostSimpleWithMetadataToJournalResponse, error) {
+	journalID, err := c.defaultJournal()
+	if err != nil {
+		return nil, err
+	}
+	return PostSimpleWithMetadataToJournal(ctx, c, txID, journalID, effective, activityMetadata(effective, metadata))
+}
+
+// StatementBalance runs StatementBalance against the default journal.
+func (c *Client) StatementBalance(ctx context.Context, accountID uuid.UUID, openDate, closeDate Date, priorPeriodCloseStamp, thisPeriodCloseStamp string) (*StatementBalanceResponse, error) {
+	journalID, err := c.defaultJournal()
+	if err != nil {
+		return nil, err
+	}
+	return StatementBalance(ctx, c, accountID, journalID, openDate, closeDate, priorPeriodCloseStamp, thisPeriodCloseStamp)
+}
+
+// WithActivityIndex returns a copy of c whose ActivityQuery reads idx, as
+// returned by CreateActivityIndex, instead of DefaultActivityIndex.
+func (c *Client) WithActivityIndex(idx ActivityIndex) *Client {
+	cp := *c
+	cp.activity = idx.Name
+	return &cp
+}
+
+// ActivityQuery runs ActivityQuery against the default journal for a
+// "YYYY-MM" period, on the client's activity index.
+func (c *Client) ActivityQuery(ctx context.Context, accountID uuid.UUID, period string) (*ActivityQueryResponse, error) {
+	journalID, err := c.defaultJournal()
+	if err != nil {
+		return nil, err
+	}
+	month, err := MonthPeriod(period)
+	if err != nil {
+		return nil, err
+	}
+	return activityForPeriod(ctx, c, c.activityIndex(), journalID, accountID, month)
+}
+
+// ActivityQueryMulti runs ActivityQueryMulti on the client's activity index.
+func (c *Client) ActivityQueryMulti(ctx context.Context, journalIDs []string, accountID *string, month *string) ([]*ActivityEntry, error) {
+	return activityQueryMulti(ctx, c, c.activityIndex(), journalIDs, accountID, month)
+}
+
+// activityIndex returns the index set by WithActivityIndex, or
+// DefaultActivityIndex.
+func (c *Client) activityIndex() string {
+	if c.activity == "" {
+		return DefaultActivityIndex
+	}
+	return c.activity
+}
+
+// BalanceLayers runs BalanceLayers against the default journal.
+func (c *Client) BalanceLayers(ctx context.Context, accountID uuid.UUID, asOf Date) (Layers, error) {
+	journalID, err := c.defaultJournal()
+	if err != nil {
+		return Layers{}, err
+	}
+	return BalanceLayers(ctx, c, accountID, journalID, asOf)
+}
+
+// CloseStatement runs CloseStatement against the default journal.
+func (c *Client) CloseStatement(ctx context.Context, period DateRange) (Timestamp, error) {
+	journalID, err := c.defaultJournal()
+	if err != nil {
+		return Timestamp{}, err
+	}
+	return CloseStatement(ctx, c, journalID, period)
+}
@@ -0,0 +1,62 @@
+package eff
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientDefaultJournal(t *testing.T) {
+	ctx := context.Background()
+
+	var vars map[string]any
+	stub := clientFunc(func(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+		b, err := json.Marshal(req.Variables)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(b, &vars))
+		return json.Unmarshal([]byte(`{"entries": {"nodes": []}}`), resp.Data)
+	})
+
+	c := NewClient(stub, journalID)
+	require.Equal(t, journalID, c.JournalID())
+
+	_, err := c.ActivityQuery(ctx, account1ID, "2026-01")
+	require.NoError(t, err)
+	require.Equal(t, journalID.String(), vars["journalId"])
+	require.Equal(t, account1ID.String(), vars["accountId"])
+	require.Equal(t, "2026-01", vars["period"])
+
+	_, err = NewClient(stub, uuid.Nil).ActivityQuery(ctx, account1ID, "2026-01")
+	require.ErrorIs(t, err, ErrNoDefaultJournal)
+}
+
+func TestClientPostTransactionWithMetadata(t *testing.T) {
+	ctx := context.Background()
+	other := uuid.New()
+
+	var vars map[string]any
+	stub := clientFunc(func(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+		require.Equal(t, "PostSimpleWithMetadataToJournal", req.OpName)
+		b, err := json.Marshal(req.Variables)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(b, &vars))
+		return json.Unmarshal([]byte(`{"postTransaction": {"transactionId": "`+uuid.NewString()+`"}}`), resp.Data)
+	})
+
+	_, err := NewClient(stub, other).PostTransactionWithMetadata(ctx, uuid.New(), NewDate(2026, time.January, 10), map[string]any{"ref": "INV-42"})
+	require.NoError(t, err)
+	require.Equal(t, other.String(), vars["journalId"])
+	require.Equal(t, map[string]any{
+		"ref":           "INV-42",
+		"effective":     "2026-01-10",
+		"statementDate": "2026-01-10",
+	}, vars["metadata"])
+
+	_, err = NewClient(stub, uuid.Nil).PostTransactionWithMetadata(ctx, uuid.New(), NewDate(2026, time.January, 10), nil)
+	require.ErrorIs(t, err, ErrNoDefaultJournal)
+}
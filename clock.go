@@ -0,0 +1,49 @@
+package eff
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Clock is the package's source of the current time.
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now() }
+
+// FixedClock is a Clock that always returns the same instant.
+type FixedClock time.Time
+
+func (c FixedClock) Now() time.Time { return time.Time(c) }
+
+var clock atomic.Pointer[Clock]
+
+// WithClock makes the package read the time from c instead of the system
+// clock, and returns a func that restores the system clock:
+//
+//	t.Cleanup(eff.WithClock(eff.FixedClock(start)))
+//
+// The clock is package-wide, so tests that replace it must not run in
+// parallel with tests that depend on it. Only one clock can be installed at
+// a time: WithClock panics while another is, which catches two parallel
+// tests each installing their own. Restore the first before installing the
+// next. Calling restore again, or after another clock is installed, does
+// nothing.
+func WithClock(c Clock) (restore func()) {
+	installed := &c
+	if !clock.CompareAndSwap(nil, installed) {
+		panic("eff: WithClock called while another clock is installed; the package clock can't be replaced by parallel tests")
+	}
+	return func() { clock.CompareAndSwap(installed, nil) }
+}
+
+// now returns the current time according to the package clock.
+func now() time.Time {
+	if c := clock.Load(); c != nil {
+		return (*c).Now()
+	}
+	return systemClock{}.Now()
+}
@@ -0,0 +1,35 @@
+package eff
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithClock(t *testing.T) {
+	start := time.Date(2026, time.February, 28, 23, 30, 0, 0, time.UTC)
+	restore := WithClock(FixedClock(start))
+
+	require.Equal(t, "2026-03-01T00:30:00Z", OpenCutoff().UTC().Format(time.RFC3339Nano))
+	require.Equal(t, OpenCutoff(), OpenCutoff())
+
+	var cfg twispConfig
+	var events []StartupEvent
+	WithStartupProgress(func(e StartupEvent) { events = append(events, e) })(&cfg)
+	require.NoError(t, startupHooks(cfg.progress).PostReadies[0](t.Context(), nil))
+	require.Equal(t, start, events[0].Time)
+
+	require.PanicsWithValue(t,
+		"eff: WithClock called while another clock is installed; the package clock can't be replaced by parallel tests",
+		func() { WithClock(FixedClock(start)) })
+
+	restore()
+	require.WithinDuration(t, time.Now().Add(time.Hour), OpenCutoff().Time, time.Minute)
+
+	// A stale restore doesn't remove a clock installed after it.
+	again := WithClock(FixedClock(start))
+	restore()
+	require.Equal(t, start, now())
+	again()
+}
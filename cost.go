@@ -0,0 +1,184 @@
+package eff
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// Query cost response headers. Twisp's schema doesn't describe query cost,
+// so these are the conventional names a deployment or gateway enforcing a
+// complexity limit reports it under; responses without them simply carry
+// no server-side cost.
+const (
+	QueryCostHeader      = "X-Query-Cost"
+	QueryCostLimitHeader = "X-Query-Cost-Limit"
+)
+
+// QueryCost describes the cost of one operation sent by the client.
+type QueryCost struct {
+	Operation string
+	// Estimated is EstimateQueryCost of the operation's document.
+	Estimated int
+	// Actual and Limit come from the QueryCostHeader and
+	// QueryCostLimitHeader response headers, and are 0 when the server
+	// doesn't send them.
+	Actual, Limit int
+}
+
+// WithQueryCost calls fn with the cost of every operation the client
+// completes, so tests hitting complexity limits under parallel load can see
+// what their queries cost. fn may be called concurrently.
+func WithQueryCost(fn func(QueryCost)) ClientOption {
+	return func(c *clientConfig) { c.costHooks = append(c.costHooks, fn) }
+}
+
+// WithMaxQueryCost refuses to send any operation whose EstimateQueryCost
+// exceeds max; the operation fails with a *QueryCostError instead. It
+// catches an over-wide page size before the server rejects it.
+func WithMaxQueryCost(max int) ClientOption {
+	return func(c *clientConfig) { c.maxQueryCost = max }
+}
+
+// LogQueryCost returns a WithQueryCost hook that logs each operation's
+// cost to tb.
+func LogQueryCost(tb testing.TB) func(QueryCost) {
+	return func(c QueryCost) {
+		tb.Logf("%s: query cost estimated %d, actual %d, limit %d", c.Operation, c.Estimated, c.Actual, c.Limit)
+	}
+}
+
+// QueryCostError is returned for operations refused by WithMaxQueryCost.
+type QueryCostError struct {
+	Operation      string
+	Estimated, Max int
+}
+
+func (e *QueryCostError) Error() string {
+	return fmt.Sprintf("%s: estimated query cost %d exceeds the maximum of %d", e.Operation, e.Estimated, e.Max)
+}
+
+// EstimateQueryCost estimates what doc's first operation costs to resolve,
+// given its variables: each selected field costs 1, and the selections
+// under a field with a first or last argument count once per requested
+// item, so a connection page of 100 nodes costs 100 times its node
+// selection. An unset page size counts as 1.
+func EstimateQueryCost(doc string, vars map[string]any) (int, error) {
+	parsed, err := parser.ParseQuery(&ast.Source{Input: doc})
+	if err != nil {
+		return 0, fmt.Errorf("estimating query cost: %w", err)
+	}
+	if len(parsed.Operations) == 0 {
+		return 0, fmt.Errorf("estimating query cost: no operation")
+	}
+	return selectionCost(parsed, parsed.Operations[0].SelectionSet, vars), nil
+}
+
+func selectionCost(doc *ast.QueryDocument, set ast.SelectionSet, vars map[string]any) int {
+	cost := 0
+	for _, sel := range set {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			cost += 1 + pageSize(sel, vars)*selectionCost(doc, sel.SelectionSet, vars)
+		case *ast.InlineFragment:
+			cost += selectionCost(doc, sel.SelectionSet, vars)
+		case *ast.FragmentSpread:
+			if def := doc.Fragments.ForName(sel.Name); def != nil {
+				cost += selectionCost(doc, def.SelectionSet, vars)
+			}
+		}
+	}
+	return cost
+}
+
+// pageSize returns f's first or last argument, or 1 if it has neither.
+func pageSize(f *ast.Field, vars map[string]any) int {
+	arg := f.Arguments.ForName("first")
+	if arg == nil {
+		arg = f.Arguments.ForName("last")
+	}
+	if arg == nil {
+		return 1
+	}
+	v, err := arg.Value.Value(vars)
+	if err != nil {
+		return 1
+	}
+	var n int64
+	switch v := v.(type) {
+	case int64:
+		n = v
+	case json.Number:
+		n, _ = v.Int64()
+	}
+	return max(int(n), 1)
+}
+
+// queryCostTransport estimates each operation's cost, enforces the
+// client's maximum and reports costs to its hooks.
+type queryCostTransport struct {
+	base  http.RoundTripper
+	max   int
+	hooks []func(QueryCost)
+}
+
+func (t *queryCostTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body == nil || req.GetBody == nil {
+		return t.base.RoundTrip(req)
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	var gqlReq struct {
+		Query         string         `json:"query"`
+		OperationName string         `json:"operationName"`
+		Variables     map[string]any `json:"variables"`
+	}
+	dec := json.NewDecoder(body)
+	dec.UseNumber()
+	err = dec.Decode(&gqlReq)
+	body.Close()
+	if err != nil || gqlReq.Query == "" {
+		return t.base.RoundTrip(req)
+	}
+	estimated, err := EstimateQueryCost(gqlReq.Query, gqlReq.Variables)
+	if err != nil {
+		// Let the server report the malformed document.
+		return t.base.RoundTrip(req)
+	}
+	if t.max > 0 && estimated > t.max {
+		return nil, &QueryCostError{Operation: gqlReq.OperationName, Estimated: estimated, Max: t.max}
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil || len(t.hooks) == 0 {
+		return resp, err
+	}
+	cost := QueryCost{
+		Operation: gqlReq.OperationName,
+		Estimated: estimated,
+		Actual:    headerInt(resp.Header, QueryCostHeader),
+		Limit:     headerInt(resp.Header, QueryCostLimitHeader),
+	}
+	for _, fn := range t.hooks {
+		fn(cost)
+	}
+	return resp, nil
+}
+
+// headerInt parses header key of h as an integer, or returns 0. Costs
+// reported with a fraction are rounded down.
+func headerInt(h http.Header, key string) int {
+	v := h.Get(key)
+	if n, err := strconv.Atoi(v); err == nil {
+		return n
+	}
+	f, _ := strconv.ParseFloat(v, 64)
+	return int(f)
+}
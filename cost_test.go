@@ -0,0 +1,74 @@
+package eff
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateQueryCost(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		doc  string
+		vars map[string]any
+		want int
+	}{
+		{"flat", `query { journal(id: "x") { name code } }`, nil, 3},
+		{"literal page", `query { accounts(first: 10) { nodes { code } } }`, nil, 21},
+		{"variable page", `query Q($n: Int!) { accounts(first: $n) { nodes { code name } } }`, map[string]any{"n": int64(50)}, 151},
+		{"unset page", `query Q($n: Int) { accounts(first: $n) { nodes { code } } }`, nil, 3},
+		{"fragment", `query { journal(id: "x") { ...J } } fragment J on Journal { name code }`, nil, 3},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EstimateQueryCost(tt.doc, tt.vars)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := EstimateQueryCost(`query {`, nil)
+	require.Error(t, err)
+}
+
+func TestQueryCost(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set(QueryCostHeader, "120")
+		w.Header().Set(QueryCostLimitHeader, "1000")
+		fmt.Fprint(w, `{"data": {"entries": {"nodes": [], "pageInfo": {"hasNextPage": false}}}}`)
+	}))
+	t.Cleanup(srv.Close)
+
+	var mu sync.Mutex
+	var costs []QueryCost
+	client := (&TwispContainer{GraphQLEndpoint: srv.URL}).NewGraphQLClient(tenantHeader(),
+		WithQueryCost(func(c QueryCost) {
+			mu.Lock()
+			defer mu.Unlock()
+			costs = append(costs, c)
+		}),
+		WithMaxQueryCost(600),
+	)
+	ctx := context.Background()
+	journal, account := journalID.String(), account1ID.String()
+
+	// entries costs 1, plus 5 (nodes, entryId, pageInfo and its two
+	// fields) per requested entry.
+	_, err := CountActivityEntries(ctx, client, DefaultActivityIndex, journal, account, "2026-01", 100, nil)
+	require.NoError(t, err)
+	require.Equal(t, []QueryCost{{Operation: "CountActivityEntries", Estimated: 501, Actual: 120, Limit: 1000}}, costs)
+
+	_, err = CountActivityEntries(ctx, client, DefaultActivityIndex, journal, account, "2026-01", 200, nil)
+	var costErr *QueryCostError
+	require.True(t, errors.As(err, &costErr), "got %v", err)
+	require.Equal(t, QueryCostError{Operation: "CountActivityEntries", Estimated: 1001, Max: 600}, *costErr)
+	require.Equal(t, 1, requests, "the over-cost query must not be sent")
+	require.Len(t, costs, 1)
+}
@@ -0,0 +1,432 @@
+package eff
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// NegativeStyle selects how Format renders negative amounts.
+type NegativeStyle int
+
+const (
+	// NegativeMinus renders a leading minus sign: -$1,234.50.
+	NegativeMinus NegativeStyle = iota
+	// NegativeParens wraps the amount in parentheses: ($1,234.50).
+	NegativeParens
+)
+
+// FormatOptions controls Decimal.Format.
+type FormatOptions struct {
+	// ThousandsSep groups integer digits in threes; empty disables grouping.
+	ThousandsSep string
+	// DecimalSep separates the fraction; defaults to ".".
+	DecimalSep string
+	// Symbol is a currency symbol placed before the amount, or after it
+	// when SymbolAfter is set. Include any spacing in the symbol itself.
+	Symbol      string
+	SymbolAfter bool
+	Negative    NegativeStyle
+}
+
+// Format renders d for display, e.g. Decimal("1234.50") as "$1,234.50".
+// Values that aren't plain decimals are returned unchanged.
+func (d Decimal) Format(opts FormatOptions) string {
+	neg, intPart, frac, ok := splitDecimal(string(d))
+	if !ok {
+		return string(d)
+	}
+
+	var b strings.Builder
+	if opts.ThousandsSep == "" {
+		b.WriteString(intPart)
+	} else {
+		for i, r := range intPart {
+			if i > 0 && (len(intPart)-i)%3 == 0 {
+				b.WriteString(opts.ThousandsSep)
+			}
+			b.WriteRune(r)
+		}
+	}
+	if frac != "" {
+		if opts.DecimalSep == "" {
+			b.WriteString(".")
+		} else {
+			b.WriteString(opts.DecimalSep)
+		}
+		b.WriteString(frac)
+	}
+
+	s := b.String()
+	if opts.SymbolAfter {
+		s += opts.Symbol
+	} else {
+		s = opts.Symbol + s
+	}
+	if neg && strings.Trim(intPart+frac, "0") != "" {
+		if opts.Negative == NegativeParens {
+			return "(" + s + ")"
+		}
+		return "-" + s
+	}
+	return s
+}
+
+// Split divides d into n parts at d's scale whose sum is exactly d. Any
+// remainder is handed out one unit at a time to the earliest parts, so
+// Decimal("10.00").Split(3) is [3.34 3.33 3.33]. Negative amounts split
+// symmetrically. Split returns nil if n < 1 or d isn't a plain decimal.
+func (d Decimal) Split(n int) []Decimal {
+	v, scale, ok := d.unscaled()
+	if !ok || n < 1 {
+		return nil
+	}
+	neg := v.Sign() < 0
+	v.Abs(v)
+
+	q, r := new(big.Int).QuoRem(v, big.NewInt(int64(n)), new(big.Int))
+	extra := int(r.Int64())
+	parts := make([]Decimal, n)
+	for i := range parts {
+		p := new(big.Int).Set(q)
+		if i < extra {
+			p.Add(p, big.NewInt(1))
+		}
+		if neg {
+			p.Neg(p)
+		}
+		parts[i] = newDecimal(p, scale)
+	}
+	return parts
+}
+
+// Add returns d + x at the larger of the two scales. Like Sub and Cmp it
+// panics if either operand isn't a plain decimal.
+func (d Decimal) Add(x Decimal) Decimal {
+	a, b, scale := alignScales(d, x)
+	return newDecimal(a.Add(a, b), scale)
+}
+
+// Sub returns d - x at the larger of the two scales.
+func (d Decimal) Sub(x Decimal) Decimal {
+	a, b, scale := alignScales(d, x)
+	return newDecimal(a.Sub(a, b), scale)
+}
+
+// Cmp compares d and x numerically, returning -1, 0 or +1. Unlike string
+// equality it treats "3.0" and "3.00" as equal, ignores surrounding
+// whitespace and leading zeros, and treats "-0.00" as zero.
+func (d Decimal) Cmp(x Decimal) int {
+	a, b, _ := alignScales(d, x)
+	return a.Cmp(b)
+}
+
+// Equal reports whether d and x are numerically equal; see Cmp.
+func (d Decimal) Equal(x Decimal) bool {
+	return d.Cmp(x) == 0
+}
+
+// Clamp limits d to the band [lo, hi] by numeric comparison: it returns lo
+// if d is below it, hi if d is above it, and d otherwise. A bound is
+// returned as given, at its own scale, so Decimal("0.5").Clamp("1.00",
+// "25.00") is "1.00". Clamp panics if lo is greater than hi or, like Cmp,
+// if any operand isn't a plain decimal.
+func (d Decimal) Clamp(lo, hi Decimal) Decimal {
+	if lo.Cmp(hi) > 0 {
+		panic(fmt.Sprintf("Decimal.Clamp: min %s is greater than max %s", lo, hi))
+	}
+	switch {
+	case d.Cmp(lo) < 0:
+		return lo
+	case d.Cmp(hi) > 0:
+		return hi
+	}
+	return d
+}
+
+// Canonical returns d with surrounding whitespace, a plus sign and
+// redundant leading zeros removed and negative zero collapsed to zero,
+// keeping its scale: " 007.50" becomes "7.50" and "-0.00" becomes "0.00".
+// Values that aren't plain decimals are returned unchanged.
+func (d Decimal) Canonical() Decimal {
+	v, scale, ok := d.unscaled()
+	if !ok {
+		return d
+	}
+	return newDecimal(v, scale)
+}
+
+// Abs returns the magnitude of d at d's scale, e.g. to show a credit
+// balance as positive: "-12.50" becomes "12.50" and "-0.00" becomes "0.00".
+// Like Canonical it also strips whitespace and leading zeros, and returns
+// values that aren't plain decimals unchanged.
+func (d Decimal) Abs() Decimal {
+	v, scale, ok := d.unscaled()
+	if !ok {
+		return d
+	}
+	return newDecimal(v.Abs(v), scale)
+}
+
+// Truncate drops the digits of d beyond places digits after the point,
+// without rounding, so the result moves toward zero: "1.99" becomes "1.9"
+// and "-1.99" becomes "-1.9" at 1 place. d's trailing zeros are kept, and d
+// keeps its scale if it has no more than places digits: "1.50" truncated
+// to 3 places is still "1.50". A result of zero has no sign, and a
+// negative places counts as 0. Like Canonical it strips whitespace and
+// leading zeros, and returns values that aren't plain decimals unchanged.
+func (d Decimal) Truncate(places int) Decimal {
+	v, scale, ok := d.unscaled()
+	if !ok {
+		return d
+	}
+	places = max(places, 0)
+	if scale <= places {
+		return newDecimal(v, scale)
+	}
+	div := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale-places)), nil)
+	return newDecimal(v.Quo(v, div), places)
+}
+
+// IsNegative reports whether d is below zero. Negative zero ("-0.00") and
+// values that aren't plain decimals are not negative.
+func (d Decimal) IsNegative() bool {
+	v, _, ok := d.unscaled()
+	return ok && v.Sign() < 0
+}
+
+// AccountingString renders d in accounting style, with negatives in
+// parentheses instead of a minus sign: "-5.00" becomes "(5.00)". Zero,
+// including negative zero, has neither. Like Canonical it strips
+// whitespace and leading zeros, and returns values that aren't plain
+// decimals unchanged.
+func (d Decimal) AccountingString() string {
+	return d.Canonical().Format(FormatOptions{Negative: NegativeParens})
+}
+
+// DecimalAcc sums Decimals without rendering an intermediate string per
+// addition, for totals over many entries. It holds the running sum as an
+// integer count of minor units at the largest scale added so far. The zero
+// value is an empty sum, ready to use.
+type DecimalAcc struct {
+	sum   big.Int
+	scale int
+}
+
+// Add adds d to the sum. Like Decimal.Add it panics if d isn't a plain
+// decimal.
+func (a *DecimalAcc) Add(d Decimal) {
+	v, scale := d.mustUnscaled()
+	switch {
+	case scale > a.scale:
+		rescale(&a.sum, a.scale, scale)
+		a.scale = scale
+	case scale < a.scale:
+		rescale(v, scale, a.scale)
+	}
+	a.sum.Add(&a.sum, v)
+}
+
+// AddN adds every value in ds to the sum.
+func (a *DecimalAcc) AddN(ds ...Decimal) {
+	for _, d := range ds {
+		a.Add(d)
+	}
+}
+
+// Decimal returns the sum at scale digits after the point, rounding half
+// away from zero if that drops digits. An empty sum is zero.
+func (a *DecimalAcc) Decimal(scale int) Decimal {
+	v := new(big.Int).Set(&a.sum)
+	return newDecimal(roundScale(v, a.scale, scale), scale)
+}
+
+// Percent returns p percent of d rounded half away from zero to scale
+// digits, so Decimal("200.00").Percent("0.05", 2) is "0.10". The result is
+// computed exactly; no float64 is involved. Percent panics if d or p isn't a
+// plain decimal.
+func (d Decimal) Percent(p Decimal, scale int) Decimal {
+	a, as := d.mustUnscaled()
+	b, bs := p.mustUnscaled()
+	return newDecimal(roundScale(a.Mul(a, b), as+bs+2, scale), scale)
+}
+
+// BasisPoints returns bps hundredths of a percent of d rounded half away
+// from zero to scale digits, so Decimal("10000.00").BasisPoints(25, 2) is
+// "25.00".
+func (d Decimal) BasisPoints(bps, scale int) Decimal {
+	a, as := d.mustUnscaled()
+	return newDecimal(roundScale(a.Mul(a, big.NewInt(int64(bps))), as+4, scale), scale)
+}
+
+// MinorUnits returns d as an integer count of minor units at scale digits,
+// so Decimal("12.34").MinorUnits(2) is 1234 cents and Decimal("500")
+// .MinorUnits(0) is 500 yen. It fails if d isn't a plain decimal, has
+// nonzero digits beyond scale, or doesn't fit in an int64.
+func (d Decimal) MinorUnits(scale int) (int64, error) {
+	if scale < 0 {
+		return 0, fmt.Errorf("minor units of %q: negative scale %d", string(d), scale)
+	}
+	v, vs, ok := d.unscaled()
+	if !ok {
+		return 0, fmt.Errorf("minor units of %q: invalid decimal", string(d))
+	}
+	if vs > scale {
+		div := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(vs-scale)), nil)
+		var r big.Int
+		if v.QuoRem(v, div, &r); r.Sign() != 0 {
+			return 0, fmt.Errorf("minor units of %q: more than %d fractional digits", string(d), scale)
+		}
+	} else {
+		v = rescale(v, vs, scale)
+	}
+	if !v.IsInt64() {
+		return 0, fmt.Errorf("minor units of %q: overflows int64 at scale %d", string(d), scale)
+	}
+	return v.Int64(), nil
+}
+
+// DecimalFromMinorUnits is the inverse of MinorUnits: n minor units at
+// scale digits, so DecimalFromMinorUnits(1234, 2) is "12.34". scale must
+// not be negative.
+func DecimalFromMinorUnits(n int64, scale int) Decimal {
+	return newDecimal(big.NewInt(n), scale)
+}
+
+// roundScale converts v from scale from to scale to, rounding half away
+// from zero when digits are dropped.
+func roundScale(v *big.Int, from, to int) *big.Int {
+	if to >= from {
+		return rescale(v, from, to)
+	}
+	div := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(from-to)), nil)
+	q, r := new(big.Int).QuoRem(v, div, new(big.Int))
+	if r.Abs(r).Lsh(r, 1).Cmp(div) >= 0 {
+		if v.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return q
+}
+
+// alignScales returns the coefficients of d and x rescaled to a common scale.
+func alignScales(d, x Decimal) (*big.Int, *big.Int, int) {
+	a, as := d.mustUnscaled()
+	b, bs := x.mustUnscaled()
+	scale := max(as, bs)
+	return rescale(a, as, scale), rescale(b, bs, scale), scale
+}
+
+// rescale multiplies v, currently at scale from, up to scale to (to >= from).
+func rescale(v *big.Int, from, to int) *big.Int {
+	if to == from {
+		return v
+	}
+	return v.Mul(v, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(to-from)), nil))
+}
+
+func (d Decimal) mustUnscaled() (*big.Int, int) {
+	v, scale, ok := d.unscaled()
+	if !ok {
+		panic(fmt.Sprintf("invalid Decimal %q", string(d)))
+	}
+	return v, scale
+}
+
+// maxDecimalExponent bounds the exponent Normalize will expand. Larger ones
+// would allocate a digit per unit of exponent, so "1e999999999" from an
+// untrusted JSON body is rejected rather than expanded.
+const maxDecimalExponent = 1000
+
+// Normalize expands scientific notation into plain decimal form without a
+// float round-trip: "1.5e2" becomes "150" and "2E-3" becomes "0.002".
+// Values without an exponent, that don't parse, or whose exponent exceeds
+// ±1000 are returned unchanged, so ParseDecimal and the unmarshalers reject
+// them as invalid.
+func (d Decimal) Normalize() Decimal {
+	mantissa, exp, found := strings.Cut(strings.ToLower(string(d)), "e")
+	if !found {
+		return d
+	}
+	e, err := strconv.Atoi(exp)
+	if err != nil || e > maxDecimalExponent || e < -maxDecimalExponent {
+		return d
+	}
+	v, scale, ok := Decimal(mantissa).unscaled()
+	if !ok {
+		return d
+	}
+	scale -= e
+	if scale < 0 {
+		v, scale = rescale(v, scale, 0), 0
+	}
+	return newDecimal(v, scale)
+}
+
+// unscaled parses d into an integer coefficient and the number of digits
+// after the decimal point, so "-12.340" is (-12340, 3). Surrounding
+// whitespace is ignored.
+func (d Decimal) unscaled() (*big.Int, int, bool) {
+	neg, intPart, frac, ok := splitDecimal(strings.TrimSpace(string(d)))
+	if !ok {
+		return nil, 0, false
+	}
+	v, ok := new(big.Int).SetString(intPart+frac, 10)
+	if !ok {
+		return nil, 0, false
+	}
+	if neg {
+		v.Neg(v)
+	}
+	return v, len(frac), true
+}
+
+// newDecimal renders the coefficient v at scale digits after the point.
+func newDecimal(v *big.Int, scale int) Decimal {
+	digits := new(big.Int).Abs(v).String()
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	s := digits
+	if scale > 0 {
+		s = digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+	}
+	if v.Sign() < 0 {
+		s = "-" + s
+	}
+	return Decimal(s)
+}
+
+// splitDecimal splits a plain decimal string such as "-1234.50" into its
+// sign, integer digits and fraction digits.
+func splitDecimal(s string) (neg bool, intPart, frac string, ok bool) {
+	switch {
+	case strings.HasPrefix(s, "-"):
+		neg, s = true, s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+	intPart, frac, _ = strings.Cut(s, ".")
+	if intPart == "" && frac == "" {
+		return false, "", "", false
+	}
+	if intPart == "" {
+		intPart = "0"
+	}
+	if !isDigits(intPart) || !isDigits(frac) {
+		return false, "", "", false
+	}
+	return neg, intPart, frac, true
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
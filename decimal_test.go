@@ -0,0 +1,346 @@
+package eff
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecimalFormat(t *testing.T) {
+	us := FormatOptions{ThousandsSep: ",", Symbol: "$"}
+	de := FormatOptions{ThousandsSep: ".", DecimalSep: ",", Symbol: " €", SymbolAfter: true}
+	ch := FormatOptions{ThousandsSep: "'", Symbol: "CHF "}
+
+	tests := []struct {
+		name string
+		in   Decimal
+		opts FormatOptions
+		want string
+	}{
+		{"us", "1234.50", us, "$1,234.50"},
+		{"us small", "5.00", us, "$5.00"},
+		{"us millions", "1234567.89", us, "$1,234,567.89"},
+		{"us negative minus", "-1234.50", us, "-$1,234.50"},
+		{"us negative parens", "-1234.50", FormatOptions{ThousandsSep: ",", Symbol: "$", Negative: NegativeParens}, "($1,234.50)"},
+		{"us negative zero", "-0.00", FormatOptions{Symbol: "$", Negative: NegativeParens}, "$0.00"},
+		{"de", "1234.50", de, "1.234,50 €"},
+		{"de negative", "-1234567.5", de, "-1.234.567,5 €"},
+		{"ch", "1000000", ch, "CHF 1'000'000"},
+		{"no options", "1234.50", FormatOptions{}, "1234.50"},
+		{"not a number", "abc", us, "abc"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.in.Format(tt.opts))
+		})
+	}
+
+	d := Decimal("1234.50")
+	_ = d.Format(us)
+	require.Equal(t, Decimal("1234.50"), d)
+}
+
+func TestDecimalSplit(t *testing.T) {
+	tests := []struct {
+		in   Decimal
+		n    int
+		want []Decimal
+	}{
+		{"10.00", 3, []Decimal{"3.34", "3.33", "3.33"}},
+		{"-10.00", 3, []Decimal{"-3.34", "-3.33", "-3.33"}},
+		{"0.05", 3, []Decimal{"0.02", "0.02", "0.01"}},
+		{"0.01", 3, []Decimal{"0.01", "0.00", "0.00"}},
+		{"9", 2, []Decimal{"5", "4"}},
+		{"1.00", 1, []Decimal{"1.00"}},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.in), func(t *testing.T) {
+			parts := tt.in.Split(tt.n)
+			require.Equal(t, tt.want, parts)
+
+			sum := new(big.Int)
+			for _, p := range parts {
+				v, _, ok := p.unscaled()
+				require.True(t, ok)
+				sum.Add(sum, v)
+			}
+			want, _, _ := tt.in.unscaled()
+			require.Zero(t, want.Cmp(sum), "parts must sum to the original")
+		})
+	}
+
+	require.Nil(t, Decimal("10.00").Split(0))
+	require.Nil(t, Decimal("abc").Split(2))
+}
+
+func TestDecimalNormalize(t *testing.T) {
+	tests := []struct {
+		in   Decimal
+		want Decimal
+	}{
+		{"1.5e2", "150"},
+		{"2E-3", "0.002"},
+		{"-1.25E+1", "-12.5"},
+		{"1.50e0", "1.50"},
+		{"123456789012345678901234567890e-20", "1234567890.12345678901234567890"},
+		{"150.00", "150.00"},
+		{"abc", "abc"},
+		{"1e1000", "1" + Decimal(strings.Repeat("0", 1000))},
+		{"1e1001", "1e1001"},
+		{"1e999999999", "1e999999999"},
+		{"1e-999999999", "1e-999999999"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.in)[:min(len(tt.in), 20)], func(t *testing.T) {
+			require.Equal(t, tt.want, tt.in.Normalize())
+		})
+	}
+
+	var d Decimal
+	require.NoError(t, json.Unmarshal([]byte(`"1.5e2"`), &d))
+	require.Equal(t, Decimal("150"), d)
+	require.NoError(t, json.Unmarshal([]byte(`2E-3`), &d))
+	require.Equal(t, Decimal("0.002"), d)
+
+	// Huge exponents are invalid rather than expanded.
+	_, err := ParseDecimal("1e999999999")
+	require.Error(t, err)
+	require.Error(t, json.Unmarshal([]byte(`1e999999999`), &d))
+	require.Error(t, d.UnmarshalText([]byte("1e-999999999")))
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	require.Equal(t, Decimal("4.25"), Decimal("1.5").Add("2.75"))
+	require.Equal(t, Decimal("-1.25"), Decimal("1.5").Sub("2.75"))
+	require.Equal(t, Decimal("0.00"), Decimal("3.00").Sub("3"))
+	require.Equal(t, 0, Decimal("3.0").Cmp("3.00"))
+	require.Equal(t, -1, Decimal("-1").Cmp("0.01"))
+	require.Equal(t, 1, Decimal("10").Cmp("9.99"))
+	require.Panics(t, func() { Decimal("abc").Add("1") })
+}
+
+func TestDecimalPercent(t *testing.T) {
+	require.Equal(t, Decimal("25.00"), Decimal("10000.00").BasisPoints(25, 2))
+	require.Equal(t, Decimal("5.00"), Decimal("10000.00").Percent("0.05", 2))
+	require.Equal(t, Decimal("0.10"), Decimal("200.00").Percent("0.05", 2))
+	// 0.05% of 123.45 is 0.0617250.
+	require.Equal(t, Decimal("0.06"), Decimal("123.45").Percent("0.05", 2))
+	require.Equal(t, Decimal("0.061725"), Decimal("123.45").Percent("0.05", 6))
+	// Halves round away from zero.
+	require.Equal(t, Decimal("0.01"), Decimal("1.00").BasisPoints(50, 2))
+	require.Equal(t, Decimal("-0.01"), Decimal("-1.00").BasisPoints(50, 2))
+	require.Equal(t, Decimal("0.00"), Decimal("1.00").BasisPoints(49, 2))
+	require.Equal(t, Decimal("12"), Decimal("100").Percent("12", 0))
+	require.Panics(t, func() { Decimal("abc").Percent("1", 2) })
+}
+
+func TestDecimalCanonical(t *testing.T) {
+	require.Equal(t, Decimal("0.00"), Decimal(" 0.00").Canonical())
+	require.Equal(t, Decimal("0.00"), Decimal("-0.00").Canonical())
+	require.Equal(t, Decimal("7.50"), Decimal("007.50").Canonical())
+	require.Equal(t, Decimal("-7.50"), Decimal(" -007.50\n").Canonical())
+	require.Equal(t, Decimal("abc"), Decimal("abc").Canonical())
+
+	require.True(t, Decimal(" 0.00").Equal("0"))
+	require.True(t, Decimal("-0.00").Equal("0.00"))
+	require.True(t, Decimal("007.50").Equal("7.5"))
+	require.False(t, Decimal("7.51").Equal("7.5"))
+
+	d := Decimal("-0.00")
+	require.Equal(t, 0, d.Cmp("0"))
+	require.Equal(t, Decimal("-0.00"), d, "Cmp must not modify the receiver")
+}
+
+func TestDecimalAbs(t *testing.T) {
+	require.Equal(t, Decimal("12.50"), Decimal("12.50").Abs())
+	require.Equal(t, Decimal("12.50"), Decimal("-12.50").Abs())
+	require.Equal(t, Decimal("0.00"), Decimal("-0.00").Abs())
+	require.Equal(t, Decimal("100"), Decimal("-100").Abs())
+	require.Equal(t, Decimal("0.001"), Decimal("-0.001").Abs())
+	require.Equal(t, Decimal("abc"), Decimal("abc").Abs())
+}
+
+func TestDecimalTruncate(t *testing.T) {
+	for _, tt := range []struct {
+		in     Decimal
+		places int
+		want   Decimal
+	}{
+		{"1.99", 1, "1.9"},
+		{"-1.99", 1, "-1.9"},
+		{"1.99", 0, "1"},
+		{"-1.99", 0, "-1"},
+		{"123.456789", 4, "123.4567"},
+		{"-123.456789", 4, "-123.4567"},
+		{"1.50", 2, "1.50"},
+		{"1.500", 2, "1.50"},
+		{"1.50", 4, "1.50"},
+		{"-2", 2, "-2"},
+		{"-0.09", 1, "0.0"},
+		{"0.09", 1, "0.0"},
+		{" -007.509", 2, "-7.50"},
+		{"9.99", -1, "9"},
+		{"abc", 2, "abc"},
+	} {
+		require.Equal(t, tt.want, tt.in.Truncate(tt.places), "%q.Truncate(%d)", tt.in, tt.places)
+	}
+	// Unlike rounding, truncation never carries into the next digit.
+	require.Equal(t, Decimal("0.99"), Decimal("0.999").Truncate(2))
+	var acc DecimalAcc
+	acc.Add("0.999")
+	require.Equal(t, Decimal("1.00"), acc.Decimal(2))
+}
+
+func TestDecimalAccountingSign(t *testing.T) {
+	for in, want := range map[Decimal]struct {
+		negative bool
+		str      string
+	}{
+		"5.00":     {false, "5.00"},
+		"1234.5":   {false, "1234.5"},
+		"-5.00":    {true, "(5.00)"},
+		"-0.001":   {true, "(0.001)"},
+		" -007.50": {true, "(7.50)"},
+		"0":        {false, "0"},
+		"0.00":     {false, "0.00"},
+		"-0.00":    {false, "0.00"},
+		"+0":       {false, "0"},
+		"abc":      {false, "abc"},
+	} {
+		require.Equal(t, want.negative, in.IsNegative(), "%q", in)
+		require.Equal(t, want.str, in.AccountingString(), "%q", in)
+	}
+}
+
+func TestDecimalClamp(t *testing.T) {
+	tests := []struct {
+		name       string
+		in, lo, hi Decimal
+		want       Decimal
+	}{
+		{"below", "0.5", "1.00", "25.00", "1.00"},
+		{"negative below", "-3", "0.00", "25.00", "0.00"},
+		{"at min", "1.0", "1.00", "25.00", "1.0"},
+		{"within", "12.345", "1.00", "25.00", "12.345"},
+		{"at max", "25", "1.00", "25.00", "25"},
+		{"above", "25.01", "1.00", "25.00", "25.00"},
+		{"above keeps bound scale", "100", "1.0", "25.000", "25.000"},
+		{"single point band", "3", "2.50", "2.5", "2.5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.in.Clamp(tt.lo, tt.hi))
+		})
+	}
+
+	require.PanicsWithValue(t, "Decimal.Clamp: min 5.00 is greater than max 1.00", func() {
+		Decimal("3").Clamp("5.00", "1.00")
+	})
+	require.Panics(t, func() { Decimal("x").Clamp("1", "2") })
+}
+
+func TestDecimalText(t *testing.T) {
+	q := url.Values{}
+	q.Set("amount", string(Must(Decimal("1234.50").MarshalText())))
+	require.Equal(t, "amount=1234.50", q.Encode())
+
+	parsed, err := url.ParseQuery(q.Encode())
+	require.NoError(t, err)
+	var d Decimal
+	require.NoError(t, d.UnmarshalText([]byte(parsed.Get("amount"))))
+	require.Equal(t, Decimal("1234.50"), d)
+
+	require.NoError(t, d.UnmarshalText([]byte("1.5e2")))
+	require.Equal(t, Decimal("150"), d)
+
+	require.Error(t, d.UnmarshalText([]byte("12abc")))
+	require.Error(t, json.Unmarshal([]byte(`"12abc"`), &d))
+	require.Equal(t, Decimal("150"), d, "a failed unmarshal leaves d unchanged")
+
+	// Decimal keeps its JSON form: a quoted string.
+	require.Equal(t, `"1.00"`, string(Must(json.Marshal(Decimal("1.00")))))
+}
+
+func TestDecimalMinorUnits(t *testing.T) {
+	// USD cents.
+	for _, d := range []Decimal{"12.34", "-12.34", "0.00", "0.01", "92233720368547758.07"} {
+		n, err := d.MinorUnits(2)
+		require.NoError(t, err, d)
+		require.Equal(t, d, DecimalFromMinorUnits(n, 2))
+	}
+	require.Equal(t, int64(1234), Must(Decimal("12.34").MinorUnits(2)))
+	require.Equal(t, int64(1200), Must(Decimal("12").MinorUnits(2)))
+	require.Equal(t, int64(1230), Must(Decimal("12.300").MinorUnits(2)), "trailing zeros are not extra precision")
+
+	// JPY whole units.
+	for _, d := range []Decimal{"500", "-500", "0"} {
+		n, err := d.MinorUnits(0)
+		require.NoError(t, err, d)
+		require.Equal(t, d, DecimalFromMinorUnits(n, 0))
+	}
+	require.Equal(t, int64(500), Must(Decimal("500.00").MinorUnits(0)))
+
+	_, err := Decimal("12.345").MinorUnits(2)
+	require.ErrorContains(t, err, "more than 2 fractional digits")
+	_, err = Decimal("500.5").MinorUnits(0)
+	require.Error(t, err)
+	_, err = Decimal("92233720368547758.08").MinorUnits(2)
+	require.ErrorContains(t, err, "overflows int64")
+	_, err = Decimal("abc").MinorUnits(2)
+	require.Error(t, err)
+}
+
+func TestDecimalAcc(t *testing.T) {
+	var acc DecimalAcc
+	require.Equal(t, Decimal("0.00"), acc.Decimal(2))
+
+	acc.Add("1.5")
+	acc.AddN("2.25", "-0.125", " 10 ")
+	require.Equal(t, Decimal("13.625"), acc.Decimal(3))
+	require.Equal(t, Decimal("13.63"), acc.Decimal(2))
+	require.Equal(t, Decimal("13.62500"), acc.Decimal(5))
+	require.Equal(t, Decimal("13.625"), acc.Decimal(3), "Decimal must not modify the sum")
+
+	acc.Add("-20")
+	require.Equal(t, Decimal("-6.38"), acc.Decimal(2))
+	require.Panics(t, func() { acc.Add("abc") })
+}
+
+func benchmarkAmounts() []Decimal {
+	amounts := make([]Decimal, 5000)
+	for i := range amounts {
+		amounts[i] = DecimalFromMinorUnits(int64(i*37%10000-5000), 2)
+	}
+	return amounts
+}
+
+func BenchmarkDecimalSum(b *testing.B) {
+	amounts := benchmarkAmounts()
+	var want DecimalAcc
+	want.AddN(amounts...)
+
+	b.Run("Add", func(b *testing.B) {
+		for b.Loop() {
+			sum := Decimal("0")
+			for _, d := range amounts {
+				sum = sum.Add(d)
+			}
+			if !sum.Equal(want.Decimal(2)) {
+				b.Fatal(sum)
+			}
+		}
+	})
+	b.Run("DecimalAcc", func(b *testing.B) {
+		for b.Loop() {
+			var acc DecimalAcc
+			acc.AddN(amounts...)
+			if sum := acc.Decimal(2); !sum.Equal(want.Decimal(2)) {
+				b.Fatal(sum)
+			}
+		}
+	})
+}
@@ -0,0 +1,124 @@
+package eff
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+)
+
+// dumpEntriesPerAccount caps the entries DumpLedger reads for each account.
+const dumpEntriesPerAccount = 20
+
+// DumpLedger writes a human-readable snapshot of journalID to w for
+// attaching to a test failure: the journal, every account with a balance
+// in it (from the first listPageSize accounts by code), and the
+// transactions behind each account's most recent entries, with their legs.
+//
+// Each section is fetched separately. When one fails its error is written
+// in its place and the rest of the report still follows; DumpLedger then
+// returns the joined errors.
+func DumpLedger(ctx context.Context, client graphql.Client, journalID uuid.UUID, w io.Writer) error {
+	var errs []error
+	fail := func(what string, err error) string {
+		err = fmt.Errorf("%s: %w", what, err)
+		errs = append(errs, err)
+		// gqlerror.List ends its message with a newline.
+		return strings.TrimSpace(err.Error())
+	}
+
+	fmt.Fprintf(w, "Ledger dump for journal %s\n\n", journalID)
+
+	fmt.Fprintln(w, "Journal:")
+	if j, err := GetJournal(ctx, client, journalID); err != nil {
+		fmt.Fprintf(w, "  error: %s\n", fail("journal", err))
+	} else {
+		code := ""
+		if j.Code != nil {
+			code = *j.Code
+		}
+		fmt.Fprintf(w, "  %s (%s) %s, created %s\n", j.Name, code, j.Status, j.Created.Format(time.RFC3339))
+	}
+
+	fmt.Fprintln(w, "\nAccounts:")
+	resp, err := DumpAccounts(ctx, client, journalID, listPageSize)
+	if err != nil {
+		fmt.Fprintf(w, "  error: %s\n", fail("accounts", err))
+		return errors.Join(errs...)
+	}
+	codes := map[uuid.UUID]string{}
+	var accounts []uuid.UUID // in code order
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	for _, a := range resp.Accounts.Nodes {
+		if a.Balance == nil {
+			continue
+		}
+		codes[a.AccountId] = a.Code
+		accounts = append(accounts, a.AccountId)
+		b := a.Balance.Available.NormalBalance
+		fmt.Fprintf(tw, "  %s\t%s\t%s normal\t%s %s\n", a.Code, a.Name, a.NormalBalanceType, b.Units, b.Currency)
+	}
+	tw.Flush()
+	if len(accounts) == 0 {
+		fmt.Fprintln(w, "  (no accounts with a balance in this journal)")
+	}
+
+	type leg struct {
+		code  string
+		entry *DumpAccountEntriesAccountEntriesEntryConnectionNodesEntry
+	}
+	txs := map[uuid.UUID][]leg{}
+	var entryErrs []string
+	for _, id := range accounts {
+		resp, err := DumpAccountEntries(ctx, client, id, journalID.String(), dumpEntriesPerAccount)
+		if err != nil {
+			entryErrs = append(entryErrs, fail("entries of "+codes[id], err))
+			continue
+		}
+		if resp.Account == nil {
+			continue
+		}
+		for _, e := range resp.Account.Entries.Nodes {
+			txs[e.TransactionId] = append(txs[e.TransactionId], leg{codes[id], e})
+		}
+	}
+
+	fmt.Fprintln(w, "\nRecent transactions:")
+	ids := slices.Collect(maps.Keys(txs))
+	slices.SortFunc(ids, func(a, b uuid.UUID) int {
+		ea, eb := txs[a][0].entry, txs[b][0].entry
+		return cmp.Or(
+			ea.Transaction.Effective.Compare(eb.Transaction.Effective.Time),
+			ea.Created.Compare(eb.Created.Time),
+			slices.Compare(a[:], b[:]),
+		)
+	})
+	tw = tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	for _, id := range ids {
+		legs := txs[id]
+		first := legs[0].entry.Transaction
+		fmt.Fprintf(tw, "  %s\n", Transaction{ID: id, Effective: first.Effective, TranCode: first.TranCode.Code}.header())
+		slices.SortFunc(legs, func(a, b leg) int { return cmp.Compare(a.code, b.code) })
+		for _, l := range legs {
+			e := l.entry
+			fmt.Fprintf(tw, "    %s\t%s\t%s %s\t%s\t%s\n", l.code, e.Direction, e.Amount.Units, e.Amount.Currency, e.EntryType, e.Layer)
+		}
+	}
+	tw.Flush()
+	if len(ids) == 0 && len(entryErrs) == 0 {
+		fmt.Fprintln(w, "  (none)")
+	}
+	for _, err := range entryErrs {
+		fmt.Fprintf(w, "  error: %s\n", err)
+	}
+	return errors.Join(errs...)
+}
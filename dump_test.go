@@ -0,0 +1,78 @@
+package eff
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func TestDumpLedgerPartial(t *testing.T) {
+	txID := uuid.MustParse("0190f5a0-0000-7000-8000-000000000001")
+	stub := clientFunc(func(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+		switch req.OpName {
+		case "FetchJournal":
+			return gqlerror.List{{Message: "permission denied"}}
+		case "DumpAccounts":
+			return json.Unmarshal([]byte(`{"accounts": {"nodes": [
+				{"accountId": "`+account2ID.String()+`", "code": "BERT.CHECKING", "name": "Bert", "normalBalanceType": "CREDIT",
+				 "balance": {"available": {"normalBalance": {"units": "-1.00", "currency": "USD"}}}},
+				{"accountId": "`+account1ID.String()+`", "code": "ERNIE.CHECKING", "name": "Ernie", "normalBalanceType": "CREDIT",
+				 "balance": {"available": {"normalBalance": {"units": "1.00", "currency": "USD"}}}},
+				{"accountId": "`+uuid.NewString()+`", "code": "UNUSED", "name": "Unused", "normalBalanceType": "DEBIT", "balance": null}
+			]}}`), resp.Data)
+		case "DumpAccountEntries":
+			if req.Variables.(*__DumpAccountEntriesInput).AccountId == account2ID {
+				return gqlerror.List{{Message: "timeout"}}
+			}
+			return json.Unmarshal([]byte(`{"account": {"entries": {"nodes": [
+				{"transactionId": "`+txID.String()+`", "entryType": "SIMPLE_CR", "direction": "CREDIT", "layer": "SETTLED",
+				 "amount": {"units": "1.00", "currency": "USD"}, "created": "2026-01-10T12:00:00Z",
+				 "transaction": {"effective": "2026-01-10", "tranCode": {"code": "SIMPLE"}}}
+			]}}}`), resp.Data)
+		}
+		t.Fatalf("unexpected operation %s", req.OpName)
+		return nil
+	})
+
+	var buf bytes.Buffer
+	err := DumpLedger(context.Background(), stub, journalID, &buf)
+	require.ErrorContains(t, err, "journal: ")
+	require.ErrorContains(t, err, "entries of BERT.CHECKING: ")
+
+	out := buf.String()
+	t.Log("\n" + out)
+	require.Contains(t, out, "Journal:\n  error: journal: ")
+	require.Contains(t, out, "ERNIE.CHECKING  Ernie  CREDIT normal  1.00 USD")
+	require.NotContains(t, out, "UNUSED")
+	require.Contains(t, out, "  2026-01-10 SIMPLE "+txID.String()+"\n    ERNIE.CHECKING  CREDIT  1.00 USD  SIMPLE_CR  SETTLED\n")
+	require.Contains(t, out, "error: entries of BERT.CHECKING: ")
+}
+
+func TestDumpLedger(t *testing.T) {
+	ctx, client := newLiveClient(t)
+
+	_, err := Setup(ctx, client, journalID, tranCodeID, account1ID, account2ID)
+	require.NoError(t, err)
+	txID, err := Transfer(ctx, client, journalID, account2ID, account1ID, "5.00", NewDate(2026, time.January, 10))
+	require.NoError(t, err)
+	_, err = PostTransaction(ctx, client, uuid.New(), NewDate(2026, time.January, 15))
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, DumpLedger(ctx, client, journalID, &buf))
+	out := buf.String()
+	t.Log("\n" + out)
+
+	require.Contains(t, out, "Sample (SAMPLE) ACTIVE")
+	require.Regexp(t, `ERNIE\.CHECKING +Ernie Bishop - Checking +CREDIT normal +6\.00 USD`, out)
+	require.Regexp(t, `BERT\.CHECKING +Bert - Checking +CREDIT normal +-6\.00 USD`, out)
+	require.Contains(t, out, "2026-01-10 SIMPLE "+txID.String())
+	require.Regexp(t, `BERT\.CHECKING +DEBIT +5\.00 USD +SIMPLE_DR +SETTLED`, out)
+}
@@ -0,0 +1,197 @@
+package eff
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// TwispError is a failed Twisp request broken down into its GraphQL
+// errors.
+type TwispError struct {
+	// StatusCode is the HTTP status of a request Twisp rejected outright
+	// (anything but 200), so callers can tell 4xx from 5xx; 0 when the
+	// errors came back in a successful response.
+	StatusCode int
+	Errors     []GraphQLError
+
+	cause error
+}
+
+// GraphQLError is one entry of a GraphQL "errors" array.
+type GraphQLError struct {
+	Message string
+	// Path is the response field the error applies to, e.g.
+	// "postTransaction" or "schema.createIndex"; empty for request-level
+	// errors.
+	Path string
+	// Code is extensions.code, if Twisp set one.
+	Code string
+}
+
+func (e *TwispError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, ge := range e.Errors {
+		msgs[i] = ge.String()
+	}
+	msg := strings.Join(msgs, "; ")
+	if e.StatusCode == 0 {
+		return msg
+	}
+	status := fmt.Sprintf("HTTP %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	if msg == "" {
+		return status
+	}
+	return status + ": " + msg
+}
+
+// Unwrap returns the error e was built from, if any, e.g. the
+// *graphql.HTTPError of a rejected request.
+func (e *TwispError) Unwrap() error { return e.cause }
+
+func (e GraphQLError) String() string {
+	var b strings.Builder
+	if e.Code != "" {
+		fmt.Fprintf(&b, "[%s] ", e.Code)
+	}
+	if e.Path != "" {
+		fmt.Fprintf(&b, "%s: ", e.Path)
+	}
+	b.WriteString(e.Message)
+	return b.String()
+}
+
+// TwispErrorFrom extracts the GraphQL errors carried by err, as returned by
+// any generated operation, along with the HTTP status if Twisp rejected the
+// request. It returns nil if err carries neither, e.g. for a connection
+// error.
+func TwispErrorFrom(err error) *TwispError {
+	var te *TwispError
+	if errors.As(err, &te) {
+		return te
+	}
+	var httpErr *graphql.HTTPError
+	if errors.As(err, &httpErr) {
+		return &TwispError{
+			StatusCode: httpErr.StatusCode,
+			Errors:     graphQLErrors(httpErr.Response.Errors),
+			cause:      httpErr,
+		}
+	}
+	var list gqlerror.List
+	if !errors.As(err, &list) {
+		return nil
+	}
+	return &TwispError{Errors: graphQLErrors(list), cause: list}
+}
+
+func graphQLErrors(list gqlerror.List) []GraphQLError {
+	if len(list) == 0 {
+		return nil
+	}
+	out := make([]GraphQLError, len(list))
+	for i, e := range list {
+		out[i] = GraphQLError{Message: e.Message, Path: e.Path.String()}
+		if code, ok := e.Extensions["code"].(string); ok {
+			out[i].Code = code
+		}
+	}
+	return out
+}
+
+type partialDataKey struct{}
+
+// WithPartialData returns a context under which wrappers that document
+// support for it keep the data Twisp returns alongside GraphQL errors: they
+// return the result built from that partial data together with the error,
+// whose *TwispError (see TwispErrorFrom) says what failed, instead of the
+// error alone. Callers then decide whether the partial result will do.
+// Connection errors and non-200 responses, which carry no data, still fail
+// outright. Generated operations always return whatever response they
+// decoded alongside the error.
+func WithPartialData(ctx context.Context) context.Context {
+	return context.WithValue(ctx, partialDataKey{}, true)
+}
+
+// acceptPartial reports whether a wrapper should go on to use the data of a
+// response that failed with err.
+func acceptPartial(ctx context.Context, err error) bool {
+	if allowed, _ := ctx.Value(partialDataKey{}).(bool); !allowed {
+		return false
+	}
+	te := TwispErrorFrom(err)
+	return te != nil && te.StatusCode == 0
+}
+
+// failedFields returns the top-level response fields (or aliases) that the
+// GraphQL errors in err point at.
+func failedFields(err error) map[string]bool {
+	failed := map[string]bool{}
+	if te := TwispErrorFrom(err); te != nil {
+		for _, e := range te.Errors {
+			if field, _, _ := strings.Cut(e.Path, "."); field != "" {
+				field, _, _ = strings.Cut(field, "[")
+				failed[field] = true
+			}
+		}
+	}
+	return failed
+}
+
+// withStatusErrors wraps client so a request Twisp rejects with a non-200
+// status fails with a *TwispError carrying the status, instead of
+// genqlient's *graphql.HTTPError (which it still unwraps to).
+func withStatusErrors(client graphql.Client) graphql.Client {
+	return statusErrorClient{client}
+}
+
+type statusErrorClient struct {
+	graphql.Client
+}
+
+func (c statusErrorClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	err := c.Client.MakeRequest(ctx, req, resp)
+	if httpErr, ok := err.(*graphql.HTTPError); ok {
+		return TwispErrorFrom(httpErr)
+	}
+	return err
+}
+
+// RequireNoGQLError fails tb if err is non-nil, reporting the operation
+// name, its variables as indented JSON and each GraphQL error's code and
+// path alongside err itself.
+func RequireNoGQLError(tb testing.TB, err error, op string, vars any) {
+	tb.Helper()
+	if err == nil {
+		return
+	}
+	tb.Fatal(formatGQLFailure(err, op, vars))
+}
+
+func formatGQLFailure(err error, op string, vars any) string {
+	var b strings.Builder
+	// gqlerror.List messages end in a newline.
+	fmt.Fprintf(&b, "%s failed: %s\n", op, strings.TrimSpace(err.Error()))
+
+	b.WriteString("variables:\n")
+	if v, jsonErr := json.MarshalIndent(vars, "  ", "  "); jsonErr != nil {
+		fmt.Fprintf(&b, "  <unencodable: %v>\n", jsonErr)
+	} else {
+		fmt.Fprintf(&b, "  %s\n", v)
+	}
+
+	if te := TwispErrorFrom(err); te != nil {
+		b.WriteString("errors:\n")
+		for _, e := range te.Errors {
+			fmt.Fprintf(&b, "  %s\n", e)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,151 @@
+package eff
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// fatalRecorder captures Fatal output instead of ending the test.
+type fatalRecorder struct {
+	testing.TB
+	fatal string
+}
+
+func (r *fatalRecorder) Helper() {}
+
+func (r *fatalRecorder) Fatal(args ...any) { r.fatal = fmt.Sprint(args...) }
+
+func TestRequireNoGQLError(t *testing.T) {
+	stub := clientFunc(func(context.Context, *graphql.Request, *graphql.Response) error {
+		return gqlerror.List{{
+			Message:    "tran code SIMPLE not found",
+			Path:       ast.Path{ast.PathName("postTransaction")},
+			Extensions: map[string]any{"code": "NOT_FOUND"},
+		}}
+	})
+	txID := uuid.MustParse("0198c0de-0000-7000-8000-000000000001")
+	effective := NewDate(2026, time.January, 15)
+	_, err := PostTransaction(context.Background(), stub, txID, effective)
+	require.Error(t, err)
+
+	rec := &fatalRecorder{TB: t}
+	RequireNoGQLError(rec, err, "PostTransaction", map[string]any{
+		"transactionId": txID,
+		"effective":     &effective,
+	})
+	require.Equal(t, `PostTransaction failed: input: postTransaction tran code SIMPLE not found
+variables:
+  {
+    "effective": "2026-01-15",
+    "transactionId": "0198c0de-0000-7000-8000-000000000001"
+  }
+errors:
+  [NOT_FOUND] postTransaction: tran code SIMPLE not found
+`, rec.fatal)
+
+	rec = &fatalRecorder{TB: t}
+	RequireNoGQLError(rec, nil, "PostTransaction", nil)
+	require.Empty(t, rec.fatal)
+}
+
+func TestTwispErrorFrom(t *testing.T) {
+	require.Nil(t, TwispErrorFrom(nil))
+	require.Nil(t, TwispErrorFrom(errors.New("connection refused")))
+
+	err := fmt.Errorf("posting: %w", gqlerror.List{
+		{Message: "bad amount", Path: ast.Path{ast.PathName("postTransaction"), ast.PathIndex(0)}},
+		{Message: "unauthorized", Extensions: map[string]any{"code": "UNAUTHENTICATED"}},
+	})
+	te := TwispErrorFrom(err)
+	require.Equal(t, []GraphQLError{
+		{Message: "bad amount", Path: "postTransaction[0]"},
+		{Message: "unauthorized", Code: "UNAUTHENTICATED"},
+	}, te.Errors)
+	require.Equal(t, "postTransaction[0]: bad amount; [UNAUTHENTICATED] unauthorized", te.Error())
+	require.Same(t, te, TwispErrorFrom(fmt.Errorf("wrapped: %w", te)))
+}
+
+func TestTwispErrorStatusCode(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"errors": [{"message": "Cannot query field \"bogus\"", "extensions": {"code": "GRAPHQL_VALIDATION_FAILED"}}]}`)
+	}))
+	t.Cleanup(srv.Close)
+
+	client := (&TwispContainer{GraphQLEndpoint: srv.URL}).NewGraphQLClient(tenantHeader())
+	_, err := GetJournal(context.Background(), client, journalID)
+	require.Error(t, err)
+
+	var te *TwispError
+	require.ErrorAs(t, err, &te)
+	require.Equal(t, http.StatusBadRequest, te.StatusCode)
+	require.Equal(t, []GraphQLError{
+		{Message: `Cannot query field "bogus"`, Code: "GRAPHQL_VALIDATION_FAILED"},
+	}, te.Errors)
+	require.Contains(t, te.Error(), `HTTP 400 Bad Request: [GRAPHQL_VALIDATION_FAILED] Cannot query field "bogus"`)
+
+	// genqlient's error is still reachable.
+	var httpErr *graphql.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	require.Equal(t, http.StatusBadRequest, httpErr.StatusCode)
+
+	require.Equal(t, "HTTP 503 Service Unavailable", (&TwispError{StatusCode: http.StatusServiceUnavailable}).Error())
+}
+
+func TestWithPartialData(t *testing.T) {
+	ok, bad := balanceAlias(0, account1ID), balanceAlias(1, account2ID)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		fmt.Fprintf(w, `{
+			"data": {%q: {"available": {"normalBalance": {"units": "3.00"}}}, %q: null},
+			"errors": [{"message": "journal not found", "path": [%q], "extensions": {"code": "NOT_FOUND"}}]
+		}`, ok, bad, bad)
+	}))
+	t.Cleanup(srv.Close)
+	client := (&TwispContainer{GraphQLEndpoint: srv.URL}).NewGraphQLClient(tenantHeader())
+	reqs := []BalanceReq{
+		{AccountID: account1ID, JournalID: journalID},
+		{AccountID: account2ID, JournalID: uuid.New()},
+	}
+
+	// By default any error fails the call.
+	got, err := BatchBalances(context.Background(), client, reqs)
+	require.Error(t, err)
+	require.Nil(t, got)
+
+	got, err = BatchBalances(WithPartialData(context.Background()), client, reqs)
+	require.Equal(t, []Decimal{"3.00", ""}, got)
+	te := TwispErrorFrom(err)
+	require.NotNil(t, te)
+	require.Equal(t, []GraphQLError{{Message: "journal not found", Path: bad, Code: "NOT_FOUND"}}, te.Errors)
+
+	var out map[string]any
+	err = RawQuery(WithPartialData(context.Background()), client, `query Partial { a: __typename }`, nil, &out)
+	require.Error(t, err)
+	require.Contains(t, out, ok)
+	require.Nil(t, out[bad])
+
+	// Non-200 responses carry no data and still fail outright.
+	rejected := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"errors": [{"message": "bad request"}]}`)
+	}))
+	t.Cleanup(rejected.Close)
+	got, err = BatchBalances(WithPartialData(context.Background()), (&TwispContainer{GraphQLEndpoint: rejected.URL}).NewGraphQLClient(tenantHeader()), reqs)
+	require.Error(t, err)
+	require.Nil(t, got)
+}
@@ -0,0 +1,50 @@
+// Package shared shows how a test binary shares one Twisp container across
+// its tests with eff.SharedContainer.
+package shared
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/parsnips/eff"
+	"github.com/stretchr/testify/require"
+)
+
+// started is the container TestMain started; every test must see it.
+var started *eff.TwispContainer
+
+func TestMain(m *testing.M) {
+	tc, stop := eff.SharedContainer(m)
+	started = tc
+	code := m.Run()
+	stop()
+	os.Exit(code)
+}
+
+// newTenant returns a client for a fresh tenant on the shared container,
+// with a journal and two accounts set up.
+func newTenant(t *testing.T) *eff.TwispContainer {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	t.Cleanup(cancel)
+	tc := eff.Shared(t)
+	client := tc.NewGraphQLClient(http.Header{"x-twisp-account-id": {uuid.New().String()}})
+	_, err := eff.Setup(ctx, client, uuid.New(), uuid.New(), uuid.New(), uuid.New())
+	require.NoError(t, err)
+	return tc
+}
+
+func TestFirstTenant(t *testing.T) {
+	tc := newTenant(t)
+	require.Same(t, started, tc)
+	require.Equal(t, started.GraphQLEndpoint, tc.GraphQLEndpoint)
+}
+
+func TestSecondTenant(t *testing.T) {
+	tc := newTenant(t)
+	require.Same(t, started, tc)
+	require.Equal(t, started.GraphQLEndpoint, tc.GraphQLEndpoint)
+}
@@ -0,0 +1,194 @@
+package eff
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+)
+
+// Scenario is a seeded journal with named accounts and tran codes.
+// Every scenario gets a fresh journal ID and uniquely suffixed codes, so
+// several can be created against the same tenant without colliding.
+type Scenario struct {
+	JournalID uuid.UUID
+	// Currencies lists the currencies the scenario posts in; empty means
+	// USD only.
+	Currencies []CurrencyCode
+	accounts   map[string]ScenarioAccount
+	tranCodes  map[string]tranCodeRef
+
+	// DSL state; see scenario.go.
+	steps  []scenarioStep
+	cutoff Timestamp
+}
+
+type tranCodeRef struct {
+	id   uuid.UUID
+	code string
+}
+
+// ScenarioAccount identifies an account created by a Scenario.
+type ScenarioAccount struct {
+	ID   uuid.UUID
+	Code string
+}
+
+// Account returns the account registered under name. It panics if the
+// scenario has no such account.
+func (s *Scenario) Account(name string) ScenarioAccount {
+	a, ok := s.accounts[name]
+	if !ok {
+		panic(fmt.Sprintf("scenario has no account %q", name))
+	}
+	return a
+}
+
+// TranCode returns the code of the tran code registered under name. It
+// panics if the scenario has no such tran code.
+func (s *Scenario) TranCode(name string) string {
+	c, ok := s.tranCodes[name]
+	if !ok {
+		panic(fmt.Sprintf("scenario has no tran code %q", name))
+	}
+	return c.code
+}
+
+// Seed lists the records created by a fixture so they can be removed with
+// TeardownSeed.
+type Seed struct {
+	JournalID   uuid.UUID
+	AccountIDs  []uuid.UUID
+	TranCodeIDs []uuid.UUID
+}
+
+// Seed returns the records this scenario created.
+func (s *Scenario) Seed() Seed {
+	seed := Seed{JournalID: s.JournalID}
+	for _, a := range s.accounts {
+		seed.AccountIDs = append(seed.AccountIDs, a.ID)
+	}
+	for _, c := range s.tranCodes {
+		seed.TranCodeIDs = append(seed.TranCodeIDs, c.id)
+	}
+	return seed
+}
+
+// RetailBankingJournal creates a journal with customer "checking" and
+// "savings" accounts (credit normal), a bank "cash" account (debit normal)
+// and a "transfer" tran code that debits params.from and credits params.to.
+func RetailBankingJournal(ctx context.Context, client graphql.Client) (*Scenario, error) {
+	journalID := newRandomID()
+	suffix := strings.ToUpper(journalID.String()[:8])
+	code := func(prefix string) string { return prefix + "." + suffix }
+
+	s := &Scenario{
+		JournalID: journalID,
+		accounts: map[string]ScenarioAccount{
+			"checking": {ID: newRandomID(), Code: code("CHECKING")},
+			"savings":  {ID: newRandomID(), Code: code("SAVINGS")},
+			"cash":     {ID: newRandomID(), Code: code("CASH")},
+		},
+		tranCodes: map[string]tranCodeRef{
+			"transfer": {id: newRandomID(), code: code("TRANSFER")},
+		},
+	}
+
+	_, err := SetupRetailBanking(
+		ctx, client,
+		journalID, code("RETAIL"), fmt.Sprintf("uuid('%s')", journalID),
+		s.tranCodes["transfer"].id, s.tranCodes["transfer"].code,
+		s.accounts["checking"].ID, s.accounts["checking"].Code,
+		s.accounts["savings"].ID, s.accounts["savings"].Code,
+		s.accounts["cash"].ID, s.accounts["cash"].Code,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("setting up retail banking journal: %w", err)
+	}
+	return s, nil
+}
+
+// SetupMultiCurrency creates a RetailBankingJournal intended for postings
+// in each of currencies (via PostTransferInCurrency). Twisp keeps a separate
+// balance per currency; read them with BalancesByCurrency.
+func SetupMultiCurrency(ctx context.Context, client graphql.Client, currencies ...CurrencyCode) (*Scenario, error) {
+	if len(currencies) == 0 {
+		return nil, errors.New("setting up multi-currency journal: no currencies")
+	}
+	s, err := RetailBankingJournal(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	s.Currencies = slices.Clone(currencies)
+	return s, nil
+}
+
+// seedActivityStart is the first day SeedActivity posts on, matching the
+// January 2026 dates used throughout the tests.
+var seedActivityStart = NewDate(2026, time.January, 1)
+
+// ActivitySeed reports what SeedActivity posted.
+type ActivitySeed struct {
+	// Posted is the number of transactions posted.
+	Posted int
+	// Total is the sum of their amounts.
+	Total Decimal
+	// Months lists the "YYYY-MM" periods seeded, in order.
+	Months []string
+}
+
+// SeedActivity posts perMonth transfers from from to to in journalID for
+// each of months consecutive months from January 2026, using Transfer
+// (and so Setup's SIMPLE tran code) via BulkPostTransactions. Effective
+// dates are spread evenly across each month. Amounts range from 0.01 to
+// 100.00 and are pseudo-random with a seed derived from journalID, so
+// seeding the same journal again reproduces them.
+//
+// If any posting fails, SeedActivity still returns what was posted along
+// with the joined errors.
+func SeedActivity(ctx context.Context, client graphql.Client, journalID, from, to uuid.UUID, months int, perMonth int) (ActivitySeed, error) {
+	if months <= 0 || perMonth <= 0 {
+		return ActivitySeed{}, fmt.Errorf("seeding activity: months and perMonth must be positive, got %d and %d", months, perMonth)
+	}
+	rng := rand.New(rand.NewPCG(
+		binary.BigEndian.Uint64(journalID[:8]),
+		binary.BigEndian.Uint64(journalID[8:]),
+	))
+
+	var seed ActivitySeed
+	reqs := make([]TransferReq, 0, months*perMonth)
+	for m := range months {
+		first := seedActivityStart.AddDate(0, m, 0)
+		days := first.AddDate(0, 1, -1).Day()
+		seed.Months = append(seed.Months, first.Format("2006-01"))
+		for i := range perMonth {
+			reqs = append(reqs, TransferReq{
+				From:      from,
+				To:        to,
+				Amount:    DecimalFromMinorUnits(1+rng.Int64N(10000), 2),
+				Effective: Date{first.AddDate(0, 0, i*days/perMonth)},
+			})
+		}
+	}
+
+	r := BulkPostTransactions(ctx, client, journalID, reqs, BulkOptions{})
+	var total DecimalAcc
+	for i, err := range r.Errors {
+		if err == nil {
+			total.Add(reqs[i].Amount)
+		}
+	}
+	seed.Posted = r.Succeeded()
+	seed.Total = total.Decimal(2)
+	if err := r.Err(); err != nil {
+		return seed, fmt.Errorf("seeding activity: %w", err)
+	}
+	return seed, nil
+}
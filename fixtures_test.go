@@ -0,0 +1,105 @@
+package eff
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetailBankingJournal(t *testing.T) {
+	ctx, client := newLiveClient(t)
+
+	s, err := RetailBankingJournal(ctx, client)
+	require.NoError(t, err)
+
+	// A second scenario in the same tenant must not collide with the first.
+	other, err := RetailBankingJournal(ctx, client)
+	require.NoError(t, err)
+	require.NotEqual(t, s.JournalID, other.JournalID)
+	require.NotEqual(t, s.Account("checking").Code, other.Account("checking").Code)
+
+	txID := uuid.New()
+	resp, err := PostTransfer(
+		ctx, client,
+		txID, s.TranCode("transfer"),
+		s.Account("cash").ID, s.Account("checking").ID,
+		Decimal("25.00"), NewDate(2026, time.January, 2),
+	)
+	require.NoError(t, err)
+	require.Equal(t, txID, resp.PostTransaction.TransactionId)
+}
+
+func TestSeedActivityDeterministic(t *testing.T) {
+	record := func(journal uuid.UUID) (ActivitySeed, []TransferReq) {
+		var mu sync.Mutex
+		var posted []TransferReq
+		stub := clientFunc(func(_ context.Context, req *graphql.Request, _ *graphql.Response) error {
+			v := req.Variables.(*__PostSimpleTransferInput)
+			mu.Lock()
+			defer mu.Unlock()
+			posted = append(posted, TransferReq{From: v.From, To: v.To, Amount: v.Amount, Effective: v.Effective})
+			return nil
+		})
+		seed, err := SeedActivity(context.Background(), stub, journal, account2ID, account1ID, 2, 30)
+		require.NoError(t, err)
+		slices.SortFunc(posted, func(a, b TransferReq) int {
+			return cmp.Or(a.Effective.Compare(b.Effective.Time), strings.Compare(string(a.Amount), string(b.Amount)))
+		})
+		return seed, posted
+	}
+
+	seed, first := record(journalID)
+	require.Equal(t, 60, seed.Posted)
+	require.Equal(t, []string{"2026-01", "2026-02"}, seed.Months)
+	require.Len(t, first, 60)
+	var sum DecimalAcc
+	for _, p := range first {
+		sum.Add(p.Amount)
+		require.Positive(t, p.Amount.Cmp("0"))
+		require.LessOrEqual(t, p.Amount.Cmp("100.00"), 0)
+		require.True(t, p.Effective.Year() == 2026 && p.Effective.Month() <= time.February, p.Effective)
+	}
+	require.Equal(t, seed.Total, sum.Decimal(2))
+
+	again, second := record(journalID)
+	require.Equal(t, seed, again)
+	require.Equal(t, first, second, "same journal, same amounts")
+
+	other, _ := record(uuid.New())
+	require.NotEqual(t, seed.Total, other.Total)
+
+	_, err := SeedActivity(context.Background(), nil, journalID, account2ID, account1ID, 0, 1)
+	require.Error(t, err)
+}
+
+func TestSeedActivity(t *testing.T) {
+	ctx, client := newLiveClient(t)
+
+	_, err := CreateActivityIndex(ctx, client)
+	require.NoError(t, err)
+	_, err = Setup(ctx, client, journalID, tranCodeID, account1ID, account2ID)
+	require.NoError(t, err)
+
+	seed, err := SeedActivity(ctx, client, journalID, account2ID, account1ID, 2, 12)
+	require.NoError(t, err)
+	require.Equal(t, 24, seed.Posted)
+
+	var total DecimalAcc
+	for _, month := range seed.Months {
+		resp, err := ActivityQuery(ctx, client, Ptr(journalID.String()), Ptr(account1ID.String()), Ptr(month))
+		require.NoError(t, err)
+		require.Len(t, resp.Entries.Nodes, 12, month)
+		for _, n := range resp.Entries.Nodes {
+			total.Add(n.Amount.Units)
+		}
+	}
+	require.Equal(t, seed.Total, total.Decimal(2))
+}
@@ -9,22 +9,352 @@ import (
 	"github.com/google/uuid"
 )
 
-// ActivityQueryEntriesEntryConnection includes the requested fields of the GraphQL type EntryConnection.
+// AccountBalanceLayersBalance includes the requested fields of the GraphQL type Balance.
+// The GraphQL type's documentation follows.
+//
+// Balances are auto-calculated sums of the entries for a given account.
+//
+// Every balance record maintains a `drBalance` for entries on the debit side of the ledger and a `crBalance` for credit entries.
+//
+// Additionally, every account has a `normalBalance`, which is equal to `crBalance - drBalance` for credit normal accounts, and `drBalance - crBalance` for debit normal accounts.
+//
+// Each account can have balances across all three layers: SETTLED, PENDING, and ENCUMBRANCE.
+type AccountBalanceLayersBalance struct {
+	// The balance amounts on the settled layer.
+	Settled AccountBalanceLayersBalanceSettledBalanceAmount `json:"settled"`
+	// The balance amounts on the pending layer.
+	Pending AccountBalanceLayersBalancePendingBalanceAmount `json:"pending"`
+	// The balance amounts available by combining the provided layer with all layers above.
+	Available AccountBalanceLayersBalanceAvailableBalanceAmount `json:"available"`
+}
+
+// GetSettled returns AccountBalanceLayersBalance.Settled, and is useful for accessing the field via an interface.
+func (v *AccountBalanceLayersBalance) GetSettled() AccountBalanceLayersBalanceSettledBalanceAmount {
+	return v.Settled
+}
+
+// GetPending returns AccountBalanceLayersBalance.Pending, and is useful for accessing the field via an interface.
+func (v *AccountBalanceLayersBalance) GetPending() AccountBalanceLayersBalancePendingBalanceAmount {
+	return v.Pending
+}
+
+// GetAvailable returns AccountBalanceLayersBalance.Available, and is useful for accessing the field via an interface.
+func (v *AccountBalanceLayersBalance) GetAvailable() AccountBalanceLayersBalanceAvailableBalanceAmount {
+	return v.Available
+}
+
+// AccountBalanceLayersBalanceAvailableBalanceAmount includes the requested fields of the GraphQL type BalanceAmount.
+type AccountBalanceLayersBalanceAvailableBalanceAmount struct {
+	// The "normal balance" for an account is different for credit normal and debit normal accounts.
+	//
+	// For credit normal accounts, the normal balance is equal to `crBalance - drBalance`.
+	// For debit normal accounts, the normal balance is the reverse: `drBalance - crBalance`.
+	NormalBalance AccountBalanceLayersBalanceAvailableBalanceAmountNormalBalanceMoney `json:"normalBalance"`
+}
+
+// GetNormalBalance returns AccountBalanceLayersBalanceAvailableBalanceAmount.NormalBalance, and is useful for accessing the field via an interface.
+func (v *AccountBalanceLayersBalanceAvailableBalanceAmount) GetNormalBalance() AccountBalanceLayersBalanceAvailableBalanceAmountNormalBalanceMoney {
+	return v.NormalBalance
+}
+
+// AccountBalanceLayersBalanceAvailableBalanceAmountNormalBalanceMoney includes the requested fields of the GraphQL type Money.
+// The GraphQL type's documentation follows.
+//
+// Money type with multi-currency support.
+//
+// Monetary amounts are represented as decimal units of currency. Fields which use the Money type can be converted to a symbolic representations by specifying a MoneyFormatInput on the `formatted` field.
+//
+// Here is an example table showing different currencies which each have their own divisions of units represented. Japanese yen (JPY) don't have a decimal minor unit, and Bahraini dinars (BHD) use 3 minor unit decimal places. The `formatted` column uses the default values for a an `en-US` locale.
+//
+// | Currency | Units    | Formatted |
+// |----------|----------|-----------|
+// | USD      | `289.27` | $289.27   |
+// | BHD      | `28.927` | 28.927 BD |
+// | JPY      | `28927`  | ¥28927    |
+type AccountBalanceLayersBalanceAvailableBalanceAmountNormalBalanceMoney struct {
+	Units Decimal `json:"units"`
+}
+
+// GetUnits returns AccountBalanceLayersBalanceAvailableBalanceAmountNormalBalanceMoney.Units, and is useful for accessing the field via an interface.
+func (v *AccountBalanceLayersBalanceAvailableBalanceAmountNormalBalanceMoney) GetUnits() Decimal {
+	return v.Units
+}
+
+// AccountBalanceLayersBalancePendingBalanceAmount includes the requested fields of the GraphQL type BalanceAmount.
+type AccountBalanceLayersBalancePendingBalanceAmount struct {
+	// The "normal balance" for an account is different for credit normal and debit normal accounts.
+	//
+	// For credit normal accounts, the normal balance is equal to `crBalance - drBalance`.
+	// For debit normal accounts, the normal balance is the reverse: `drBalance - crBalance`.
+	NormalBalance AccountBalanceLayersBalancePendingBalanceAmountNormalBalanceMoney `json:"normalBalance"`
+}
+
+// GetNormalBalance returns AccountBalanceLayersBalancePendingBalanceAmount.NormalBalance, and is useful for accessing the field via an interface.
+func (v *AccountBalanceLayersBalancePendingBalanceAmount) GetNormalBalance() AccountBalanceLayersBalancePendingBalanceAmountNormalBalanceMoney {
+	return v.NormalBalance
+}
+
+// AccountBalanceLayersBalancePendingBalanceAmountNormalBalanceMoney includes the requested fields of the GraphQL type Money.
+// The GraphQL type's documentation follows.
+//
+// Money type with multi-currency support.
+//
+// Monetary amounts are represented as decimal units of currency. Fields which use the Money type can be converted to a symbolic representations by specifying a MoneyFormatInput on the `formatted` field.
+//
+// Here is an example table showing different currencies which each have their own divisions of units represented. Japanese yen (JPY) don't have a decimal minor unit, and Bahraini dinars (BHD) use 3 minor unit decimal places. The `formatted` column uses the default values for a an `en-US` locale.
+//
+// | Currency | Units    | Formatted |
+// |----------|----------|-----------|
+// | USD      | `289.27` | $289.27   |
+// | BHD      | `28.927` | 28.927 BD |
+// | JPY      | `28927`  | ¥28927    |
+type AccountBalanceLayersBalancePendingBalanceAmountNormalBalanceMoney struct {
+	Units Decimal `json:"units"`
+}
+
+// GetUnits returns AccountBalanceLayersBalancePendingBalanceAmountNormalBalanceMoney.Units, and is useful for accessing the field via an interface.
+func (v *AccountBalanceLayersBalancePendingBalanceAmountNormalBalanceMoney) GetUnits() Decimal {
+	return v.Units
+}
+
+// AccountBalanceLayersBalanceSettledBalanceAmount includes the requested fields of the GraphQL type BalanceAmount.
+type AccountBalanceLayersBalanceSettledBalanceAmount struct {
+	// The "normal balance" for an account is different for credit normal and debit normal accounts.
+	//
+	// For credit normal accounts, the normal balance is equal to `crBalance - drBalance`.
+	// For debit normal accounts, the normal balance is the reverse: `drBalance - crBalance`.
+	NormalBalance AccountBalanceLayersBalanceSettledBalanceAmountNormalBalanceMoney `json:"normalBalance"`
+}
+
+// GetNormalBalance returns AccountBalanceLayersBalanceSettledBalanceAmount.NormalBalance, and is useful for accessing the field via an interface.
+func (v *AccountBalanceLayersBalanceSettledBalanceAmount) GetNormalBalance() AccountBalanceLayersBalanceSettledBalanceAmountNormalBalanceMoney {
+	return v.NormalBalance
+}
+
+// AccountBalanceLayersBalanceSettledBalanceAmountNormalBalanceMoney includes the requested fields of the GraphQL type Money.
+// The GraphQL type's documentation follows.
+//
+// Money type with multi-currency support.
+//
+// Monetary amounts are represented as decimal units of currency. Fields which use the Money type can be converted to a symbolic representations by specifying a MoneyFormatInput on the `formatted` field.
+//
+// Here is an example table showing different currencies which each have their own divisions of units represented. Japanese yen (JPY) don't have a decimal minor unit, and Bahraini dinars (BHD) use 3 minor unit decimal places. The `formatted` column uses the default values for a an `en-US` locale.
+//
+// | Currency | Units    | Formatted |
+// |----------|----------|-----------|
+// | USD      | `289.27` | $289.27   |
+// | BHD      | `28.927` | 28.927 BD |
+// | JPY      | `28927`  | ¥28927    |
+type AccountBalanceLayersBalanceSettledBalanceAmountNormalBalanceMoney struct {
+	Units Decimal `json:"units"`
+}
+
+// GetUnits returns AccountBalanceLayersBalanceSettledBalanceAmountNormalBalanceMoney.Units, and is useful for accessing the field via an interface.
+func (v *AccountBalanceLayersBalanceSettledBalanceAmountNormalBalanceMoney) GetUnits() Decimal {
+	return v.Units
+}
+
+// AccountBalanceLayersResponse is returned by AccountBalanceLayers on success.
+type AccountBalanceLayersResponse struct {
+	// Get a balance for an account.
+	Balance *AccountBalanceLayersBalance `json:"balance"`
+}
+
+// GetBalance returns AccountBalanceLayersResponse.Balance, and is useful for accessing the field via an interface.
+func (v *AccountBalanceLayersResponse) GetBalance() *AccountBalanceLayersBalance { return v.Balance }
+
+// Fields to create a system configuration for an account.
+type AccountConfigInput struct {
+	// When `true`, allow concurrent posting to the account.
+	// See `BalanceType` for balance retrieval options available for concurrent-enabled accounts.
+	// Defaults to `false`.
+	EnableConcurrentPosting *bool `json:"enableConcurrentPosting"`
+	// When `true` use an upsert on the accountId index to upsert and avoid unique constraint violation.
+	//
+	// If account already created, the existing account is unchanged.
+	Upsert *bool `json:"upsert"`
+}
+
+// GetEnableConcurrentPosting returns AccountConfigInput.EnableConcurrentPosting, and is useful for accessing the field via an interface.
+func (v *AccountConfigInput) GetEnableConcurrentPosting() *bool { return v.EnableConcurrentPosting }
+
+// GetUpsert returns AccountConfigInput.Upsert, and is useful for accessing the field via an interface.
+func (v *AccountConfigInput) GetUpsert() *bool { return v.Upsert }
+
+// AccountCurrenciesBalancesBalanceConnection includes the requested fields of the GraphQL type BalanceConnection.
+// The GraphQL type's documentation follows.
+//
+// Connection to a list of Balance nodes.
+// Access Balance nodes directly through the `nodes` field, or access information about the connection edges with the `edges` field.
+// Use `pageInfo` to paginate responses using the cursors provided.
+type AccountCurrenciesBalancesBalanceConnection struct {
+	Nodes []*AccountCurrenciesBalancesBalanceConnectionNodesBalance `json:"nodes"`
+}
+
+// GetNodes returns AccountCurrenciesBalancesBalanceConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *AccountCurrenciesBalancesBalanceConnection) GetNodes() []*AccountCurrenciesBalancesBalanceConnectionNodesBalance {
+	return v.Nodes
+}
+
+// AccountCurrenciesBalancesBalanceConnectionNodesBalance includes the requested fields of the GraphQL type Balance.
+// The GraphQL type's documentation follows.
+//
+// Balances are auto-calculated sums of the entries for a given account.
+//
+// Every balance record maintains a `drBalance` for entries on the debit side of the ledger and a `crBalance` for credit entries.
+//
+// Additionally, every account has a `normalBalance`, which is equal to `crBalance - drBalance` for credit normal accounts, and `drBalance - crBalance` for debit normal accounts.
+//
+// Each account can have balances across all three layers: SETTLED, PENDING, and ENCUMBRANCE.
+type AccountCurrenciesBalancesBalanceConnectionNodesBalance struct {
+	// The currency of the balance amounts.
+	//
+	// Balances represent the sum of entries using the same currency. Multi-currency ledgers will therefore have different balances for each currency.
+	Currency string `json:"currency"`
+}
+
+// GetCurrency returns AccountCurrenciesBalancesBalanceConnectionNodesBalance.Currency, and is useful for accessing the field via an interface.
+func (v *AccountCurrenciesBalancesBalanceConnectionNodesBalance) GetCurrency() string {
+	return v.Currency
+}
+
+// AccountCurrenciesResponse is returned by AccountCurrencies on success.
+type AccountCurrenciesResponse struct {
+	// Select one or more balances. Specify the index to use and apply filters to your query.
+	Balances AccountCurrenciesBalancesBalanceConnection `json:"balances"`
+}
+
+// GetBalances returns AccountCurrenciesResponse.Balances, and is useful for accessing the field via an interface.
+func (v *AccountCurrenciesResponse) GetBalances() AccountCurrenciesBalancesBalanceConnection {
+	return v.Balances
+}
+
+// Fields to create a new account.
+type AccountInput struct {
+	// Unique identifier for the account.
+	AccountId uuid.UUID `json:"accountId"`
+	// Allows specifying a unique external ID associated with this account.
+	ExternalId *string `json:"externalId"`
+	// Shorthand code for the account.
+	Code string `json:"code"`
+	// Account name.
+	Name string `json:"name"`
+	// Determines whether account should use a debit- or credit-normal balance.
+	NormalBalanceType DebitOrCredit `json:"normalBalanceType"`
+	// IDs of AccountSets to add this account to.
+	AccountSetIds []*uuid.UUID `json:"accountSetIds"`
+	// Description of the account.
+	Description *string `json:"description"`
+	// Current status for the account.
+	Status Status `json:"status"`
+	// Metadata attached to this account.
+	Metadata *map[string]interface{} `json:"metadata"`
+	// System config for the account.
+	Config *AccountConfigInput `json:"config"`
+}
+
+// GetAccountId returns AccountInput.AccountId, and is useful for accessing the field via an interface.
+func (v *AccountInput) GetAccountId() uuid.UUID { return v.AccountId }
+
+// GetExternalId returns AccountInput.ExternalId, and is useful for accessing the field via an interface.
+func (v *AccountInput) GetExternalId() *string { return v.ExternalId }
+
+// GetCode returns AccountInput.Code, and is useful for accessing the field via an interface.
+func (v *AccountInput) GetCode() string { return v.Code }
+
+// GetName returns AccountInput.Name, and is useful for accessing the field via an interface.
+func (v *AccountInput) GetName() string { return v.Name }
+
+// GetNormalBalanceType returns AccountInput.NormalBalanceType, and is useful for accessing the field via an interface.
+func (v *AccountInput) GetNormalBalanceType() DebitOrCredit { return v.NormalBalanceType }
+
+// GetAccountSetIds returns AccountInput.AccountSetIds, and is useful for accessing the field via an interface.
+func (v *AccountInput) GetAccountSetIds() []*uuid.UUID { return v.AccountSetIds }
+
+// GetDescription returns AccountInput.Description, and is useful for accessing the field via an interface.
+func (v *AccountInput) GetDescription() *string { return v.Description }
+
+// GetStatus returns AccountInput.Status, and is useful for accessing the field via an interface.
+func (v *AccountInput) GetStatus() Status { return v.Status }
+
+// GetMetadata returns AccountInput.Metadata, and is useful for accessing the field via an interface.
+func (v *AccountInput) GetMetadata() *map[string]interface{} { return v.Metadata }
+
+// GetConfig returns AccountInput.Config, and is useful for accessing the field via an interface.
+func (v *AccountInput) GetConfig() *AccountConfigInput { return v.Config }
+
+// AccountLockStatusAccount includes the requested fields of the GraphQL type Account.
+// The GraphQL type's documentation follows.
+//
+// Accounts model all of the economic activity that your ledger provides.
+//
+// The chart of accounts is the basis for creating balance sheets, P&L reports, and for understanding the balances for the customer and business entities your business services.
+//
+// Accounts can be organized into sets with the AccountSet type. Hierarchical tree structures which roll up balances across many accounts can be modeled by nesting sets within other sets.
+type AccountLockStatusAccount struct {
+	// Unique identifier for the account.
+	AccountId uuid.UUID `json:"accountId"`
+	// Current status for the account.
+	Status AccountStatus `json:"status"`
+}
+
+// GetAccountId returns AccountLockStatusAccount.AccountId, and is useful for accessing the field via an interface.
+func (v *AccountLockStatusAccount) GetAccountId() uuid.UUID { return v.AccountId }
+
+// GetStatus returns AccountLockStatusAccount.Status, and is useful for accessing the field via an interface.
+func (v *AccountLockStatusAccount) GetStatus() AccountStatus { return v.Status }
+
+// AccountLockStatusResponse is returned by AccountLockStatus on success.
+type AccountLockStatusResponse struct {
+	// Get a single account by its `accountId`.
+	Account *AccountLockStatusAccount `json:"account"`
+}
+
+// GetAccount returns AccountLockStatusResponse.Account, and is useful for accessing the field via an interface.
+func (v *AccountLockStatusResponse) GetAccount() *AccountLockStatusAccount { return v.Account }
+
+// Account status determines whether the account is in active use or closed (locked). By default, all accounts are `ACTIVE`.
+//
+// When account is `LOCKED`, it cannot be changed and any attempt to write a ledger entry to this account will raise an error.
+type AccountStatus string
+
+const (
+	// ACTIVE = Account is open for posting.
+	AccountStatusActive AccountStatus = "ACTIVE"
+	// LOCKED = Account is locked and will block posting.
+	AccountStatusLocked AccountStatus = "LOCKED"
+	// INACTIVE = Account is open for posting but will be LOCKED soon.
+	AccountStatusInactive AccountStatus = "INACTIVE"
+)
+
+var AllAccountStatus = []AccountStatus{
+	AccountStatusActive,
+	AccountStatusLocked,
+	AccountStatusInactive,
+}
+
+// ActivityIndexQueryEntriesEntryConnection includes the requested fields of the GraphQL type EntryConnection.
 // The GraphQL type's documentation follows.
 //
 // Connection to a list of Entry nodes.
 // Access Entry nodes directly through the `nodes` field, or access information about the connection edges with the `edges` field.
 // Use `pageInfo` to paginate responses using the cursors provided.
-type ActivityQueryEntriesEntryConnection struct {
-	Nodes []*ActivityQueryEntriesEntryConnectionNodesEntry `json:"nodes"`
+type ActivityIndexQueryEntriesEntryConnection struct {
+	Nodes    []*ActivityIndexQueryEntriesEntryConnectionNodesEntry `json:"nodes"`
+	PageInfo ActivityIndexQueryEntriesEntryConnectionPageInfo      `json:"pageInfo"`
 }
 
-// GetNodes returns ActivityQueryEntriesEntryConnection.Nodes, and is useful for accessing the field via an interface.
-func (v *ActivityQueryEntriesEntryConnection) GetNodes() []*ActivityQueryEntriesEntryConnectionNodesEntry {
+// GetNodes returns ActivityIndexQueryEntriesEntryConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ActivityIndexQueryEntriesEntryConnection) GetNodes() []*ActivityIndexQueryEntriesEntryConnectionNodesEntry {
 	return v.Nodes
 }
 
-// ActivityQueryEntriesEntryConnectionNodesEntry includes the requested fields of the GraphQL type Entry.
+// GetPageInfo returns ActivityIndexQueryEntriesEntryConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *ActivityIndexQueryEntriesEntryConnection) GetPageInfo() ActivityIndexQueryEntriesEntryConnectionPageInfo {
+	return v.PageInfo
+}
+
+// ActivityIndexQueryEntriesEntryConnectionNodesEntry includes the requested fields of the GraphQL type Entry.
 // The GraphQL type's documentation follows.
 //
 // An entry represents one side of a transaction in a ledger. In other systems, these may be called "ledger lines" or "journal entries".
@@ -32,31 +362,43 @@ func (v *ActivityQueryEntriesEntryConnection) GetNodes() []*ActivityQueryEntries
 // Entries always have an account, amount, and direction (CREDIT or DEBIT). In addition, Twisp uses the concept of "entry types" to assign every entry to a categorical type.
 //
 // Twisp enforces double-entry accounting, which in practice means that entries can only be entered in the context of a Transaction. Posting a transaction will create _at least 2_ ledger entries.
-type ActivityQueryEntriesEntryConnectionNodesEntry struct {
+type ActivityIndexQueryEntriesEntryConnectionNodesEntry struct {
+	// Unique identifier for the ledger entry.
+	EntryId uuid.UUID `json:"entryId"`
+	// The journal identifier of the ledger entry.
+	JournalId uuid.UUID `json:"journalId"`
 	// Arbitrary structured data about this entry.
 	Metadata *map[string]interface{} `json:"metadata"`
 	// Amount of the ledger entry using the currency-supported Money type.
-	Amount ActivityQueryEntriesEntryConnectionNodesEntryAmountMoney `json:"amount"`
+	Amount ActivityIndexQueryEntriesEntryConnectionNodesEntryAmountMoney `json:"amount"`
 	// Reference to the transaction which posted this entry.
-	Transaction ActivityQueryEntriesEntryConnectionNodesEntryTransaction `json:"transaction"`
+	Transaction ActivityIndexQueryEntriesEntryConnectionNodesEntryTransaction `json:"transaction"`
 }
 
-// GetMetadata returns ActivityQueryEntriesEntryConnectionNodesEntry.Metadata, and is useful for accessing the field via an interface.
-func (v *ActivityQueryEntriesEntryConnectionNodesEntry) GetMetadata() *map[string]interface{} {
+// GetEntryId returns ActivityIndexQueryEntriesEntryConnectionNodesEntry.EntryId, and is useful for accessing the field via an interface.
+func (v *ActivityIndexQueryEntriesEntryConnectionNodesEntry) GetEntryId() uuid.UUID { return v.EntryId }
+
+// GetJournalId returns ActivityIndexQueryEntriesEntryConnectionNodesEntry.JournalId, and is useful for accessing the field via an interface.
+func (v *ActivityIndexQueryEntriesEntryConnectionNodesEntry) GetJournalId() uuid.UUID {
+	return v.JournalId
+}
+
+// GetMetadata returns ActivityIndexQueryEntriesEntryConnectionNodesEntry.Metadata, and is useful for accessing the field via an interface.
+func (v *ActivityIndexQueryEntriesEntryConnectionNodesEntry) GetMetadata() *map[string]interface{} {
 	return v.Metadata
 }
 
-// GetAmount returns ActivityQueryEntriesEntryConnectionNodesEntry.Amount, and is useful for accessing the field via an interface.
-func (v *ActivityQueryEntriesEntryConnectionNodesEntry) GetAmount() ActivityQueryEntriesEntryConnectionNodesEntryAmountMoney {
+// GetAmount returns ActivityIndexQueryEntriesEntryConnectionNodesEntry.Amount, and is useful for accessing the field via an interface.
+func (v *ActivityIndexQueryEntriesEntryConnectionNodesEntry) GetAmount() ActivityIndexQueryEntriesEntryConnectionNodesEntryAmountMoney {
 	return v.Amount
 }
 
-// GetTransaction returns ActivityQueryEntriesEntryConnectionNodesEntry.Transaction, and is useful for accessing the field via an interface.
-func (v *ActivityQueryEntriesEntryConnectionNodesEntry) GetTransaction() ActivityQueryEntriesEntryConnectionNodesEntryTransaction {
+// GetTransaction returns ActivityIndexQueryEntriesEntryConnectionNodesEntry.Transaction, and is useful for accessing the field via an interface.
+func (v *ActivityIndexQueryEntriesEntryConnectionNodesEntry) GetTransaction() ActivityIndexQueryEntriesEntryConnectionNodesEntryTransaction {
 	return v.Transaction
 }
 
-// ActivityQueryEntriesEntryConnectionNodesEntryAmountMoney includes the requested fields of the GraphQL type Money.
+// ActivityIndexQueryEntriesEntryConnectionNodesEntryAmountMoney includes the requested fields of the GraphQL type Money.
 // The GraphQL type's documentation follows.
 //
 // Money type with multi-currency support.
@@ -70,14 +412,16 @@ func (v *ActivityQueryEntriesEntryConnectionNodesEntry) GetTransaction() Activit
 // | USD      | `289.27` | $289.27   |
 // | BHD      | `28.927` | 28.927 BD |
 // | JPY      | `28927`  | ¥28927    |
-type ActivityQueryEntriesEntryConnectionNodesEntryAmountMoney struct {
+type ActivityIndexQueryEntriesEntryConnectionNodesEntryAmountMoney struct {
 	Units Decimal `json:"units"`
 }
 
-// GetUnits returns ActivityQueryEntriesEntryConnectionNodesEntryAmountMoney.Units, and is useful for accessing the field via an interface.
-func (v *ActivityQueryEntriesEntryConnectionNodesEntryAmountMoney) GetUnits() Decimal { return v.Units }
+// GetUnits returns ActivityIndexQueryEntriesEntryConnectionNodesEntryAmountMoney.Units, and is useful for accessing the field via an interface.
+func (v *ActivityIndexQueryEntriesEntryConnectionNodesEntryAmountMoney) GetUnits() Decimal {
+	return v.Units
+}
 
-// ActivityQueryEntriesEntryConnectionNodesEntryTransaction includes the requested fields of the GraphQL type Transaction.
+// ActivityIndexQueryEntriesEntryConnectionNodesEntryTransaction includes the requested fields of the GraphQL type Transaction.
 // The GraphQL type's documentation follows.
 //
 // Transactions record all accounting events in the ledger. In Twisp, the only way to write to a ledger is through a transaction.
@@ -85,39 +429,39 @@ func (v *ActivityQueryEntriesEntryConnectionNodesEntryAmountMoney) GetUnits() De
 // Every transaction writes two or more entries to the ledger in standard double-entry accounting practice.
 //
 // Twisp expands upon the basic principle of an accounting transaction with additional features like transaction codes and correlations.
-type ActivityQueryEntriesEntryConnectionNodesEntryTransaction struct {
+type ActivityIndexQueryEntriesEntryConnectionNodesEntryTransaction struct {
 	// Arbitrary structured data about this transaction.
 	Metadata *map[string]interface{} `json:"metadata"`
 	// Ledger entries written by the transaction.
-	Entries ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnection `json:"entries"`
+	Entries ActivityIndexQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnection `json:"entries"`
 }
 
-// GetMetadata returns ActivityQueryEntriesEntryConnectionNodesEntryTransaction.Metadata, and is useful for accessing the field via an interface.
-func (v *ActivityQueryEntriesEntryConnectionNodesEntryTransaction) GetMetadata() *map[string]interface{} {
+// GetMetadata returns ActivityIndexQueryEntriesEntryConnectionNodesEntryTransaction.Metadata, and is useful for accessing the field via an interface.
+func (v *ActivityIndexQueryEntriesEntryConnectionNodesEntryTransaction) GetMetadata() *map[string]interface{} {
 	return v.Metadata
 }
 
-// GetEntries returns ActivityQueryEntriesEntryConnectionNodesEntryTransaction.Entries, and is useful for accessing the field via an interface.
-func (v *ActivityQueryEntriesEntryConnectionNodesEntryTransaction) GetEntries() ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnection {
+// GetEntries returns ActivityIndexQueryEntriesEntryConnectionNodesEntryTransaction.Entries, and is useful for accessing the field via an interface.
+func (v *ActivityIndexQueryEntriesEntryConnectionNodesEntryTransaction) GetEntries() ActivityIndexQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnection {
 	return v.Entries
 }
 
-// ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnection includes the requested fields of the GraphQL type EntryConnection.
+// ActivityIndexQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnection includes the requested fields of the GraphQL type EntryConnection.
 // The GraphQL type's documentation follows.
 //
 // Connection to a list of Entry nodes.
 // Access Entry nodes directly through the `nodes` field, or access information about the connection edges with the `edges` field.
 // Use `pageInfo` to paginate responses using the cursors provided.
-type ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnection struct {
-	Nodes []*ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntry `json:"nodes"`
+type ActivityIndexQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnection struct {
+	Nodes []*ActivityIndexQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntry `json:"nodes"`
 }
 
-// GetNodes returns ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnection.Nodes, and is useful for accessing the field via an interface.
-func (v *ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnection) GetNodes() []*ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntry {
+// GetNodes returns ActivityIndexQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ActivityIndexQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnection) GetNodes() []*ActivityIndexQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntry {
 	return v.Nodes
 }
 
-// ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntry includes the requested fields of the GraphQL type Entry.
+// ActivityIndexQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntry includes the requested fields of the GraphQL type Entry.
 // The GraphQL type's documentation follows.
 //
 // An entry represents one side of a transaction in a ledger. In other systems, these may be called "ledger lines" or "journal entries".
@@ -125,17 +469,17 @@ func (v *ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryCon
 // Entries always have an account, amount, and direction (CREDIT or DEBIT). In addition, Twisp uses the concept of "entry types" to assign every entry to a categorical type.
 //
 // Twisp enforces double-entry accounting, which in practice means that entries can only be entered in the context of a Transaction. Posting a transaction will create _at least 2_ ledger entries.
-type ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntry struct {
+type ActivityIndexQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntry struct {
 	// Reference to the account to be debited/credited.
-	Account ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntryAccount `json:"account"`
+	Account ActivityIndexQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntryAccount `json:"account"`
 }
 
-// GetAccount returns ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntry.Account, and is useful for accessing the field via an interface.
-func (v *ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntry) GetAccount() ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntryAccount {
+// GetAccount returns ActivityIndexQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntry.Account, and is useful for accessing the field via an interface.
+func (v *ActivityIndexQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntry) GetAccount() ActivityIndexQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntryAccount {
 	return v.Account
 }
 
-// ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntryAccount includes the requested fields of the GraphQL type Account.
+// ActivityIndexQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntryAccount includes the requested fields of the GraphQL type Account.
 // The GraphQL type's documentation follows.
 //
 // Accounts model all of the economic activity that your ledger provides.
@@ -143,123 +487,113 @@ func (v *ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryCon
 // The chart of accounts is the basis for creating balance sheets, P&L reports, and for understanding the balances for the customer and business entities your business services.
 //
 // Accounts can be organized into sets with the AccountSet type. Hierarchical tree structures which roll up balances across many accounts can be modeled by nesting sets within other sets.
-type ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntryAccount struct {
+type ActivityIndexQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntryAccount struct {
 	// Shorthand code for the account, often an abbreviated version of the account name.
 	// Example: 'ACH_RECON' for an account named 'ACH Reconciliation'.
 	Code string `json:"code"`
 }
 
-// GetCode returns ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntryAccount.Code, and is useful for accessing the field via an interface.
-func (v *ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntryAccount) GetCode() string {
+// GetCode returns ActivityIndexQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntryAccount.Code, and is useful for accessing the field via an interface.
+func (v *ActivityIndexQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntryAccount) GetCode() string {
 	return v.Code
 }
 
-// ActivityQueryResponse is returned by ActivityQuery on success.
-type ActivityQueryResponse struct {
-	// Select one or more entries. Specify the index to use and apply filters to your query.
-	Entries ActivityQueryEntriesEntryConnection `json:"entries"`
+// ActivityIndexQueryEntriesEntryConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type ActivityIndexQueryEntriesEntryConnectionPageInfo struct {
+	// True if there are nodes in the connection after the current page / end cursor.
+	HasNextPage bool `json:"hasNextPage"`
+	// Query cursor for the last node in the current page.
+	EndCursor *string `json:"endCursor"`
 }
 
-// GetEntries returns ActivityQueryResponse.Entries, and is useful for accessing the field via an interface.
-func (v *ActivityQueryResponse) GetEntries() ActivityQueryEntriesEntryConnection { return v.Entries }
+// GetHasNextPage returns ActivityIndexQueryEntriesEntryConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *ActivityIndexQueryEntriesEntryConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
 
-// CreateActivityIndexResponse is returned by CreateActivityIndex on success.
-type CreateActivityIndexResponse struct {
-	// Mutations in the `schema` namespace are used to manage custom indexes, aggregates, and historical indexes. Use the `schema` namespace to create and delete indexes and aggregates.
-	Schema CreateActivityIndexSchemaSchemaMutation `json:"schema"`
+// GetEndCursor returns ActivityIndexQueryEntriesEntryConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *ActivityIndexQueryEntriesEntryConnectionPageInfo) GetEndCursor() *string { return v.EndCursor }
+
+// ActivityIndexQueryResponse is returned by ActivityIndexQuery on success.
+type ActivityIndexQueryResponse struct {
+	// Select one or more entries. Specify the index to use and apply filters to your query.
+	Entries ActivityIndexQueryEntriesEntryConnection `json:"entries"`
 }
 
-// GetSchema returns CreateActivityIndexResponse.Schema, and is useful for accessing the field via an interface.
-func (v *CreateActivityIndexResponse) GetSchema() CreateActivityIndexSchemaSchemaMutation {
-	return v.Schema
+// GetEntries returns ActivityIndexQueryResponse.Entries, and is useful for accessing the field via an interface.
+func (v *ActivityIndexQueryResponse) GetEntries() ActivityIndexQueryEntriesEntryConnection {
+	return v.Entries
 }
 
-// CreateActivityIndexSchemaSchemaMutation includes the requested fields of the GraphQL type SchemaMutation.
-type CreateActivityIndexSchemaSchemaMutation struct {
-	// Create a custom index for querying records. Currently available for indexing Account, AccountSet, Balance, Entry, Transaction, and TranCode record types.
-	//
-	// To query the index, use the `CUSTOM` index type for the applicable resource query and supply the filter inputs specified by the index.
-	//
-	// Custom indexes can be created using fields on the root level of the record like `Account.modified` as well as nested fields within documents like the `metadata` object.
-	//
-	// Depending on the parameters defined, custom indexes may be structured to return a single record or a sorted list of records.
-	//
-	// Note that due to the scaling properties of the underlying database, a single partition supports a fixed amount of read bandwidth and individual write operations per second. Beyond that threshold, throttling will occur. Visit scaling properties for more information.
-	//
-	// When designing custom indexes, care must be taken to ensure that reads and writes are spread across a sufficient number of partitions to support peak workloads. In practice, partitioning by account is usually sufficient. Our technical support staff is available for guidance on partition design patterns at [support@twisp.com](mailto:support@twisp.com).
-	//
-	// To learn more about indexes within the Twisp FLDB, see [Index-First Design](https://www.twisp.com/docs/infrastructure/ledger-database#index-first-design) in the docs.
-	CreateIndex CreateActivityIndexSchemaSchemaMutationCreateIndex `json:"createIndex"`
+// ActivityQueryEntriesEntryConnection includes the requested fields of the GraphQL type EntryConnection.
+// The GraphQL type's documentation follows.
+//
+// Connection to a list of Entry nodes.
+// Access Entry nodes directly through the `nodes` field, or access information about the connection edges with the `edges` field.
+// Use `pageInfo` to paginate responses using the cursors provided.
+type ActivityQueryEntriesEntryConnection struct {
+	Nodes []*ActivityQueryEntriesEntryConnectionNodesEntry `json:"nodes"`
 }
 
-// GetCreateIndex returns CreateActivityIndexSchemaSchemaMutation.CreateIndex, and is useful for accessing the field via an interface.
-func (v *CreateActivityIndexSchemaSchemaMutation) GetCreateIndex() CreateActivityIndexSchemaSchemaMutationCreateIndex {
-	return v.CreateIndex
+// GetNodes returns ActivityQueryEntriesEntryConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ActivityQueryEntriesEntryConnection) GetNodes() []*ActivityQueryEntriesEntryConnectionNodesEntry {
+	return v.Nodes
 }
 
-// CreateActivityIndexSchemaSchemaMutationCreateIndex includes the requested fields of the GraphQL type Index.
-type CreateActivityIndexSchemaSchemaMutationCreateIndex struct {
-	// The type of record this index applies to.
-	On IndexOnEnum `json:"on"`
+// ActivityQueryEntriesEntryConnectionNodesEntry includes the requested fields of the GraphQL type Entry.
+// The GraphQL type's documentation follows.
+//
+// An entry represents one side of a transaction in a ledger. In other systems, these may be called "ledger lines" or "journal entries".
+//
+// Entries always have an account, amount, and direction (CREDIT or DEBIT). In addition, Twisp uses the concept of "entry types" to assign every entry to a categorical type.
+//
+// Twisp enforces double-entry accounting, which in practice means that entries can only be entered in the context of a Transaction. Posting a transaction will create _at least 2_ ledger entries.
+type ActivityQueryEntriesEntryConnectionNodesEntry struct {
+	// Arbitrary structured data about this entry.
+	Metadata *map[string]interface{} `json:"metadata"`
+	// Amount of the ledger entry using the currency-supported Money type.
+	Amount ActivityQueryEntriesEntryConnectionNodesEntryAmountMoney `json:"amount"`
+	// Reference to the transaction which posted this entry.
+	Transaction ActivityQueryEntriesEntryConnectionNodesEntryTransaction `json:"transaction"`
 }
 
-// GetOn returns CreateActivityIndexSchemaSchemaMutationCreateIndex.On, and is useful for accessing the field via an interface.
-func (v *CreateActivityIndexSchemaSchemaMutationCreateIndex) GetOn() IndexOnEnum { return v.On }
-
-// Record types which support custom indexes.
-type IndexOnEnum string
+// GetMetadata returns ActivityQueryEntriesEntryConnectionNodesEntry.Metadata, and is useful for accessing the field via an interface.
+func (v *ActivityQueryEntriesEntryConnectionNodesEntry) GetMetadata() *map[string]interface{} {
+	return v.Metadata
+}
 
-const (
-	IndexOnEnumAccount     IndexOnEnum = "Account"
-	IndexOnEnumAccountset  IndexOnEnum = "AccountSet"
-	IndexOnEnumBalance     IndexOnEnum = "Balance"
-	IndexOnEnumTransaction IndexOnEnum = "Transaction"
-	IndexOnEnumTrancode    IndexOnEnum = "TranCode"
-	IndexOnEnumEntry       IndexOnEnum = "Entry"
-)
+// GetAmount returns ActivityQueryEntriesEntryConnectionNodesEntry.Amount, and is useful for accessing the field via an interface.
+func (v *ActivityQueryEntriesEntryConnectionNodesEntry) GetAmount() ActivityQueryEntriesEntryConnectionNodesEntryAmountMoney {
+	return v.Amount
+}
 
-var AllIndexOnEnum = []IndexOnEnum{
-	IndexOnEnumAccount,
-	IndexOnEnumAccountset,
-	IndexOnEnumBalance,
-	IndexOnEnumTransaction,
-	IndexOnEnumTrancode,
-	IndexOnEnumEntry,
+// GetTransaction returns ActivityQueryEntriesEntryConnectionNodesEntry.Transaction, and is useful for accessing the field via an interface.
+func (v *ActivityQueryEntriesEntryConnectionNodesEntry) GetTransaction() ActivityQueryEntriesEntryConnectionNodesEntryTransaction {
+	return v.Transaction
 }
 
-// PostTransactionPostTransaction includes the requested fields of the GraphQL type Transaction.
+// ActivityQueryEntriesEntryConnectionNodesEntryAmountMoney includes the requested fields of the GraphQL type Money.
 // The GraphQL type's documentation follows.
 //
-// Transactions record all accounting events in the ledger. In Twisp, the only way to write to a ledger is through a transaction.
+// Money type with multi-currency support.
 //
-// Every transaction writes two or more entries to the ledger in standard double-entry accounting practice.
+// Monetary amounts are represented as decimal units of currency. Fields which use the Money type can be converted to a symbolic representations by specifying a MoneyFormatInput on the `formatted` field.
 //
-// Twisp expands upon the basic principle of an accounting transaction with additional features like transaction codes and correlations.
-type PostTransactionPostTransaction struct {
-	// Unique identifier for the transaction.
-	TransactionId uuid.UUID `json:"transactionId"`
-	// Date and time when the transaction was first posted.
-	Created Timestamp `json:"created"`
-}
-
-// GetTransactionId returns PostTransactionPostTransaction.TransactionId, and is useful for accessing the field via an interface.
-func (v *PostTransactionPostTransaction) GetTransactionId() uuid.UUID { return v.TransactionId }
-
-// GetCreated returns PostTransactionPostTransaction.Created, and is useful for accessing the field via an interface.
-func (v *PostTransactionPostTransaction) GetCreated() Timestamp { return v.Created }
-
-// PostTransactionResponse is returned by PostTransaction on success.
-type PostTransactionResponse struct {
-	// Write a transaction to the ledger using the predefined defaults from the `tranCode` provided.
-	PostTransaction PostTransactionPostTransaction `json:"postTransaction"`
+// Here is an example table showing different currencies which each have their own divisions of units represented. Japanese yen (JPY) don't have a decimal minor unit, and Bahraini dinars (BHD) use 3 minor unit decimal places. The `formatted` column uses the default values for a an `en-US` locale.
+//
+// | Currency | Units    | Formatted |
+// |----------|----------|-----------|
+// | USD      | `289.27` | $289.27   |
+// | BHD      | `28.927` | 28.927 BD |
+// | JPY      | `28927`  | ¥28927    |
+type ActivityQueryEntriesEntryConnectionNodesEntryAmountMoney struct {
+	Units Decimal `json:"units"`
 }
 
-// GetPostTransaction returns PostTransactionResponse.PostTransaction, and is useful for accessing the field via an interface.
-func (v *PostTransactionResponse) GetPostTransaction() PostTransactionPostTransaction {
-	return v.PostTransaction
-}
+// GetUnits returns ActivityQueryEntriesEntryConnectionNodesEntryAmountMoney.Units, and is useful for accessing the field via an interface.
+func (v *ActivityQueryEntriesEntryConnectionNodesEntryAmountMoney) GetUnits() Decimal { return v.Units }
 
-// PostTransactionWithStatementDatePostTransaction includes the requested fields of the GraphQL type Transaction.
+// ActivityQueryEntriesEntryConnectionNodesEntryTransaction includes the requested fields of the GraphQL type Transaction.
 // The GraphQL type's documentation follows.
 //
 // Transactions record all accounting events in the ledger. In Twisp, the only way to write to a ledger is through a transaction.
@@ -267,84 +601,57 @@ func (v *PostTransactionResponse) GetPostTransaction() PostTransactionPostTransa
 // Every transaction writes two or more entries to the ledger in standard double-entry accounting practice.
 //
 // Twisp expands upon the basic principle of an accounting transaction with additional features like transaction codes and correlations.
-type PostTransactionWithStatementDatePostTransaction struct {
-	// Unique identifier for the transaction.
-	TransactionId uuid.UUID `json:"transactionId"`
-	// Date and time when the transaction was first posted.
-	Created Timestamp `json:"created"`
-}
-
-// GetTransactionId returns PostTransactionWithStatementDatePostTransaction.TransactionId, and is useful for accessing the field via an interface.
-func (v *PostTransactionWithStatementDatePostTransaction) GetTransactionId() uuid.UUID {
-	return v.TransactionId
+type ActivityQueryEntriesEntryConnectionNodesEntryTransaction struct {
+	// Arbitrary structured data about this transaction.
+	Metadata *map[string]interface{} `json:"metadata"`
+	// Ledger entries written by the transaction.
+	Entries ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnection `json:"entries"`
 }
 
-// GetCreated returns PostTransactionWithStatementDatePostTransaction.Created, and is useful for accessing the field via an interface.
-func (v *PostTransactionWithStatementDatePostTransaction) GetCreated() Timestamp { return v.Created }
-
-// PostTransactionWithStatementDateResponse is returned by PostTransactionWithStatementDate on success.
-type PostTransactionWithStatementDateResponse struct {
-	// Write a transaction to the ledger using the predefined defaults from the `tranCode` provided.
-	PostTransaction PostTransactionWithStatementDatePostTransaction `json:"postTransaction"`
+// GetMetadata returns ActivityQueryEntriesEntryConnectionNodesEntryTransaction.Metadata, and is useful for accessing the field via an interface.
+func (v *ActivityQueryEntriesEntryConnectionNodesEntryTransaction) GetMetadata() *map[string]interface{} {
+	return v.Metadata
 }
 
-// GetPostTransaction returns PostTransactionWithStatementDateResponse.PostTransaction, and is useful for accessing the field via an interface.
-func (v *PostTransactionWithStatementDateResponse) GetPostTransaction() PostTransactionWithStatementDatePostTransaction {
-	return v.PostTransaction
+// GetEntries returns ActivityQueryEntriesEntryConnectionNodesEntryTransaction.Entries, and is useful for accessing the field via an interface.
+func (v *ActivityQueryEntriesEntryConnectionNodesEntryTransaction) GetEntries() ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnection {
+	return v.Entries
 }
 
-// SetupBert_checkingAccount includes the requested fields of the GraphQL type Account.
+// ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnection includes the requested fields of the GraphQL type EntryConnection.
 // The GraphQL type's documentation follows.
 //
-// Accounts model all of the economic activity that your ledger provides.
-//
-// The chart of accounts is the basis for creating balance sheets, P&L reports, and for understanding the balances for the customer and business entities your business services.
-//
-// Accounts can be organized into sets with the AccountSet type. Hierarchical tree structures which roll up balances across many accounts can be modeled by nesting sets within other sets.
-type SetupBert_checkingAccount struct {
-	// Unique identifier for the account.
-	AccountId uuid.UUID `json:"accountId"`
-	// Account name. @example("Bill Pay Settlement") @example("Courtesy Credit")
-	Name string `json:"name"`
+// Connection to a list of Entry nodes.
+// Access Entry nodes directly through the `nodes` field, or access information about the connection edges with the `edges` field.
+// Use `pageInfo` to paginate responses using the cursors provided.
+type ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnection struct {
+	Nodes []*ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntry `json:"nodes"`
 }
 
-// GetAccountId returns SetupBert_checkingAccount.AccountId, and is useful for accessing the field via an interface.
-func (v *SetupBert_checkingAccount) GetAccountId() uuid.UUID { return v.AccountId }
-
-// GetName returns SetupBert_checkingAccount.Name, and is useful for accessing the field via an interface.
-func (v *SetupBert_checkingAccount) GetName() string { return v.Name }
+// GetNodes returns ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnection) GetNodes() []*ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntry {
+	return v.Nodes
+}
 
-// SetupCreateJournal includes the requested fields of the GraphQL type Journal.
+// ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntry includes the requested fields of the GraphQL type Entry.
 // The GraphQL type's documentation follows.
 //
-// Journals allow for the organizing of transactions within separate "books".
+// An entry represents one side of a transaction in a ledger. In other systems, these may be called "ledger lines" or "journal entries".
 //
-// In many cases, users only need a single journal. For this reason, Twisp always contains a default journal with code `DEFAULT`.
+// Entries always have an account, amount, and direction (CREDIT or DEBIT). In addition, Twisp uses the concept of "entry types" to assign every entry to a categorical type.
 //
-// Journals can be used for a variety of functions. For example, users may create separate journals for different currencies, or product-specific journals.
-type SetupCreateJournal struct {
-	// Unique identifier for the journal.
-	JournalId uuid.UUID `json:"journalId"`
+// Twisp enforces double-entry accounting, which in practice means that entries can only be entered in the context of a Transaction. Posting a transaction will create _at least 2_ ledger entries.
+type ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntry struct {
+	// Reference to the account to be debited/credited.
+	Account ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntryAccount `json:"account"`
 }
 
-// GetJournalId returns SetupCreateJournal.JournalId, and is useful for accessing the field via an interface.
-func (v *SetupCreateJournal) GetJournalId() uuid.UUID { return v.JournalId }
-
-// SetupCreateTranCode includes the requested fields of the GraphQL type TranCode.
-// The GraphQL type's documentation follows.
-//
-// Transaction Codes (tran codes) are how financial engineers do double-entry accounting. They encode the basic patterns for a type of transaction as a predictable and repeatable formula.
-//
-// You can think of tran codes as function signatures which define how a transaction acts upon the ledger.
-type SetupCreateTranCode struct {
-	// Internal UUID for the transaction code record.
-	TranCodeId uuid.UUID `json:"tranCodeId"`
+// GetAccount returns ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntry.Account, and is useful for accessing the field via an interface.
+func (v *ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntry) GetAccount() ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntryAccount {
+	return v.Account
 }
 
-// GetTranCodeId returns SetupCreateTranCode.TranCodeId, and is useful for accessing the field via an interface.
-func (v *SetupCreateTranCode) GetTranCodeId() uuid.UUID { return v.TranCodeId }
-
-// SetupErnie_checkingAccount includes the requested fields of the GraphQL type Account.
+// ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntryAccount includes the requested fields of the GraphQL type Account.
 // The GraphQL type's documentation follows.
 //
 // Accounts model all of the economic activity that your ledger provides.
@@ -352,44 +659,27 @@ func (v *SetupCreateTranCode) GetTranCodeId() uuid.UUID { return v.TranCodeId }
 // The chart of accounts is the basis for creating balance sheets, P&L reports, and for understanding the balances for the customer and business entities your business services.
 //
 // Accounts can be organized into sets with the AccountSet type. Hierarchical tree structures which roll up balances across many accounts can be modeled by nesting sets within other sets.
-type SetupErnie_checkingAccount struct {
-	// Unique identifier for the account.
-	AccountId uuid.UUID `json:"accountId"`
-	// Account name. @example("Bill Pay Settlement") @example("Courtesy Credit")
-	Name string `json:"name"`
+type ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntryAccount struct {
+	// Shorthand code for the account, often an abbreviated version of the account name.
+	// Example: 'ACH_RECON' for an account named 'ACH Reconciliation'.
+	Code string `json:"code"`
 }
 
-// GetAccountId returns SetupErnie_checkingAccount.AccountId, and is useful for accessing the field via an interface.
-func (v *SetupErnie_checkingAccount) GetAccountId() uuid.UUID { return v.AccountId }
-
-// GetName returns SetupErnie_checkingAccount.Name, and is useful for accessing the field via an interface.
-func (v *SetupErnie_checkingAccount) GetName() string { return v.Name }
-
-// SetupResponse is returned by Setup on success.
-type SetupResponse struct {
-	// Create a new journal for recording transactions in the ledger.
-	CreateJournal SetupCreateJournal `json:"createJournal"`
-	// Create a new transaction code (tran code).
-	CreateTranCode SetupCreateTranCode `json:"createTranCode"`
-	// Create a new account.
-	Ernie_checking SetupErnie_checkingAccount `json:"ernie_checking"`
-	// Create a new account.
-	Bert_checking SetupBert_checkingAccount `json:"bert_checking"`
+// GetCode returns ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntryAccount.Code, and is useful for accessing the field via an interface.
+func (v *ActivityQueryEntriesEntryConnectionNodesEntryTransactionEntriesEntryConnectionNodesEntryAccount) GetCode() string {
+	return v.Code
 }
 
-// GetCreateJournal returns SetupResponse.CreateJournal, and is useful for accessing the field via an interface.
-func (v *SetupResponse) GetCreateJournal() SetupCreateJournal { return v.CreateJournal }
-
-// GetCreateTranCode returns SetupResponse.CreateTranCode, and is useful for accessing the field via an interface.
-func (v *SetupResponse) GetCreateTranCode() SetupCreateTranCode { return v.CreateTranCode }
-
-// GetErnie_checking returns SetupResponse.Ernie_checking, and is useful for accessing the field via an interface.
-func (v *SetupResponse) GetErnie_checking() SetupErnie_checkingAccount { return v.Ernie_checking }
+// ActivityQueryResponse is returned by ActivityQuery on success.
+type ActivityQueryResponse struct {
+	// Select one or more entries. Specify the index to use and apply filters to your query.
+	Entries ActivityQueryEntriesEntryConnection `json:"entries"`
+}
 
-// GetBert_checking returns SetupResponse.Bert_checking, and is useful for accessing the field via an interface.
-func (v *SetupResponse) GetBert_checking() SetupBert_checkingAccount { return v.Bert_checking }
+// GetEntries returns ActivityQueryResponse.Entries, and is useful for accessing the field via an interface.
+func (v *ActivityQueryResponse) GetEntries() ActivityQueryEntriesEntryConnection { return v.Entries }
 
-// StatementBalanceClosedBalance includes the requested fields of the GraphQL type Balance.
+// BalanceAtCutoffBalance includes the requested fields of the GraphQL type Balance.
 // The GraphQL type's documentation follows.
 //
 // Balances are auto-calculated sums of the entries for a given account.
@@ -399,44 +689,31 @@ func (v *SetupResponse) GetBert_checking() SetupBert_checkingAccount { return v.
 // Additionally, every account has a `normalBalance`, which is equal to `crBalance - drBalance` for credit normal accounts, and `drBalance - crBalance` for debit normal accounts.
 //
 // Each account can have balances across all three layers: SETTLED, PENDING, and ENCUMBRANCE.
-type StatementBalanceClosedBalance struct {
-	// Time of the last change. Especially useful when reviewing the `history`.
-	Modified Timestamp `json:"modified"`
+type BalanceAtCutoffBalance struct {
 	// The balance amounts available by combining the provided layer with all layers above.
-	Available StatementBalanceClosedBalanceAvailableBalanceAmount `json:"available"`
-	// History of changes to this Balance record.
-	// Because ledgers are immutable and append-only, all changes are recorded as sequenced versions of the record, providing an unbroken lineage of the current state.
-	History StatementBalanceClosedBalanceHistoryBalanceConnection `json:"history"`
+	Available BalanceAtCutoffBalanceAvailableBalanceAmount `json:"available"`
 }
 
-// GetModified returns StatementBalanceClosedBalance.Modified, and is useful for accessing the field via an interface.
-func (v *StatementBalanceClosedBalance) GetModified() Timestamp { return v.Modified }
-
-// GetAvailable returns StatementBalanceClosedBalance.Available, and is useful for accessing the field via an interface.
-func (v *StatementBalanceClosedBalance) GetAvailable() StatementBalanceClosedBalanceAvailableBalanceAmount {
+// GetAvailable returns BalanceAtCutoffBalance.Available, and is useful for accessing the field via an interface.
+func (v *BalanceAtCutoffBalance) GetAvailable() BalanceAtCutoffBalanceAvailableBalanceAmount {
 	return v.Available
 }
 
-// GetHistory returns StatementBalanceClosedBalance.History, and is useful for accessing the field via an interface.
-func (v *StatementBalanceClosedBalance) GetHistory() StatementBalanceClosedBalanceHistoryBalanceConnection {
-	return v.History
-}
-
-// StatementBalanceClosedBalanceAvailableBalanceAmount includes the requested fields of the GraphQL type BalanceAmount.
-type StatementBalanceClosedBalanceAvailableBalanceAmount struct {
+// BalanceAtCutoffBalanceAvailableBalanceAmount includes the requested fields of the GraphQL type BalanceAmount.
+type BalanceAtCutoffBalanceAvailableBalanceAmount struct {
 	// The "normal balance" for an account is different for credit normal and debit normal accounts.
 	//
 	// For credit normal accounts, the normal balance is equal to `crBalance - drBalance`.
 	// For debit normal accounts, the normal balance is the reverse: `drBalance - crBalance`.
-	NormalBalance StatementBalanceClosedBalanceAvailableBalanceAmountNormalBalanceMoney `json:"normalBalance"`
+	NormalBalance BalanceAtCutoffBalanceAvailableBalanceAmountNormalBalanceMoney `json:"normalBalance"`
 }
 
-// GetNormalBalance returns StatementBalanceClosedBalanceAvailableBalanceAmount.NormalBalance, and is useful for accessing the field via an interface.
-func (v *StatementBalanceClosedBalanceAvailableBalanceAmount) GetNormalBalance() StatementBalanceClosedBalanceAvailableBalanceAmountNormalBalanceMoney {
+// GetNormalBalance returns BalanceAtCutoffBalanceAvailableBalanceAmount.NormalBalance, and is useful for accessing the field via an interface.
+func (v *BalanceAtCutoffBalanceAvailableBalanceAmount) GetNormalBalance() BalanceAtCutoffBalanceAvailableBalanceAmountNormalBalanceMoney {
 	return v.NormalBalance
 }
 
-// StatementBalanceClosedBalanceAvailableBalanceAmountNormalBalanceMoney includes the requested fields of the GraphQL type Money.
+// BalanceAtCutoffBalanceAvailableBalanceAmountNormalBalanceMoney includes the requested fields of the GraphQL type Money.
 // The GraphQL type's documentation follows.
 //
 // Money type with multi-currency support.
@@ -450,31 +727,25 @@ func (v *StatementBalanceClosedBalanceAvailableBalanceAmount) GetNormalBalance()
 // | USD      | `289.27` | $289.27   |
 // | BHD      | `28.927` | 28.927 BD |
 // | JPY      | `28927`  | ¥28927    |
-type StatementBalanceClosedBalanceAvailableBalanceAmountNormalBalanceMoney struct {
+type BalanceAtCutoffBalanceAvailableBalanceAmountNormalBalanceMoney struct {
 	Units Decimal `json:"units"`
 }
 
-// GetUnits returns StatementBalanceClosedBalanceAvailableBalanceAmountNormalBalanceMoney.Units, and is useful for accessing the field via an interface.
-func (v *StatementBalanceClosedBalanceAvailableBalanceAmountNormalBalanceMoney) GetUnits() Decimal {
+// GetUnits returns BalanceAtCutoffBalanceAvailableBalanceAmountNormalBalanceMoney.Units, and is useful for accessing the field via an interface.
+func (v *BalanceAtCutoffBalanceAvailableBalanceAmountNormalBalanceMoney) GetUnits() Decimal {
 	return v.Units
 }
 
-// StatementBalanceClosedBalanceHistoryBalanceConnection includes the requested fields of the GraphQL type BalanceConnection.
-// The GraphQL type's documentation follows.
-//
-// Connection to a list of Balance nodes.
-// Access Balance nodes directly through the `nodes` field, or access information about the connection edges with the `edges` field.
-// Use `pageInfo` to paginate responses using the cursors provided.
-type StatementBalanceClosedBalanceHistoryBalanceConnection struct {
-	Nodes []*StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalance `json:"nodes"`
+// BalanceAtCutoffResponse is returned by BalanceAtCutoff on success.
+type BalanceAtCutoffResponse struct {
+	// Get a balance for an account.
+	Balance *BalanceAtCutoffBalance `json:"balance"`
 }
 
-// GetNodes returns StatementBalanceClosedBalanceHistoryBalanceConnection.Nodes, and is useful for accessing the field via an interface.
-func (v *StatementBalanceClosedBalanceHistoryBalanceConnection) GetNodes() []*StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalance {
-	return v.Nodes
-}
+// GetBalance returns BalanceAtCutoffResponse.Balance, and is useful for accessing the field via an interface.
+func (v *BalanceAtCutoffResponse) GetBalance() *BalanceAtCutoffBalance { return v.Balance }
 
-// StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalance includes the requested fields of the GraphQL type Balance.
+// BalanceVersionsBalance includes the requested fields of the GraphQL type Balance.
 // The GraphQL type's documentation follows.
 //
 // Balances are auto-calculated sums of the entries for a given account.
@@ -484,42 +755,44 @@ func (v *StatementBalanceClosedBalanceHistoryBalanceConnection) GetNodes() []*St
 // Additionally, every account has a `normalBalance`, which is equal to `crBalance - drBalance` for credit normal accounts, and `drBalance - crBalance` for debit normal accounts.
 //
 // Each account can have balances across all three layers: SETTLED, PENDING, and ENCUMBRANCE.
-type StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalance struct {
-	// Reference to the most recent entry used to calculate the balance.
-	Entry StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalanceEntry `json:"entry"`
+type BalanceVersionsBalance struct {
+	// The current version number of this balance. Previous versions are tracked in `history`.
+	Version int `json:"version"`
+	// The balance amounts available by combining the provided layer with all layers above.
+	Available BalanceVersionsBalanceAvailableBalanceAmount `json:"available"`
+	// History of changes to this Balance record.
+	// Because ledgers are immutable and append-only, all changes are recorded as sequenced versions of the record, providing an unbroken lineage of the current state.
+	History BalanceVersionsBalanceHistoryBalanceConnection `json:"history"`
 }
 
-// GetEntry returns StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalance.Entry, and is useful for accessing the field via an interface.
-func (v *StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalance) GetEntry() StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalanceEntry {
-	return v.Entry
+// GetVersion returns BalanceVersionsBalance.Version, and is useful for accessing the field via an interface.
+func (v *BalanceVersionsBalance) GetVersion() int { return v.Version }
+
+// GetAvailable returns BalanceVersionsBalance.Available, and is useful for accessing the field via an interface.
+func (v *BalanceVersionsBalance) GetAvailable() BalanceVersionsBalanceAvailableBalanceAmount {
+	return v.Available
 }
 
-// StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalanceEntry includes the requested fields of the GraphQL type Entry.
-// The GraphQL type's documentation follows.
-//
-// An entry represents one side of a transaction in a ledger. In other systems, these may be called "ledger lines" or "journal entries".
-//
-// Entries always have an account, amount, and direction (CREDIT or DEBIT). In addition, Twisp uses the concept of "entry types" to assign every entry to a categorical type.
-//
-// Twisp enforces double-entry accounting, which in practice means that entries can only be entered in the context of a Transaction. Posting a transaction will create _at least 2_ ledger entries.
-type StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalanceEntry struct {
-	// Arbitrary structured data about this entry.
-	Metadata *map[string]interface{} `json:"metadata"`
-	// Amount of the ledger entry using the currency-supported Money type.
-	Amount StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalanceEntryAmountMoney `json:"amount"`
+// GetHistory returns BalanceVersionsBalance.History, and is useful for accessing the field via an interface.
+func (v *BalanceVersionsBalance) GetHistory() BalanceVersionsBalanceHistoryBalanceConnection {
+	return v.History
 }
 
-// GetMetadata returns StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalanceEntry.Metadata, and is useful for accessing the field via an interface.
-func (v *StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalanceEntry) GetMetadata() *map[string]interface{} {
-	return v.Metadata
+// BalanceVersionsBalanceAvailableBalanceAmount includes the requested fields of the GraphQL type BalanceAmount.
+type BalanceVersionsBalanceAvailableBalanceAmount struct {
+	// The "normal balance" for an account is different for credit normal and debit normal accounts.
+	//
+	// For credit normal accounts, the normal balance is equal to `crBalance - drBalance`.
+	// For debit normal accounts, the normal balance is the reverse: `drBalance - crBalance`.
+	NormalBalance BalanceVersionsBalanceAvailableBalanceAmountNormalBalanceMoney `json:"normalBalance"`
 }
 
-// GetAmount returns StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalanceEntry.Amount, and is useful for accessing the field via an interface.
-func (v *StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalanceEntry) GetAmount() StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalanceEntryAmountMoney {
-	return v.Amount
+// GetNormalBalance returns BalanceVersionsBalanceAvailableBalanceAmount.NormalBalance, and is useful for accessing the field via an interface.
+func (v *BalanceVersionsBalanceAvailableBalanceAmount) GetNormalBalance() BalanceVersionsBalanceAvailableBalanceAmountNormalBalanceMoney {
+	return v.NormalBalance
 }
 
-// StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalanceEntryAmountMoney includes the requested fields of the GraphQL type Money.
+// BalanceVersionsBalanceAvailableBalanceAmountNormalBalanceMoney includes the requested fields of the GraphQL type Money.
 // The GraphQL type's documentation follows.
 //
 // Money type with multi-currency support.
@@ -533,16 +806,37 @@ func (v *StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalanceEntry)
 // | USD      | `289.27` | $289.27   |
 // | BHD      | `28.927` | 28.927 BD |
 // | JPY      | `28927`  | ¥28927    |
-type StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalanceEntryAmountMoney struct {
+type BalanceVersionsBalanceAvailableBalanceAmountNormalBalanceMoney struct {
 	Units Decimal `json:"units"`
 }
 
-// GetUnits returns StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalanceEntryAmountMoney.Units, and is useful for accessing the field via an interface.
-func (v *StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalanceEntryAmountMoney) GetUnits() Decimal {
+// GetUnits returns BalanceVersionsBalanceAvailableBalanceAmountNormalBalanceMoney.Units, and is useful for accessing the field via an interface.
+func (v *BalanceVersionsBalanceAvailableBalanceAmountNormalBalanceMoney) GetUnits() Decimal {
 	return v.Units
 }
 
-// StatementBalanceOpenBalance includes the requested fields of the GraphQL type Balance.
+// BalanceVersionsBalanceHistoryBalanceConnection includes the requested fields of the GraphQL type BalanceConnection.
+// The GraphQL type's documentation follows.
+//
+// Connection to a list of Balance nodes.
+// Access Balance nodes directly through the `nodes` field, or access information about the connection edges with the `edges` field.
+// Use `pageInfo` to paginate responses using the cursors provided.
+type BalanceVersionsBalanceHistoryBalanceConnection struct {
+	Nodes    []*BalanceVersionsBalanceHistoryBalanceConnectionNodesBalance `json:"nodes"`
+	PageInfo BalanceVersionsBalanceHistoryBalanceConnectionPageInfo        `json:"pageInfo"`
+}
+
+// GetNodes returns BalanceVersionsBalanceHistoryBalanceConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *BalanceVersionsBalanceHistoryBalanceConnection) GetNodes() []*BalanceVersionsBalanceHistoryBalanceConnectionNodesBalance {
+	return v.Nodes
+}
+
+// GetPageInfo returns BalanceVersionsBalanceHistoryBalanceConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *BalanceVersionsBalanceHistoryBalanceConnection) GetPageInfo() BalanceVersionsBalanceHistoryBalanceConnectionPageInfo {
+	return v.PageInfo
+}
+
+// BalanceVersionsBalanceHistoryBalanceConnectionNodesBalance includes the requested fields of the GraphQL type Balance.
 // The GraphQL type's documentation follows.
 //
 // Balances are auto-calculated sums of the entries for a given account.
@@ -552,44 +846,38 @@ func (v *StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalanceEntryA
 // Additionally, every account has a `normalBalance`, which is equal to `crBalance - drBalance` for credit normal accounts, and `drBalance - crBalance` for debit normal accounts.
 //
 // Each account can have balances across all three layers: SETTLED, PENDING, and ENCUMBRANCE.
-type StatementBalanceOpenBalance struct {
-	// Time of the last change. Especially useful when reviewing the `history`.
-	Modified Timestamp `json:"modified"`
+type BalanceVersionsBalanceHistoryBalanceConnectionNodesBalance struct {
+	// The current version number of this balance. Previous versions are tracked in `history`.
+	Version int `json:"version"`
 	// The balance amounts available by combining the provided layer with all layers above.
-	Available StatementBalanceOpenBalanceAvailableBalanceAmount `json:"available"`
-	// History of changes to this Balance record.
-	// Because ledgers are immutable and append-only, all changes are recorded as sequenced versions of the record, providing an unbroken lineage of the current state.
-	History StatementBalanceOpenBalanceHistoryBalanceConnection `json:"history"`
+	Available BalanceVersionsBalanceHistoryBalanceConnectionNodesBalanceAvailableBalanceAmount `json:"available"`
 }
 
-// GetModified returns StatementBalanceOpenBalance.Modified, and is useful for accessing the field via an interface.
-func (v *StatementBalanceOpenBalance) GetModified() Timestamp { return v.Modified }
-
-// GetAvailable returns StatementBalanceOpenBalance.Available, and is useful for accessing the field via an interface.
-func (v *StatementBalanceOpenBalance) GetAvailable() StatementBalanceOpenBalanceAvailableBalanceAmount {
-	return v.Available
+// GetVersion returns BalanceVersionsBalanceHistoryBalanceConnectionNodesBalance.Version, and is useful for accessing the field via an interface.
+func (v *BalanceVersionsBalanceHistoryBalanceConnectionNodesBalance) GetVersion() int {
+	return v.Version
 }
 
-// GetHistory returns StatementBalanceOpenBalance.History, and is useful for accessing the field via an interface.
-func (v *StatementBalanceOpenBalance) GetHistory() StatementBalanceOpenBalanceHistoryBalanceConnection {
-	return v.History
+// GetAvailable returns BalanceVersionsBalanceHistoryBalanceConnectionNodesBalance.Available, and is useful for accessing the field via an interface.
+func (v *BalanceVersionsBalanceHistoryBalanceConnectionNodesBalance) GetAvailable() BalanceVersionsBalanceHistoryBalanceConnectionNodesBalanceAvailableBalanceAmount {
+	return v.Available
 }
 
-// StatementBalanceOpenBalanceAvailableBalanceAmount includes the requested fields of the GraphQL type BalanceAmount.
-type StatementBalanceOpenBalanceAvailableBalanceAmount struct {
+// BalanceVersionsBalanceHistoryBalanceConnectionNodesBalanceAvailableBalanceAmount includes the requested fields of the GraphQL type BalanceAmount.
+type BalanceVersionsBalanceHistoryBalanceConnectionNodesBalanceAvailableBalanceAmount struct {
 	// The "normal balance" for an account is different for credit normal and debit normal accounts.
 	//
 	// For credit normal accounts, the normal balance is equal to `crBalance - drBalance`.
 	// For debit normal accounts, the normal balance is the reverse: `drBalance - crBalance`.
-	NormalBalance StatementBalanceOpenBalanceAvailableBalanceAmountNormalBalanceMoney `json:"normalBalance"`
+	NormalBalance BalanceVersionsBalanceHistoryBalanceConnectionNodesBalanceAvailableBalanceAmountNormalBalanceMoney `json:"normalBalance"`
 }
 
-// GetNormalBalance returns StatementBalanceOpenBalanceAvailableBalanceAmount.NormalBalance, and is useful for accessing the field via an interface.
-func (v *StatementBalanceOpenBalanceAvailableBalanceAmount) GetNormalBalance() StatementBalanceOpenBalanceAvailableBalanceAmountNormalBalanceMoney {
+// GetNormalBalance returns BalanceVersionsBalanceHistoryBalanceConnectionNodesBalanceAvailableBalanceAmount.NormalBalance, and is useful for accessing the field via an interface.
+func (v *BalanceVersionsBalanceHistoryBalanceConnectionNodesBalanceAvailableBalanceAmount) GetNormalBalance() BalanceVersionsBalanceHistoryBalanceConnectionNodesBalanceAvailableBalanceAmountNormalBalanceMoney {
 	return v.NormalBalance
 }
 
-// StatementBalanceOpenBalanceAvailableBalanceAmountNormalBalanceMoney includes the requested fields of the GraphQL type Money.
+// BalanceVersionsBalanceHistoryBalanceConnectionNodesBalanceAvailableBalanceAmountNormalBalanceMoney includes the requested fields of the GraphQL type Money.
 // The GraphQL type's documentation follows.
 //
 // Money type with multi-currency support.
@@ -603,51 +891,75 @@ func (v *StatementBalanceOpenBalanceAvailableBalanceAmount) GetNormalBalance() S
 // | USD      | `289.27` | $289.27   |
 // | BHD      | `28.927` | 28.927 BD |
 // | JPY      | `28927`  | ¥28927    |
-type StatementBalanceOpenBalanceAvailableBalanceAmountNormalBalanceMoney struct {
+type BalanceVersionsBalanceHistoryBalanceConnectionNodesBalanceAvailableBalanceAmountNormalBalanceMoney struct {
 	Units Decimal `json:"units"`
 }
 
-// GetUnits returns StatementBalanceOpenBalanceAvailableBalanceAmountNormalBalanceMoney.Units, and is useful for accessing the field via an interface.
-func (v *StatementBalanceOpenBalanceAvailableBalanceAmountNormalBalanceMoney) GetUnits() Decimal {
+// GetUnits returns BalanceVersionsBalanceHistoryBalanceConnectionNodesBalanceAvailableBalanceAmountNormalBalanceMoney.Units, and is useful for accessing the field via an interface.
+func (v *BalanceVersionsBalanceHistoryBalanceConnectionNodesBalanceAvailableBalanceAmountNormalBalanceMoney) GetUnits() Decimal {
 	return v.Units
 }
 
-// StatementBalanceOpenBalanceHistoryBalanceConnection includes the requested fields of the GraphQL type BalanceConnection.
-// The GraphQL type's documentation follows.
-//
-// Connection to a list of Balance nodes.
-// Access Balance nodes directly through the `nodes` field, or access information about the connection edges with the `edges` field.
-// Use `pageInfo` to paginate responses using the cursors provided.
-type StatementBalanceOpenBalanceHistoryBalanceConnection struct {
-	Nodes []*StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalance `json:"nodes"`
+// BalanceVersionsBalanceHistoryBalanceConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type BalanceVersionsBalanceHistoryBalanceConnectionPageInfo struct {
+	// True if there are nodes in the connection after the current page / end cursor.
+	HasNextPage bool `json:"hasNextPage"`
+	// Query cursor for the last node in the current page.
+	EndCursor *string `json:"endCursor"`
 }
 
-// GetNodes returns StatementBalanceOpenBalanceHistoryBalanceConnection.Nodes, and is useful for accessing the field via an interface.
-func (v *StatementBalanceOpenBalanceHistoryBalanceConnection) GetNodes() []*StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalance {
-	return v.Nodes
+// GetHasNextPage returns BalanceVersionsBalanceHistoryBalanceConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *BalanceVersionsBalanceHistoryBalanceConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
 }
 
-// StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalance includes the requested fields of the GraphQL type Balance.
+// GetEndCursor returns BalanceVersionsBalanceHistoryBalanceConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *BalanceVersionsBalanceHistoryBalanceConnectionPageInfo) GetEndCursor() *string {
+	return v.EndCursor
+}
+
+// BalanceVersionsResponse is returned by BalanceVersions on success.
+type BalanceVersionsResponse struct {
+	// Get a balance for an account.
+	Balance *BalanceVersionsBalance `json:"balance"`
+}
+
+// GetBalance returns BalanceVersionsResponse.Balance, and is useful for accessing the field via an interface.
+func (v *BalanceVersionsResponse) GetBalance() *BalanceVersionsBalance { return v.Balance }
+
+type Between struct {
+	Begin *string `json:"begin"`
+	End   *string `json:"end"`
+}
+
+// GetBegin returns Between.Begin, and is useful for accessing the field via an interface.
+func (v *Between) GetBegin() *string { return v.Begin }
+
+// GetEnd returns Between.End, and is useful for accessing the field via an interface.
+func (v *Between) GetEnd() *string { return v.End }
+
+// CountActivityEntriesEntriesEntryConnection includes the requested fields of the GraphQL type EntryConnection.
 // The GraphQL type's documentation follows.
 //
-// Balances are auto-calculated sums of the entries for a given account.
-//
-// Every balance record maintains a `drBalance` for entries on the debit side of the ledger and a `crBalance` for credit entries.
-//
-// Additionally, every account has a `normalBalance`, which is equal to `crBalance - drBalance` for credit normal accounts, and `drBalance - crBalance` for debit normal accounts.
-//
-// Each account can have balances across all three layers: SETTLED, PENDING, and ENCUMBRANCE.
-type StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalance struct {
-	// Reference to the most recent entry used to calculate the balance.
-	Entry StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalanceEntry `json:"entry"`
+// Connection to a list of Entry nodes.
+// Access Entry nodes directly through the `nodes` field, or access information about the connection edges with the `edges` field.
+// Use `pageInfo` to paginate responses using the cursors provided.
+type CountActivityEntriesEntriesEntryConnection struct {
+	Nodes    []*CountActivityEntriesEntriesEntryConnectionNodesEntry `json:"nodes"`
+	PageInfo CountActivityEntriesEntriesEntryConnectionPageInfo      `json:"pageInfo"`
 }
 
-// GetEntry returns StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalance.Entry, and is useful for accessing the field via an interface.
-func (v *StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalance) GetEntry() StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalanceEntry {
-	return v.Entry
+// GetNodes returns CountActivityEntriesEntriesEntryConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *CountActivityEntriesEntriesEntryConnection) GetNodes() []*CountActivityEntriesEntriesEntryConnectionNodesEntry {
+	return v.Nodes
 }
 
-// StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalanceEntry includes the requested fields of the GraphQL type Entry.
+// GetPageInfo returns CountActivityEntriesEntriesEntryConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *CountActivityEntriesEntriesEntryConnection) GetPageInfo() CountActivityEntriesEntriesEntryConnectionPageInfo {
+	return v.PageInfo
+}
+
+// CountActivityEntriesEntriesEntryConnectionNodesEntry includes the requested fields of the GraphQL type Entry.
 // The GraphQL type's documentation follows.
 //
 // An entry represents one side of a transaction in a ledger. In other systems, these may be called "ledger lines" or "journal entries".
@@ -655,196 +967,4581 @@ func (v *StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalance) GetEnt
 // Entries always have an account, amount, and direction (CREDIT or DEBIT). In addition, Twisp uses the concept of "entry types" to assign every entry to a categorical type.
 //
 // Twisp enforces double-entry accounting, which in practice means that entries can only be entered in the context of a Transaction. Posting a transaction will create _at least 2_ ledger entries.
-type StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalanceEntry struct {
-	// Arbitrary structured data about this entry.
-	Metadata *map[string]interface{} `json:"metadata"`
-	// Amount of the ledger entry using the currency-supported Money type.
-	Amount StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalanceEntryAmountMoney `json:"amount"`
+type CountActivityEntriesEntriesEntryConnectionNodesEntry struct {
+	// Unique identifier for the ledger entry.
+	EntryId uuid.UUID `json:"entryId"`
 }
 
-// GetMetadata returns StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalanceEntry.Metadata, and is useful for accessing the field via an interface.
-func (v *StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalanceEntry) GetMetadata() *map[string]interface{} {
-	return v.Metadata
+// GetEntryId returns CountActivityEntriesEntriesEntryConnectionNodesEntry.EntryId, and is useful for accessing the field via an interface.
+func (v *CountActivityEntriesEntriesEntryConnectionNodesEntry) GetEntryId() uuid.UUID {
+	return v.EntryId
 }
 
-// GetAmount returns StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalanceEntry.Amount, and is useful for accessing the field via an interface.
-func (v *StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalanceEntry) GetAmount() StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalanceEntryAmountMoney {
-	return v.Amount
+// CountActivityEntriesEntriesEntryConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type CountActivityEntriesEntriesEntryConnectionPageInfo struct {
+	// True if there are nodes in the connection after the current page / end cursor.
+	HasNextPage bool `json:"hasNextPage"`
+	// Query cursor for the last node in the current page.
+	EndCursor *string `json:"endCursor"`
 }
 
-// StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalanceEntryAmountMoney includes the requested fields of the GraphQL type Money.
-// The GraphQL type's documentation follows.
-//
-// Money type with multi-currency support.
-//
-// Monetary amounts are represented as decimal units of currency. Fields which use the Money type can be converted to a symbolic representations by specifying a MoneyFormatInput on the `formatted` field.
-//
-// Here is an example table showing different currencies which each have their own divisions of units represented. Japanese yen (JPY) don't have a decimal minor unit, and Bahraini dinars (BHD) use 3 minor unit decimal places. The `formatted` column uses the default values for a an `en-US` locale.
-//
-// | Currency | Units    | Formatted |
-// |----------|----------|-----------|
-// | USD      | `289.27` | $289.27   |
-// | BHD      | `28.927` | 28.927 BD |
-// | JPY      | `28927`  | ¥28927    |
-type StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalanceEntryAmountMoney struct {
-	Units Decimal `json:"units"`
+// GetHasNextPage returns CountActivityEntriesEntriesEntryConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *CountActivityEntriesEntriesEntryConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
 }
 
-// GetUnits returns StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalanceEntryAmountMoney.Units, and is useful for accessing the field via an interface.
-func (v *StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalanceEntryAmountMoney) GetUnits() Decimal {
-	return v.Units
+// GetEndCursor returns CountActivityEntriesEntriesEntryConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *CountActivityEntriesEntriesEntryConnectionPageInfo) GetEndCursor() *string {
+	return v.EndCursor
 }
 
-// StatementBalanceResponse is returned by StatementBalance on success.
-type StatementBalanceResponse struct {
-	// Get a balance for an account.
-	Open *StatementBalanceOpenBalance `json:"open"`
-	// Get a balance for an account.
-	Closed *StatementBalanceClosedBalance `json:"closed"`
+// CountActivityEntriesResponse is returned by CountActivityEntries on success.
+type CountActivityEntriesResponse struct {
+	// Select one or more entries. Specify the index to use and apply filters to your query.
+	Entries CountActivityEntriesEntriesEntryConnection `json:"entries"`
 }
 
-// GetOpen returns StatementBalanceResponse.Open, and is useful for accessing the field via an interface.
-func (v *StatementBalanceResponse) GetOpen() *StatementBalanceOpenBalance { return v.Open }
-
-// GetClosed returns StatementBalanceResponse.Closed, and is useful for accessing the field via an interface.
-func (v *StatementBalanceResponse) GetClosed() *StatementBalanceClosedBalance { return v.Closed }
-
-// __ActivityQueryInput is used internally by genqlient
-type __ActivityQueryInput struct {
-	JournalId *string `json:"journalId"`
-	AccountId *string `json:"accountId"`
-	Period    *string `json:"period"`
+// GetEntries returns CountActivityEntriesResponse.Entries, and is useful for accessing the field via an interface.
+func (v *CountActivityEntriesResponse) GetEntries() CountActivityEntriesEntriesEntryConnection {
+	return v.Entries
 }
 
-// GetJournalId returns __ActivityQueryInput.JournalId, and is useful for accessing the field via an interface.
-func (v *__ActivityQueryInput) GetJournalId() *string { return v.JournalId }
+// CreateAccountCreateAccount includes the requested fields of the GraphQL type Account.
+// The GraphQL type's documentation follows.
+//
+// Accounts model all of the economic activity that your ledger provides.
+//
+// The chart of accounts is the basis for creating balance sheets, P&L reports, and for understanding the balances for the customer and business entities your business services.
+//
+// Accounts can be organized into sets with the AccountSet type. Hierarchical tree structures which roll up balances across many accounts can be modeled by nesting sets within other sets.
+type CreateAccountCreateAccount struct {
+	// Unique identifier for the account.
+	AccountId uuid.UUID `json:"accountId"`
+	// Shorthand code for the account, often an abbreviated version of the account name.
+	// Example: 'ACH_RECON' for an account named 'ACH Reconciliation'.
+	Code string `json:"code"`
+}
 
-// GetAccountId returns __ActivityQueryInput.AccountId, and is useful for accessing the field via an interface.
-func (v *__ActivityQueryInput) GetAccountId() *string { return v.AccountId }
+// GetAccountId returns CreateAccountCreateAccount.AccountId, and is useful for accessing the field via an interface.
+func (v *CreateAccountCreateAccount) GetAccountId() uuid.UUID { return v.AccountId }
 
-// GetPeriod returns __ActivityQueryInput.Period, and is useful for accessing the field via an interface.
-func (v *__ActivityQueryInput) GetPeriod() *string { return v.Period }
+// GetCode returns CreateAccountCreateAccount.Code, and is useful for accessing the field via an interface.
+func (v *CreateAccountCreateAccount) GetCode() string { return v.Code }
 
-// __PostTransactionInput is used internally by genqlient
-type __PostTransactionInput struct {
-	TransactionId uuid.UUID `json:"transactionId"`
-	Effective     Date      `json:"effective"`
+// CreateAccountResponse is returned by CreateAccount on success.
+type CreateAccountResponse struct {
+	// Create a new account.
+	CreateAccount CreateAccountCreateAccount `json:"createAccount"`
 }
 
-// GetTransactionId returns __PostTransactionInput.TransactionId, and is useful for accessing the field via an interface.
-func (v *__PostTransactionInput) GetTransactionId() uuid.UUID { return v.TransactionId }
-
-// GetEffective returns __PostTransactionInput.Effective, and is useful for accessing the field via an interface.
-func (v *__PostTransactionInput) GetEffective() Date { return v.Effective }
+// GetCreateAccount returns CreateAccountResponse.CreateAccount, and is useful for accessing the field via an interface.
+func (v *CreateAccountResponse) GetCreateAccount() CreateAccountCreateAccount { return v.CreateAccount }
 
-// __PostTransactionWithStatementDateInput is used internally by genqlient
-type __PostTransactionWithStatementDateInput struct {
-	TransactionId uuid.UUID `json:"transactionId"`
-	Effective     Date      `json:"effective"`
-	StatementDate Date      `json:"statementDate"`
+// CreateActivityIndexResponse is returned by CreateActivityIndex on success.
+type CreateActivityIndexResponse struct {
+	// Mutations in the `schema` namespace are used to manage custom indexes, aggregates, and historical indexes. Use the `schema` namespace to create and delete indexes and aggregates.
+	Schema CreateActivityIndexSchemaSchemaMutation `json:"schema"`
 }
 
-// GetTransactionId returns __PostTransactionWithStatementDateInput.TransactionId, and is useful for accessing the field via an interface.
-func (v *__PostTransactionWithStatementDateInput) GetTransactionId() uuid.UUID {
-	return v.TransactionId
+// GetSchema returns CreateActivityIndexResponse.Schema, and is useful for accessing the field via an interface.
+func (v *CreateActivityIndexResponse) GetSchema() CreateActivityIndexSchemaSchemaMutation {
+	return v.Schema
 }
 
-// GetEffective returns __PostTransactionWithStatementDateInput.Effective, and is useful for accessing the field via an interface.
-func (v *__PostTransactionWithStatementDateInput) GetEffective() Date { return v.Effective }
-
-// GetStatementDate returns __PostTransactionWithStatementDateInput.StatementDate, and is useful for accessing the field via an interface.
-func (v *__PostTransactionWithStatementDateInput) GetStatementDate() Date { return v.StatementDate }
-
-// __SetupInput is used internally by genqlient
-type __SetupInput struct {
-	JournalId  uuid.UUID `json:"journalId"`
-	TranCodeId uuid.UUID `json:"tranCodeId"`
-	Account1Id uuid.UUID `json:"account1Id"`
+// CreateActivityIndexSchemaSchemaMutation includes the requested fields of the GraphQL type SchemaMutation.
+type CreateActivityIndexSchemaSchemaMutation struct {
+	// Create a custom index for querying records. Currently available for indexing Account, AccountSet, Balance, Entry, Transaction, and TranCode record types.
+	//
+	// To query the index, use the `CUSTOM` index type for the applicable resource query and supply the filter inputs specified by the index.
+	//
+	// Custom indexes can be created using fields on the root level of the record like `Account.modified` as well as nested fields within documents like the `metadata` object.
+	//
+	// Depending on the parameters defined, custom indexes may be structured to return a single record or a sorted list of records.
+	//
+	// Note that due to the scaling properties of the underlying database, a single partition supports a fixed amount of read bandwidth and individual write operations per second. Beyond that threshold, throttling will occur. Visit scaling properties for more information.
+	//
+	// When designing custom indexes, care must be taken to ensure that reads and writes are spread across a sufficient number of partitions to support peak workloads. In practice, partitioning by account is usually sufficient. Our technical support staff is available for guidance on partition design patterns at [support@twisp.com](mailto:support@twisp.com).
+	//
+	// To learn more about indexes within the Twisp FLDB, see [Index-First Design](https://www.twisp.com/docs/infrastructure/ledger-database#index-first-design) in the docs.
+	CreateIndex CreateActivityIndexSchemaSchemaMutationCreateIndex `json:"createIndex"`
+}
+
+// GetCreateIndex returns CreateActivityIndexSchemaSchemaMutation.CreateIndex, and is useful for accessing the field via an interface.
+func (v *CreateActivityIndexSchemaSchemaMutation) GetCreateIndex() CreateActivityIndexSchemaSchemaMutationCreateIndex {
+	return v.CreateIndex
+}
+
+// CreateActivityIndexSchemaSchemaMutationCreateIndex includes the requested fields of the GraphQL type Index.
+type CreateActivityIndexSchemaSchemaMutationCreateIndex struct {
+	// Unique identifier of this index. Typically human readable.
+	Name string `json:"name"`
+	// The type of record this index applies to.
+	On IndexOnEnum `json:"on"`
+}
+
+// GetName returns CreateActivityIndexSchemaSchemaMutationCreateIndex.Name, and is useful for accessing the field via an interface.
+func (v *CreateActivityIndexSchemaSchemaMutationCreateIndex) GetName() string { return v.Name }
+
+// GetOn returns CreateActivityIndexSchemaSchemaMutationCreateIndex.On, and is useful for accessing the field via an interface.
+func (v *CreateActivityIndexSchemaSchemaMutationCreateIndex) GetOn() IndexOnEnum { return v.On }
+
+// CreateCustomIndexResponse is returned by CreateCustomIndex on success.
+type CreateCustomIndexResponse struct {
+	// Mutations in the `schema` namespace are used to manage custom indexes, aggregates, and historical indexes. Use the `schema` namespace to create and delete indexes and aggregates.
+	Schema CreateCustomIndexSchemaSchemaMutation `json:"schema"`
+}
+
+// GetSchema returns CreateCustomIndexResponse.Schema, and is useful for accessing the field via an interface.
+func (v *CreateCustomIndexResponse) GetSchema() CreateCustomIndexSchemaSchemaMutation {
+	return v.Schema
+}
+
+// CreateCustomIndexSchemaSchemaMutation includes the requested fields of the GraphQL type SchemaMutation.
+type CreateCustomIndexSchemaSchemaMutation struct {
+	// Create a custom index for querying records. Currently available for indexing Account, AccountSet, Balance, Entry, Transaction, and TranCode record types.
+	//
+	// To query the index, use the `CUSTOM` index type for the applicable resource query and supply the filter inputs specified by the index.
+	//
+	// Custom indexes can be created using fields on the root level of the record like `Account.modified` as well as nested fields within documents like the `metadata` object.
+	//
+	// Depending on the parameters defined, custom indexes may be structured to return a single record or a sorted list of records.
+	//
+	// Note that due to the scaling properties of the underlying database, a single partition supports a fixed amount of read bandwidth and individual write operations per second. Beyond that threshold, throttling will occur. Visit scaling properties for more information.
+	//
+	// When designing custom indexes, care must be taken to ensure that reads and writes are spread across a sufficient number of partitions to support peak workloads. In practice, partitioning by account is usually sufficient. Our technical support staff is available for guidance on partition design patterns at [support@twisp.com](mailto:support@twisp.com).
+	//
+	// To learn more about indexes within the Twisp FLDB, see [Index-First Design](https://www.twisp.com/docs/infrastructure/ledger-database#index-first-design) in the docs.
+	CreateIndex CreateCustomIndexSchemaSchemaMutationCreateIndex `json:"createIndex"`
+}
+
+// GetCreateIndex returns CreateCustomIndexSchemaSchemaMutation.CreateIndex, and is useful for accessing the field via an interface.
+func (v *CreateCustomIndexSchemaSchemaMutation) GetCreateIndex() CreateCustomIndexSchemaSchemaMutationCreateIndex {
+	return v.CreateIndex
+}
+
+// CreateCustomIndexSchemaSchemaMutationCreateIndex includes the requested fields of the GraphQL type Index.
+type CreateCustomIndexSchemaSchemaMutationCreateIndex struct {
+	// Unique identifier of this index. Typically human readable.
+	Name string `json:"name"`
+	// The type of record this index applies to.
+	On IndexOnEnum `json:"on"`
+	// For non-search indexes, the partition key used for this index.
+	Partition []*CreateCustomIndexSchemaSchemaMutationCreateIndexPartitionPartitionKey `json:"partition"`
+	// For non-search indexes, the range key to use for query/sorting.
+	Range []*CreateCustomIndexSchemaSchemaMutationCreateIndexRangeIndexKey `json:"range"`
+	// Map of named CEL expressions specifying the conditions for including a record in this index.
+	//
+	// Records are only included in the index if _all_ expressions evaluate to `true`, i.e. they are combined with a logical AND. Each expression must return a boolean value.
+	//
+	// For example, a custom index on a `metadata.category` field might use the constraints `{ hasCateogory: "has(document.metadata.category)" }` to ensure that only records whose `metadata` document has a defined value for the `category` field are included.
+	Constraints *map[string]string `json:"constraints"`
+}
+
+// GetName returns CreateCustomIndexSchemaSchemaMutationCreateIndex.Name, and is useful for accessing the field via an interface.
+func (v *CreateCustomIndexSchemaSchemaMutationCreateIndex) GetName() string { return v.Name }
+
+// GetOn returns CreateCustomIndexSchemaSchemaMutationCreateIndex.On, and is useful for accessing the field via an interface.
+func (v *CreateCustomIndexSchemaSchemaMutationCreateIndex) GetOn() IndexOnEnum { return v.On }
+
+// GetPartition returns CreateCustomIndexSchemaSchemaMutationCreateIndex.Partition, and is useful for accessing the field via an interface.
+func (v *CreateCustomIndexSchemaSchemaMutationCreateIndex) GetPartition() []*CreateCustomIndexSchemaSchemaMutationCreateIndexPartitionPartitionKey {
+	return v.Partition
+}
+
+// GetRange returns CreateCustomIndexSchemaSchemaMutationCreateIndex.Range, and is useful for accessing the field via an interface.
+func (v *CreateCustomIndexSchemaSchemaMutationCreateIndex) GetRange() []*CreateCustomIndexSchemaSchemaMutationCreateIndexRangeIndexKey {
+	return v.Range
+}
+
+// GetConstraints returns CreateCustomIndexSchemaSchemaMutationCreateIndex.Constraints, and is useful for accessing the field via an interface.
+func (v *CreateCustomIndexSchemaSchemaMutationCreateIndex) GetConstraints() *map[string]string {
+	return v.Constraints
+}
+
+// CreateCustomIndexSchemaSchemaMutationCreateIndexPartitionPartitionKey includes the requested fields of the GraphQL type PartitionKey.
+// The GraphQL type's documentation follows.
+//
+// A named expression defining a partition key.
+type CreateCustomIndexSchemaSchemaMutationCreateIndexPartitionPartitionKey struct {
+	// Identifier for this partition key.
+	Alias string `json:"alias"`
+	// CEL expression which resolves to the value that is to be used for the partition key.
+	//
+	// Within the expression, the `document` object represents the record.
+	Value string `json:"value"`
+	// Resolved type of this partition element.
+	Type *IndexDataType `json:"type"`
+}
+
+// GetAlias returns CreateCustomIndexSchemaSchemaMutationCreateIndexPartitionPartitionKey.Alias, and is useful for accessing the field via an interface.
+func (v *CreateCustomIndexSchemaSchemaMutationCreateIndexPartitionPartitionKey) GetAlias() string {
+	return v.Alias
+}
+
+// GetValue returns CreateCustomIndexSchemaSchemaMutationCreateIndexPartitionPartitionKey.Value, and is useful for accessing the field via an interface.
+func (v *CreateCustomIndexSchemaSchemaMutationCreateIndexPartitionPartitionKey) GetValue() string {
+	return v.Value
+}
+
+// GetType returns CreateCustomIndexSchemaSchemaMutationCreateIndexPartitionPartitionKey.Type, and is useful for accessing the field via an interface.
+func (v *CreateCustomIndexSchemaSchemaMutationCreateIndexPartitionPartitionKey) GetType() *IndexDataType {
+	return v.Type
+}
+
+// CreateCustomIndexSchemaSchemaMutationCreateIndexRangeIndexKey includes the requested fields of the GraphQL type IndexKey.
+// The GraphQL type's documentation follows.
+//
+// A named expression used for sorting and range conditions.
+type CreateCustomIndexSchemaSchemaMutationCreateIndexRangeIndexKey struct {
+	// Identifier for this key.
+	Alias string `json:"alias"`
+	// CEL expression which resolves to the value that is to be sorted.
+	//
+	// Within the expression, the `document` object represents the record.
+	Value string `json:"value"`
+	// Whether the sort is in ascending or descending order.
+	Sort SortOrder `json:"sort"`
+	// Explicit type for sort key.
+	Type *IndexDataType `json:"type"`
+}
+
+// GetAlias returns CreateCustomIndexSchemaSchemaMutationCreateIndexRangeIndexKey.Alias, and is useful for accessing the field via an interface.
+func (v *CreateCustomIndexSchemaSchemaMutationCreateIndexRangeIndexKey) GetAlias() string {
+	return v.Alias
+}
+
+// GetValue returns CreateCustomIndexSchemaSchemaMutationCreateIndexRangeIndexKey.Value, and is useful for accessing the field via an interface.
+func (v *CreateCustomIndexSchemaSchemaMutationCreateIndexRangeIndexKey) GetValue() string {
+	return v.Value
+}
+
+// GetSort returns CreateCustomIndexSchemaSchemaMutationCreateIndexRangeIndexKey.Sort, and is useful for accessing the field via an interface.
+func (v *CreateCustomIndexSchemaSchemaMutationCreateIndexRangeIndexKey) GetSort() SortOrder {
+	return v.Sort
+}
+
+// GetType returns CreateCustomIndexSchemaSchemaMutationCreateIndexRangeIndexKey.Type, and is useful for accessing the field via an interface.
+func (v *CreateCustomIndexSchemaSchemaMutationCreateIndexRangeIndexKey) GetType() *IndexDataType {
+	return v.Type
+}
+
+type CreateIndexInput struct {
+	// Unique identifier of this index. Typically human readable.
+	Name string `json:"name"`
+	// The type of record this index applies to.
+	On IndexOnEnum `json:"on"`
+	// Indicates if this index is populated asynchronously.
+	Async *bool `json:"async"`
+	// Indicates if this index is a search index -- `unique`, `partition`
+	// and `sort` are ignored.
+	Search *bool `json:"search"`
+	// Indicates if this index is unique.
+	Unique *bool `json:"unique"`
+	// The partition key used for this index.
+	Partition []*PartitionKeyInput `json:"partition"`
+	// Specifies the number of shards for partition write scaling.
+	//
+	// This parameter defines how many shards the partition key is
+	// automatically split into, similarly to RAID-style disk striping.
+	// Increasing this value allows the index to distribute write
+	// throughput across multiple shards while sacrificing global sort
+	// order on the partition.
+	//
+	// For instance, setting `partitionShardCount` to 4 splits each unique
+	// partition into four shards, effectively allowing 4000 writes per
+	// second for a single partition key.
+	PartitionShardCount *int `json:"partitionShardCount"`
+	// The sort key to use for supporting range queries.
+	Sort []*IndexKeyInput `json:"sort"`
+	// Map of named CEL expressions specifying the conditions for including
+	// a record in this index.
+	//
+	// Records are only included in the index if _all_ expressions evaluate
+	// to `true`, i.e. they are combined with a logical AND. Each
+	// expression must return a boolean value.
+	//
+	// For example, a custom index on a `metadata.category` field might use
+	// the constraints `{ hasCategory: "has(document.metadata.category)" }`
+	// to ensure that only records whose `metadata` document has a defined
+	// value for the `category` field are included.
+	Constraints *map[string]string `json:"constraints"`
+}
+
+// GetName returns CreateIndexInput.Name, and is useful for accessing the field via an interface.
+func (v *CreateIndexInput) GetName() string { return v.Name }
+
+// GetOn returns CreateIndexInput.On, and is useful for accessing the field via an interface.
+func (v *CreateIndexInput) GetOn() IndexOnEnum { return v.On }
+
+// GetAsync returns CreateIndexInput.Async, and is useful for accessing the field via an interface.
+func (v *CreateIndexInput) GetAsync() *bool { return v.Async }
+
+// GetSearch returns CreateIndexInput.Search, and is useful for accessing the field via an interface.
+func (v *CreateIndexInput) GetSearch() *bool { return v.Search }
+
+// GetUnique returns CreateIndexInput.Unique, and is useful for accessing the field via an interface.
+func (v *CreateIndexInput) GetUnique() *bool { return v.Unique }
+
+// GetPartition returns CreateIndexInput.Partition, and is useful for accessing the field via an interface.
+func (v *CreateIndexInput) GetPartition() []*PartitionKeyInput { return v.Partition }
+
+// GetPartitionShardCount returns CreateIndexInput.PartitionShardCount, and is useful for accessing the field via an interface.
+func (v *CreateIndexInput) GetPartitionShardCount() *int { return v.PartitionShardCount }
+
+// GetSort returns CreateIndexInput.Sort, and is useful for accessing the field via an interface.
+func (v *CreateIndexInput) GetSort() []*IndexKeyInput { return v.Sort }
+
+// GetConstraints returns CreateIndexInput.Constraints, and is useful for accessing the field via an interface.
+func (v *CreateIndexInput) GetConstraints() *map[string]string { return v.Constraints }
+
+// CustomIndexEntriesEntriesEntryConnection includes the requested fields of the GraphQL type EntryConnection.
+// The GraphQL type's documentation follows.
+//
+// Connection to a list of Entry nodes.
+// Access Entry nodes directly through the `nodes` field, or access information about the connection edges with the `edges` field.
+// Use `pageInfo` to paginate responses using the cursors provided.
+type CustomIndexEntriesEntriesEntryConnection struct {
+	Nodes []*CustomIndexEntriesEntriesEntryConnectionNodesEntry `json:"nodes"`
+}
+
+// GetNodes returns CustomIndexEntriesEntriesEntryConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *CustomIndexEntriesEntriesEntryConnection) GetNodes() []*CustomIndexEntriesEntriesEntryConnectionNodesEntry {
+	return v.Nodes
+}
+
+// CustomIndexEntriesEntriesEntryConnectionNodesEntry includes the requested fields of the GraphQL type Entry.
+// The GraphQL type's documentation follows.
+//
+// An entry represents one side of a transaction in a ledger. In other systems, these may be called "ledger lines" or "journal entries".
+//
+// Entries always have an account, amount, and direction (CREDIT or DEBIT). In addition, Twisp uses the concept of "entry types" to assign every entry to a categorical type.
+//
+// Twisp enforces double-entry accounting, which in practice means that entries can only be entered in the context of a Transaction. Posting a transaction will create _at least 2_ ledger entries.
+type CustomIndexEntriesEntriesEntryConnectionNodesEntry struct {
+	// Unique identifier for the ledger entry.
+	EntryId uuid.UUID `json:"entryId"`
+	// Arbitrary structured data about this entry.
+	Metadata *map[string]interface{} `json:"metadata"`
+	// Amount of the ledger entry using the currency-supported Money type.
+	Amount CustomIndexEntriesEntriesEntryConnectionNodesEntryAmountMoney `json:"amount"`
+}
+
+// GetEntryId returns CustomIndexEntriesEntriesEntryConnectionNodesEntry.EntryId, and is useful for accessing the field via an interface.
+func (v *CustomIndexEntriesEntriesEntryConnectionNodesEntry) GetEntryId() uuid.UUID { return v.EntryId }
+
+// GetMetadata returns CustomIndexEntriesEntriesEntryConnectionNodesEntry.Metadata, and is useful for accessing the field via an interface.
+func (v *CustomIndexEntriesEntriesEntryConnectionNodesEntry) GetMetadata() *map[string]interface{} {
+	return v.Metadata
+}
+
+// GetAmount returns CustomIndexEntriesEntriesEntryConnectionNodesEntry.Amount, and is useful for accessing the field via an interface.
+func (v *CustomIndexEntriesEntriesEntryConnectionNodesEntry) GetAmount() CustomIndexEntriesEntriesEntryConnectionNodesEntryAmountMoney {
+	return v.Amount
+}
+
+// CustomIndexEntriesEntriesEntryConnectionNodesEntryAmountMoney includes the requested fields of the GraphQL type Money.
+// The GraphQL type's documentation follows.
+//
+// Money type with multi-currency support.
+//
+// Monetary amounts are represented as decimal units of currency. Fields which use the Money type can be converted to a symbolic representations by specifying a MoneyFormatInput on the `formatted` field.
+//
+// Here is an example table showing different currencies which each have their own divisions of units represented. Japanese yen (JPY) don't have a decimal minor unit, and Bahraini dinars (BHD) use 3 minor unit decimal places. The `formatted` column uses the default values for a an `en-US` locale.
+//
+// | Currency | Units    | Formatted |
+// |----------|----------|-----------|
+// | USD      | `289.27` | $289.27   |
+// | BHD      | `28.927` | 28.927 BD |
+// | JPY      | `28927`  | ¥28927    |
+type CustomIndexEntriesEntriesEntryConnectionNodesEntryAmountMoney struct {
+	Units Decimal `json:"units"`
+}
+
+// GetUnits returns CustomIndexEntriesEntriesEntryConnectionNodesEntryAmountMoney.Units, and is useful for accessing the field via an interface.
+func (v *CustomIndexEntriesEntriesEntryConnectionNodesEntryAmountMoney) GetUnits() Decimal {
+	return v.Units
+}
+
+// CustomIndexEntriesResponse is returned by CustomIndexEntries on success.
+type CustomIndexEntriesResponse struct {
+	// Select one or more entries. Specify the index to use and apply filters to your query.
+	Entries CustomIndexEntriesEntriesEntryConnection `json:"entries"`
+}
+
+// GetEntries returns CustomIndexEntriesResponse.Entries, and is useful for accessing the field via an interface.
+func (v *CustomIndexEntriesResponse) GetEntries() CustomIndexEntriesEntriesEntryConnection {
+	return v.Entries
+}
+
+// Filter conditionals for querying the partition or sort key of a custom index.
+type CustomIndexFilterValue struct {
+	// Identifier for the key to apply the filter to.
+	Alias string `json:"alias"`
+	// Conditions to apply at this key.
+	Value *FilterValue `json:"value"`
+}
+
+// GetAlias returns CustomIndexFilterValue.Alias, and is useful for accessing the field via an interface.
+func (v *CustomIndexFilterValue) GetAlias() string { return v.Alias }
+
+// GetValue returns CustomIndexFilterValue.Value, and is useful for accessing the field via an interface.
+func (v *CustomIndexFilterValue) GetValue() *FilterValue { return v.Value }
+
+// Debit or credit? Sometimes these are abbreviated to DR and CR.
+type DebitOrCredit string
+
+const (
+	DebitOrCreditDebit  DebitOrCredit = "DEBIT"
+	DebitOrCreditCredit DebitOrCredit = "CREDIT"
+)
+
+var AllDebitOrCredit = []DebitOrCredit{
+	DebitOrCreditDebit,
+	DebitOrCreditCredit,
+}
+
+// DumpAccountEntriesAccount includes the requested fields of the GraphQL type Account.
+// The GraphQL type's documentation follows.
+//
+// Accounts model all of the economic activity that your ledger provides.
+//
+// The chart of accounts is the basis for creating balance sheets, P&L reports, and for understanding the balances for the customer and business entities your business services.
+//
+// Accounts can be organized into sets with the AccountSet type. Hierarchical tree structures which roll up balances across many accounts can be modeled by nesting sets within other sets.
+type DumpAccountEntriesAccount struct {
+	// All ledger entries associated with this account.
+	Entries DumpAccountEntriesAccountEntriesEntryConnection `json:"entries"`
+}
+
+// GetEntries returns DumpAccountEntriesAccount.Entries, and is useful for accessing the field via an interface.
+func (v *DumpAccountEntriesAccount) GetEntries() DumpAccountEntriesAccountEntriesEntryConnection {
+	return v.Entries
+}
+
+// DumpAccountEntriesAccountEntriesEntryConnection includes the requested fields of the GraphQL type EntryConnection.
+// The GraphQL type's documentation follows.
+//
+// Connection to a list of Entry nodes.
+// Access Entry nodes directly through the `nodes` field, or access information about the connection edges with the `edges` field.
+// Use `pageInfo` to paginate responses using the cursors provided.
+type DumpAccountEntriesAccountEntriesEntryConnection struct {
+	Nodes []*DumpAccountEntriesAccountEntriesEntryConnectionNodesEntry `json:"nodes"`
+}
+
+// GetNodes returns DumpAccountEntriesAccountEntriesEntryConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *DumpAccountEntriesAccountEntriesEntryConnection) GetNodes() []*DumpAccountEntriesAccountEntriesEntryConnectionNodesEntry {
+	return v.Nodes
+}
+
+// DumpAccountEntriesAccountEntriesEntryConnectionNodesEntry includes the requested fields of the GraphQL type Entry.
+// The GraphQL type's documentation follows.
+//
+// An entry represents one side of a transaction in a ledger. In other systems, these may be called "ledger lines" or "journal entries".
+//
+// Entries always have an account, amount, and direction (CREDIT or DEBIT). In addition, Twisp uses the concept of "entry types" to assign every entry to a categorical type.
+//
+// Twisp enforces double-entry accounting, which in practice means that entries can only be entered in the context of a Transaction. Posting a transaction will create _at least 2_ ledger entries.
+type DumpAccountEntriesAccountEntriesEntryConnectionNodesEntry struct {
+	// Unique identifier for the transaction which posted this entry. Every entry is associated with a transaction.
+	TransactionId uuid.UUID `json:"transactionId"`
+	// Type code for the entry.
+	EntryType string `json:"entryType"`
+	// The side of the ledger (DEBIT or CREDIT) this entry is posted on.
+	Direction DebitOrCredit `json:"direction"`
+	// The layer on which this entry is recorded (SETTLED, PENDING, or ENCUMBRANCE).
+	Layer Layer `json:"layer"`
+	// Amount of the ledger entry using the currency-supported Money type.
+	Amount DumpAccountEntriesAccountEntriesEntryConnectionNodesEntryAmountMoney `json:"amount"`
+	// Date and time when the entry was posted.
+	Created Timestamp `json:"created"`
+	// Reference to the transaction which posted this entry.
+	Transaction DumpAccountEntriesAccountEntriesEntryConnectionNodesEntryTransaction `json:"transaction"`
+}
+
+// GetTransactionId returns DumpAccountEntriesAccountEntriesEntryConnectionNodesEntry.TransactionId, and is useful for accessing the field via an interface.
+func (v *DumpAccountEntriesAccountEntriesEntryConnectionNodesEntry) GetTransactionId() uuid.UUID {
+	return v.TransactionId
+}
+
+// GetEntryType returns DumpAccountEntriesAccountEntriesEntryConnectionNodesEntry.EntryType, and is useful for accessing the field via an interface.
+func (v *DumpAccountEntriesAccountEntriesEntryConnectionNodesEntry) GetEntryType() string {
+	return v.EntryType
+}
+
+// GetDirection returns DumpAccountEntriesAccountEntriesEntryConnectionNodesEntry.Direction, and is useful for accessing the field via an interface.
+func (v *DumpAccountEntriesAccountEntriesEntryConnectionNodesEntry) GetDirection() DebitOrCredit {
+	return v.Direction
+}
+
+// GetLayer returns DumpAccountEntriesAccountEntriesEntryConnectionNodesEntry.Layer, and is useful for accessing the field via an interface.
+func (v *DumpAccountEntriesAccountEntriesEntryConnectionNodesEntry) GetLayer() Layer { return v.Layer }
+
+// GetAmount returns DumpAccountEntriesAccountEntriesEntryConnectionNodesEntry.Amount, and is useful for accessing the field via an interface.
+func (v *DumpAccountEntriesAccountEntriesEntryConnectionNodesEntry) GetAmount() DumpAccountEntriesAccountEntriesEntryConnectionNodesEntryAmountMoney {
+	return v.Amount
+}
+
+// GetCreated returns DumpAccountEntriesAccountEntriesEntryConnectionNodesEntry.Created, and is useful for accessing the field via an interface.
+func (v *DumpAccountEntriesAccountEntriesEntryConnectionNodesEntry) GetCreated() Timestamp {
+	return v.Created
+}
+
+// GetTransaction returns DumpAccountEntriesAccountEntriesEntryConnectionNodesEntry.Transaction, and is useful for accessing the field via an interface.
+func (v *DumpAccountEntriesAccountEntriesEntryConnectionNodesEntry) GetTransaction() DumpAccountEntriesAccountEntriesEntryConnectionNodesEntryTransaction {
+	return v.Transaction
+}
+
+// DumpAccountEntriesAccountEntriesEntryConnectionNodesEntryAmountMoney includes the requested fields of the GraphQL type Money.
+// The GraphQL type's documentation follows.
+//
+// Money type with multi-currency support.
+//
+// Monetary amounts are represented as decimal units of currency. Fields which use the Money type can be converted to a symbolic representations by specifying a MoneyFormatInput on the `formatted` field.
+//
+// Here is an example table showing different currencies which each have their own divisions of units represented. Japanese yen (JPY) don't have a decimal minor unit, and Bahraini dinars (BHD) use 3 minor unit decimal places. The `formatted` column uses the default values for a an `en-US` locale.
+//
+// | Currency | Units    | Formatted |
+// |----------|----------|-----------|
+// | USD      | `289.27` | $289.27   |
+// | BHD      | `28.927` | 28.927 BD |
+// | JPY      | `28927`  | ¥28927    |
+type DumpAccountEntriesAccountEntriesEntryConnectionNodesEntryAmountMoney struct {
+	Units    Decimal `json:"units"`
+	Currency string  `json:"currency"`
+}
+
+// GetUnits returns DumpAccountEntriesAccountEntriesEntryConnectionNodesEntryAmountMoney.Units, and is useful for accessing the field via an interface.
+func (v *DumpAccountEntriesAccountEntriesEntryConnectionNodesEntryAmountMoney) GetUnits() Decimal {
+	return v.Units
+}
+
+// GetCurrency returns DumpAccountEntriesAccountEntriesEntryConnectionNodesEntryAmountMoney.Currency, and is useful for accessing the field via an interface.
+func (v *DumpAccountEntriesAccountEntriesEntryConnectionNodesEntryAmountMoney) GetCurrency() string {
+	return v.Currency
+}
+
+// DumpAccountEntriesAccountEntriesEntryConnectionNodesEntryTransaction includes the requested fields of the GraphQL type Transaction.
+// The GraphQL type's documentation follows.
+//
+// Transactions record all accounting events in the ledger. In Twisp, the only way to write to a ledger is through a transaction.
+//
+// Every transaction writes two or more entries to the ledger in standard double-entry accounting practice.
+//
+// Twisp expands upon the basic principle of an accounting transaction with additional features like transaction codes and correlations.
+type DumpAccountEntriesAccountEntriesEntryConnectionNodesEntryTransaction struct {
+	// The effective date records when the transaction is recorded as occurring for accounting purposes. Determines the accounting period within which the transaction is counted.
+	Effective Date `json:"effective"`
+	// Reference to the tran code used by this transaction.
+	TranCode DumpAccountEntriesAccountEntriesEntryConnectionNodesEntryTransactionTranCode `json:"tranCode"`
+}
+
+// GetEffective returns DumpAccountEntriesAccountEntriesEntryConnectionNodesEntryTransaction.Effective, and is useful for accessing the field via an interface.
+func (v *DumpAccountEntriesAccountEntriesEntryConnectionNodesEntryTransaction) GetEffective() Date {
+	return v.Effective
+}
+
+// GetTranCode returns DumpAccountEntriesAccountEntriesEntryConnectionNodesEntryTransaction.TranCode, and is useful for accessing the field via an interface.
+func (v *DumpAccountEntriesAccountEntriesEntryConnectionNodesEntryTransaction) GetTranCode() DumpAccountEntriesAccountEntriesEntryConnectionNodesEntryTransactionTranCode {
+	return v.TranCode
+}
+
+// DumpAccountEntriesAccountEntriesEntryConnectionNodesEntryTransactionTranCode includes the requested fields of the GraphQL type TranCode.
+// The GraphQL type's documentation follows.
+//
+// Transaction Codes (tran codes) are how financial engineers do double-entry accounting. They encode the basic patterns for a type of transaction as a predictable and repeatable formula.
+//
+// You can think of tran codes as function signatures which define how a transaction acts upon the ledger.
+type DumpAccountEntriesAccountEntriesEntryConnectionNodesEntryTransactionTranCode struct {
+	// The tran code represented as a unique string identifier.
+	//
+	// The code itself is a shorthand for the behavior represented. For example, the code `ACH_CREDIT` may represent a transaction writing two entries: an `ACH_DR` entry and an `ACH_CR` entry.
+	Code string `json:"code"`
+}
+
+// GetCode returns DumpAccountEntriesAccountEntriesEntryConnectionNodesEntryTransactionTranCode.Code, and is useful for accessing the field via an interface.
+func (v *DumpAccountEntriesAccountEntriesEntryConnectionNodesEntryTransactionTranCode) GetCode() string {
+	return v.Code
+}
+
+// DumpAccountEntriesResponse is returned by DumpAccountEntries on success.
+type DumpAccountEntriesResponse struct {
+	// Get a single account by its `accountId`.
+	Account *DumpAccountEntriesAccount `json:"account"`
+}
+
+// GetAccount returns DumpAccountEntriesResponse.Account, and is useful for accessing the field via an interface.
+func (v *DumpAccountEntriesResponse) GetAccount() *DumpAccountEntriesAccount { return v.Account }
+
+// DumpAccountsAccountsAccountConnection includes the requested fields of the GraphQL type AccountConnection.
+// The GraphQL type's documentation follows.
+//
+// Connection to a list of Account nodes.
+// Access Account nodes directly through the `nodes` field, or access information about the connection edges with the `edges` field.
+// Use `pageInfo` to paginate responses using the cursors provided.
+type DumpAccountsAccountsAccountConnection struct {
+	Nodes []*DumpAccountsAccountsAccountConnectionNodesAccount `json:"nodes"`
+}
+
+// GetNodes returns DumpAccountsAccountsAccountConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *DumpAccountsAccountsAccountConnection) GetNodes() []*DumpAccountsAccountsAccountConnectionNodesAccount {
+	return v.Nodes
+}
+
+// DumpAccountsAccountsAccountConnectionNodesAccount includes the requested fields of the GraphQL type Account.
+// The GraphQL type's documentation follows.
+//
+// Accounts model all of the economic activity that your ledger provides.
+//
+// The chart of accounts is the basis for creating balance sheets, P&L reports, and for understanding the balances for the customer and business entities your business services.
+//
+// Accounts can be organized into sets with the AccountSet type. Hierarchical tree structures which roll up balances across many accounts can be modeled by nesting sets within other sets.
+type DumpAccountsAccountsAccountConnectionNodesAccount struct {
+	// Unique identifier for the account.
+	AccountId uuid.UUID `json:"accountId"`
+	// Shorthand code for the account, often an abbreviated version of the account name.
+	// Example: 'ACH_RECON' for an account named 'ACH Reconciliation'.
+	Code string `json:"code"`
+	// Account name. @example("Bill Pay Settlement") @example("Courtesy Credit")
+	Name string `json:"name"`
+	// Flag indicating whether this account uses a "debit normal" or a "credit normal" balance.
+	//
+	// In double-entry accounting, accounts with a debit normal balance use the balance calculation `balance = debits - credits`. This is used for asset and expense account types.
+	//
+	// Accounts with a credit normal balance, in contrast, calculate their balance with the equation `balance = credits - debits`. This is the default type for liabilities, equity, and revenue account types.
+	NormalBalanceType DebitOrCredit `json:"normalBalanceType"`
+	// Reference to the balance for a specific journal and currency (defaults to "USD").
+	Balance *DumpAccountsAccountsAccountConnectionNodesAccountBalance `json:"balance"`
+}
+
+// GetAccountId returns DumpAccountsAccountsAccountConnectionNodesAccount.AccountId, and is useful for accessing the field via an interface.
+func (v *DumpAccountsAccountsAccountConnectionNodesAccount) GetAccountId() uuid.UUID {
+	return v.AccountId
+}
+
+// GetCode returns DumpAccountsAccountsAccountConnectionNodesAccount.Code, and is useful for accessing the field via an interface.
+func (v *DumpAccountsAccountsAccountConnectionNodesAccount) GetCode() string { return v.Code }
+
+// GetName returns DumpAccountsAccountsAccountConnectionNodesAccount.Name, and is useful for accessing the field via an interface.
+func (v *DumpAccountsAccountsAccountConnectionNodesAccount) GetName() string { return v.Name }
+
+// GetNormalBalanceType returns DumpAccountsAccountsAccountConnectionNodesAccount.NormalBalanceType, and is useful for accessing the field via an interface.
+func (v *DumpAccountsAccountsAccountConnectionNodesAccount) GetNormalBalanceType() DebitOrCredit {
+	return v.NormalBalanceType
+}
+
+// GetBalance returns DumpAccountsAccountsAccountConnectionNodesAccount.Balance, and is useful for accessing the field via an interface.
+func (v *DumpAccountsAccountsAccountConnectionNodesAccount) GetBalance() *DumpAccountsAccountsAccountConnectionNodesAccountBalance {
+	return v.Balance
+}
+
+// DumpAccountsAccountsAccountConnectionNodesAccountBalance includes the requested fields of the GraphQL type Balance.
+// The GraphQL type's documentation follows.
+//
+// Balances are auto-calculated sums of the entries for a given account.
+//
+// Every balance record maintains a `drBalance` for entries on the debit side of the ledger and a `crBalance` for credit entries.
+//
+// Additionally, every account has a `normalBalance`, which is equal to `crBalance - drBalance` for credit normal accounts, and `drBalance - crBalance` for debit normal accounts.
+//
+// Each account can have balances across all three layers: SETTLED, PENDING, and ENCUMBRANCE.
+type DumpAccountsAccountsAccountConnectionNodesAccountBalance struct {
+	// The balance amounts available by combining the provided layer with all layers above.
+	Available DumpAccountsAccountsAccountConnectionNodesAccountBalanceAvailableBalanceAmount `json:"available"`
+}
+
+// GetAvailable returns DumpAccountsAccountsAccountConnectionNodesAccountBalance.Available, and is useful for accessing the field via an interface.
+func (v *DumpAccountsAccountsAccountConnectionNodesAccountBalance) GetAvailable() DumpAccountsAccountsAccountConnectionNodesAccountBalanceAvailableBalanceAmount {
+	return v.Available
+}
+
+// DumpAccountsAccountsAccountConnectionNodesAccountBalanceAvailableBalanceAmount includes the requested fields of the GraphQL type BalanceAmount.
+type DumpAccountsAccountsAccountConnectionNodesAccountBalanceAvailableBalanceAmount struct {
+	// The "normal balance" for an account is different for credit normal and debit normal accounts.
+	//
+	// For credit normal accounts, the normal balance is equal to `crBalance - drBalance`.
+	// For debit normal accounts, the normal balance is the reverse: `drBalance - crBalance`.
+	NormalBalance DumpAccountsAccountsAccountConnectionNodesAccountBalanceAvailableBalanceAmountNormalBalanceMoney `json:"normalBalance"`
+}
+
+// GetNormalBalance returns DumpAccountsAccountsAccountConnectionNodesAccountBalanceAvailableBalanceAmount.NormalBalance, and is useful for accessing the field via an interface.
+func (v *DumpAccountsAccountsAccountConnectionNodesAccountBalanceAvailableBalanceAmount) GetNormalBalance() DumpAccountsAccountsAccountConnectionNodesAccountBalanceAvailableBalanceAmountNormalBalanceMoney {
+	return v.NormalBalance
+}
+
+// DumpAccountsAccountsAccountConnectionNodesAccountBalanceAvailableBalanceAmountNormalBalanceMoney includes the requested fields of the GraphQL type Money.
+// The GraphQL type's documentation follows.
+//
+// Money type with multi-currency support.
+//
+// Monetary amounts are represented as decimal units of currency. Fields which use the Money type can be converted to a symbolic representations by specifying a MoneyFormatInput on the `formatted` field.
+//
+// Here is an example table showing different currencies which each have their own divisions of units represented. Japanese yen (JPY) don't have a decimal minor unit, and Bahraini dinars (BHD) use 3 minor unit decimal places. The `formatted` column uses the default values for a an `en-US` locale.
+//
+// | Currency | Units    | Formatted |
+// |----------|----------|-----------|
+// | USD      | `289.27` | $289.27   |
+// | BHD      | `28.927` | 28.927 BD |
+// | JPY      | `28927`  | ¥28927    |
+type DumpAccountsAccountsAccountConnectionNodesAccountBalanceAvailableBalanceAmountNormalBalanceMoney struct {
+	Units    Decimal `json:"units"`
+	Currency string  `json:"currency"`
+}
+
+// GetUnits returns DumpAccountsAccountsAccountConnectionNodesAccountBalanceAvailableBalanceAmountNormalBalanceMoney.Units, and is useful for accessing the field via an interface.
+func (v *DumpAccountsAccountsAccountConnectionNodesAccountBalanceAvailableBalanceAmountNormalBalanceMoney) GetUnits() Decimal {
+	return v.Units
+}
+
+// GetCurrency returns DumpAccountsAccountsAccountConnectionNodesAccountBalanceAvailableBalanceAmountNormalBalanceMoney.Currency, and is useful for accessing the field via an interface.
+func (v *DumpAccountsAccountsAccountConnectionNodesAccountBalanceAvailableBalanceAmountNormalBalanceMoney) GetCurrency() string {
+	return v.Currency
+}
+
+// DumpAccountsResponse is returned by DumpAccounts on success.
+type DumpAccountsResponse struct {
+	// Select one or more accounts. Specify the index to use and apply filters to your query.
+	Accounts DumpAccountsAccountsAccountConnection `json:"accounts"`
+}
+
+// GetAccounts returns DumpAccountsResponse.Accounts, and is useful for accessing the field via an interface.
+func (v *DumpAccountsResponse) GetAccounts() DumpAccountsAccountsAccountConnection { return v.Accounts }
+
+// FetchJournalJournal includes the requested fields of the GraphQL type Journal.
+// The GraphQL type's documentation follows.
+//
+// Journals allow for the organizing of transactions within separate "books".
+//
+// In many cases, users only need a single journal. For this reason, Twisp always contains a default journal with code `DEFAULT`.
+//
+// Journals can be used for a variety of functions. For example, users may create separate journals for different currencies, or product-specific journals.
+type FetchJournalJournal struct {
+	// Unique identifier for the journal.
+	JournalId uuid.UUID `json:"journalId"`
+	// Name for the journal.
+	Name string `json:"name"`
+	// Description of the journal.
+	Description string `json:"description"`
+	// Optional unique code for the journal. The default journal uses the code `DEFAULT`.
+	Code *string `json:"code"`
+	// Operational status of the journal. `ACTIVE` journals can be written to with `postTransaction`, whereas `LOCKED` journals do not allow transactions to be posted to them.
+	Status Status `json:"status"`
+	// Journal specific configuration options for transactions and balances
+	// recorded in this journal.
+	Config FetchJournalJournalConfig `json:"config"`
+	// Date and time when the journal was first created.
+	Created Timestamp `json:"created"`
+	// Time of the last change. Especially useful when reviewing the `history`.
+	Modified Timestamp `json:"modified"`
+}
+
+// GetJournalId returns FetchJournalJournal.JournalId, and is useful for accessing the field via an interface.
+func (v *FetchJournalJournal) GetJournalId() uuid.UUID { return v.JournalId }
+
+// GetName returns FetchJournalJournal.Name, and is useful for accessing the field via an interface.
+func (v *FetchJournalJournal) GetName() string { return v.Name }
+
+// GetDescription returns FetchJournalJournal.Description, and is useful for accessing the field via an interface.
+func (v *FetchJournalJournal) GetDescription() string { return v.Description }
+
+// GetCode returns FetchJournalJournal.Code, and is useful for accessing the field via an interface.
+func (v *FetchJournalJournal) GetCode() *string { return v.Code }
+
+// GetStatus returns FetchJournalJournal.Status, and is useful for accessing the field via an interface.
+func (v *FetchJournalJournal) GetStatus() Status { return v.Status }
+
+// GetConfig returns FetchJournalJournal.Config, and is useful for accessing the field via an interface.
+func (v *FetchJournalJournal) GetConfig() FetchJournalJournalConfig { return v.Config }
+
+// GetCreated returns FetchJournalJournal.Created, and is useful for accessing the field via an interface.
+func (v *FetchJournalJournal) GetCreated() Timestamp { return v.Created }
+
+// GetModified returns FetchJournalJournal.Modified, and is useful for accessing the field via an interface.
+func (v *FetchJournalJournal) GetModified() Timestamp { return v.Modified }
+
+// FetchJournalJournalConfig includes the requested fields of the GraphQL type JournalConfig.
+// The GraphQL type's documentation follows.
+//
+// System configuration for a journal.
+type FetchJournalJournalConfig struct {
+	// When `true`, records point-in-time effective balances for all accounts in the journal.
+	// Defaults to `false`.
+	EnableEffectiveBalances bool `json:"enableEffectiveBalances"`
+}
+
+// GetEnableEffectiveBalances returns FetchJournalJournalConfig.EnableEffectiveBalances, and is useful for accessing the field via an interface.
+func (v *FetchJournalJournalConfig) GetEnableEffectiveBalances() bool {
+	return v.EnableEffectiveBalances
+}
+
+// FetchJournalResponse is returned by FetchJournal on success.
+type FetchJournalResponse struct {
+	// Get a single journal by its `journalId`. If `journalId` is omitted, return the default journal.
+	Journal *FetchJournalJournal `json:"journal"`
+}
+
+// GetJournal returns FetchJournalResponse.Journal, and is useful for accessing the field via an interface.
+func (v *FetchJournalResponse) GetJournal() *FetchJournalJournal { return v.Journal }
+
+// FetchTransactionEntriesResponse is returned by FetchTransactionEntries on success.
+type FetchTransactionEntriesResponse struct {
+	// Get a single transaction by its `transactionId`.
+	Transaction *FetchTransactionEntriesTransaction `json:"transaction"`
+}
+
+// GetTransaction returns FetchTransactionEntriesResponse.Transaction, and is useful for accessing the field via an interface.
+func (v *FetchTransactionEntriesResponse) GetTransaction() *FetchTransactionEntriesTransaction {
+	return v.Transaction
+}
+
+// FetchTransactionEntriesTransaction includes the requested fields of the GraphQL type Transaction.
+// The GraphQL type's documentation follows.
+//
+// Transactions record all accounting events in the ledger. In Twisp, the only way to write to a ledger is through a transaction.
+//
+// Every transaction writes two or more entries to the ledger in standard double-entry accounting practice.
+//
+// Twisp expands upon the basic principle of an accounting transaction with additional features like transaction codes and correlations.
+type FetchTransactionEntriesTransaction struct {
+	// Ledger entries written by the transaction.
+	Entries FetchTransactionEntriesTransactionEntriesEntryConnection `json:"entries"`
+}
+
+// GetEntries returns FetchTransactionEntriesTransaction.Entries, and is useful for accessing the field via an interface.
+func (v *FetchTransactionEntriesTransaction) GetEntries() FetchTransactionEntriesTransactionEntriesEntryConnection {
+	return v.Entries
+}
+
+// FetchTransactionEntriesTransactionEntriesEntryConnection includes the requested fields of the GraphQL type EntryConnection.
+// The GraphQL type's documentation follows.
+//
+// Connection to a list of Entry nodes.
+// Access Entry nodes directly through the `nodes` field, or access information about the connection edges with the `edges` field.
+// Use `pageInfo` to paginate responses using the cursors provided.
+type FetchTransactionEntriesTransactionEntriesEntryConnection struct {
+	Nodes    []*FetchTransactionEntriesTransactionEntriesEntryConnectionNodesEntry `json:"nodes"`
+	PageInfo FetchTransactionEntriesTransactionEntriesEntryConnectionPageInfo      `json:"pageInfo"`
+}
+
+// GetNodes returns FetchTransactionEntriesTransactionEntriesEntryConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *FetchTransactionEntriesTransactionEntriesEntryConnection) GetNodes() []*FetchTransactionEntriesTransactionEntriesEntryConnectionNodesEntry {
+	return v.Nodes
+}
+
+// GetPageInfo returns FetchTransactionEntriesTransactionEntriesEntryConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *FetchTransactionEntriesTransactionEntriesEntryConnection) GetPageInfo() FetchTransactionEntriesTransactionEntriesEntryConnectionPageInfo {
+	return v.PageInfo
+}
+
+// FetchTransactionEntriesTransactionEntriesEntryConnectionNodesEntry includes the requested fields of the GraphQL type Entry.
+// The GraphQL type's documentation follows.
+//
+// An entry represents one side of a transaction in a ledger. In other systems, these may be called "ledger lines" or "journal entries".
+//
+// Entries always have an account, amount, and direction (CREDIT or DEBIT). In addition, Twisp uses the concept of "entry types" to assign every entry to a categorical type.
+//
+// Twisp enforces double-entry accounting, which in practice means that entries can only be entered in the context of a Transaction. Posting a transaction will create _at least 2_ ledger entries.
+type FetchTransactionEntriesTransactionEntriesEntryConnectionNodesEntry struct {
+	// Unique identifier for the ledger entry.
+	EntryId uuid.UUID `json:"entryId"`
+	// ID of the account to be debited/credited.
+	AccountId uuid.UUID `json:"accountId"`
+	// The journal identifier of the ledger entry.
+	JournalId uuid.UUID `json:"journalId"`
+	// Type code for the entry.
+	EntryType string `json:"entryType"`
+	// The layer on which this entry is recorded (SETTLED, PENDING, or ENCUMBRANCE).
+	Layer Layer `json:"layer"`
+	// The side of the ledger (DEBIT or CREDIT) this entry is posted on.
+	Direction DebitOrCredit `json:"direction"`
+	// The order in which this entry was posted within the context of a transaction.
+	//
+	// This order is auto-generated at time of posting and is determined by the position of the entries posted within the transaction.
+	Sequence int `json:"sequence"`
+	// Amount of the ledger entry using the currency-supported Money type.
+	Amount FetchTransactionEntriesTransactionEntriesEntryConnectionNodesEntryAmountMoney `json:"amount"`
+}
+
+// GetEntryId returns FetchTransactionEntriesTransactionEntriesEntryConnectionNodesEntry.EntryId, and is useful for accessing the field via an interface.
+func (v *FetchTransactionEntriesTransactionEntriesEntryConnectionNodesEntry) GetEntryId() uuid.UUID {
+	return v.EntryId
+}
+
+// GetAccountId returns FetchTransactionEntriesTransactionEntriesEntryConnectionNodesEntry.AccountId, and is useful for accessing the field via an interface.
+func (v *FetchTransactionEntriesTransactionEntriesEntryConnectionNodesEntry) GetAccountId() uuid.UUID {
+	return v.AccountId
+}
+
+// GetJournalId returns FetchTransactionEntriesTransactionEntriesEntryConnectionNodesEntry.JournalId, and is useful for accessing the field via an interface.
+func (v *FetchTransactionEntriesTransactionEntriesEntryConnectionNodesEntry) GetJournalId() uuid.UUID {
+	return v.JournalId
+}
+
+// GetEntryType returns FetchTransactionEntriesTransactionEntriesEntryConnectionNodesEntry.EntryType, and is useful for accessing the field via an interface.
+func (v *FetchTransactionEntriesTransactionEntriesEntryConnectionNodesEntry) GetEntryType() string {
+	return v.EntryType
+}
+
+// GetLayer returns FetchTransactionEntriesTransactionEntriesEntryConnectionNodesEntry.Layer, and is useful for accessing the field via an interface.
+func (v *FetchTransactionEntriesTransactionEntriesEntryConnectionNodesEntry) GetLayer() Layer {
+	return v.Layer
+}
+
+// GetDirection returns FetchTransactionEntriesTransactionEntriesEntryConnectionNodesEntry.Direction, and is useful for accessing the field via an interface.
+func (v *FetchTransactionEntriesTransactionEntriesEntryConnectionNodesEntry) GetDirection() DebitOrCredit {
+	return v.Direction
+}
+
+// GetSequence returns FetchTransactionEntriesTransactionEntriesEntryConnectionNodesEntry.Sequence, and is useful for accessing the field via an interface.
+func (v *FetchTransactionEntriesTransactionEntriesEntryConnectionNodesEntry) GetSequence() int {
+	return v.Sequence
+}
+
+// GetAmount returns FetchTransactionEntriesTransactionEntriesEntryConnectionNodesEntry.Amount, and is useful for accessing the field via an interface.
+func (v *FetchTransactionEntriesTransactionEntriesEntryConnectionNodesEntry) GetAmount() FetchTransactionEntriesTransactionEntriesEntryConnectionNodesEntryAmountMoney {
+	return v.Amount
+}
+
+// FetchTransactionEntriesTransactionEntriesEntryConnectionNodesEntryAmountMoney includes the requested fields of the GraphQL type Money.
+// The GraphQL type's documentation follows.
+//
+// Money type with multi-currency support.
+//
+// Monetary amounts are represented as decimal units of currency. Fields which use the Money type can be converted to a symbolic representations by specifying a MoneyFormatInput on the `formatted` field.
+//
+// Here is an example table showing different currencies which each have their own divisions of units represented. Japanese yen (JPY) don't have a decimal minor unit, and Bahraini dinars (BHD) use 3 minor unit decimal places. The `formatted` column uses the default values for a an `en-US` locale.
+//
+// | Currency | Units    | Formatted |
+// |----------|----------|-----------|
+// | USD      | `289.27` | $289.27   |
+// | BHD      | `28.927` | 28.927 BD |
+// | JPY      | `28927`  | ¥28927    |
+type FetchTransactionEntriesTransactionEntriesEntryConnectionNodesEntryAmountMoney struct {
+	Units    Decimal `json:"units"`
+	Currency string  `json:"currency"`
+}
+
+// GetUnits returns FetchTransactionEntriesTransactionEntriesEntryConnectionNodesEntryAmountMoney.Units, and is useful for accessing the field via an interface.
+func (v *FetchTransactionEntriesTransactionEntriesEntryConnectionNodesEntryAmountMoney) GetUnits() Decimal {
+	return v.Units
+}
+
+// GetCurrency returns FetchTransactionEntriesTransactionEntriesEntryConnectionNodesEntryAmountMoney.Currency, and is useful for accessing the field via an interface.
+func (v *FetchTransactionEntriesTransactionEntriesEntryConnectionNodesEntryAmountMoney) GetCurrency() string {
+	return v.Currency
+}
+
+// FetchTransactionEntriesTransactionEntriesEntryConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type FetchTransactionEntriesTransactionEntriesEntryConnectionPageInfo struct {
+	// True if there are nodes in the connection after the current page / end cursor.
+	HasNextPage bool `json:"hasNextPage"`
+	// Query cursor for the last node in the current page.
+	EndCursor *string `json:"endCursor"`
+}
+
+// GetHasNextPage returns FetchTransactionEntriesTransactionEntriesEntryConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *FetchTransactionEntriesTransactionEntriesEntryConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns FetchTransactionEntriesTransactionEntriesEntryConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *FetchTransactionEntriesTransactionEntriesEntryConnectionPageInfo) GetEndCursor() *string {
+	return v.EndCursor
+}
+
+// Conditional logic by which to apply a filter on a query.
+//
+// Each FilterValue object must contain just one key/value pair.
+//
+// Valid: `{ eq: "123" }`\
+// Invalid: `{ eq: "123", gt: "100" }`
+type FilterValue struct {
+	Eq      *string  `json:"eq"`
+	Like    *string  `json:"like"`
+	Lt      *string  `json:"lt"`
+	Lte     *string  `json:"lte"`
+	Gt      *string  `json:"gt"`
+	Gte     *string  `json:"gte"`
+	All     *bool    `json:"all"`
+	Between *Between `json:"between"`
+}
+
+// GetEq returns FilterValue.Eq, and is useful for accessing the field via an interface.
+func (v *FilterValue) GetEq() *string { return v.Eq }
+
+// GetLike returns FilterValue.Like, and is useful for accessing the field via an interface.
+func (v *FilterValue) GetLike() *string { return v.Like }
+
+// GetLt returns FilterValue.Lt, and is useful for accessing the field via an interface.
+func (v *FilterValue) GetLt() *string { return v.Lt }
+
+// GetLte returns FilterValue.Lte, and is useful for accessing the field via an interface.
+func (v *FilterValue) GetLte() *string { return v.Lte }
+
+// GetGt returns FilterValue.Gt, and is useful for accessing the field via an interface.
+func (v *FilterValue) GetGt() *string { return v.Gt }
+
+// GetGte returns FilterValue.Gte, and is useful for accessing the field via an interface.
+func (v *FilterValue) GetGte() *string { return v.Gte }
+
+// GetAll returns FilterValue.All, and is useful for accessing the field via an interface.
+func (v *FilterValue) GetAll() *bool { return v.All }
+
+// GetBetween returns FilterValue.Between, and is useful for accessing the field via an interface.
+func (v *FilterValue) GetBetween() *Between { return v.Between }
+
+type IndexDataType string
+
+const (
+	IndexDataTypeInt       IndexDataType = "INT"
+	IndexDataTypeUint      IndexDataType = "UINT"
+	IndexDataTypeDouble    IndexDataType = "DOUBLE"
+	IndexDataTypeBool      IndexDataType = "BOOL"
+	IndexDataTypeString    IndexDataType = "STRING"
+	IndexDataTypeBytes     IndexDataType = "BYTES"
+	IndexDataTypeDuration  IndexDataType = "DURATION"
+	IndexDataTypeTimestamp IndexDataType = "TIMESTAMP"
+	IndexDataTypeUuid      IndexDataType = "UUID"
+	IndexDataTypeDate      IndexDataType = "DATE"
+	IndexDataTypeMoney     IndexDataType = "MONEY"
+	IndexDataTypeDecimal   IndexDataType = "DECIMAL"
+)
+
+var AllIndexDataType = []IndexDataType{
+	IndexDataTypeInt,
+	IndexDataTypeUint,
+	IndexDataTypeDouble,
+	IndexDataTypeBool,
+	IndexDataTypeString,
+	IndexDataTypeBytes,
+	IndexDataTypeDuration,
+	IndexDataTypeTimestamp,
+	IndexDataTypeUuid,
+	IndexDataTypeDate,
+	IndexDataTypeMoney,
+	IndexDataTypeDecimal,
+}
+
+// Specify a named expression to sort the records within a custom index.
+//
+// Used for sorting and for querying by range conditions.
+type IndexKeyInput struct {
+	// Identifier for this key. Should be a short, human-readable name.
+	Alias string `json:"alias"`
+	// CEL expression which resolves to the value that is to be sorted.
+	//
+	// Within the expression, the `document` object represents the record. To sort by a field on the record, use `document.<field_name>`.
+	Value string `json:"value"`
+	// Whether the sort is in ascending or descending order.
+	Sort SortOrder `json:"sort"`
+	// Optionally provide explicit type for value. Useful for metadata values which may be list of monomorphic types.
+	//
+	// @example("type: STRING")
+	Type *IndexDataType `json:"type"`
+}
+
+// GetAlias returns IndexKeyInput.Alias, and is useful for accessing the field via an interface.
+func (v *IndexKeyInput) GetAlias() string { return v.Alias }
+
+// GetValue returns IndexKeyInput.Value, and is useful for accessing the field via an interface.
+func (v *IndexKeyInput) GetValue() string { return v.Value }
+
+// GetSort returns IndexKeyInput.Sort, and is useful for accessing the field via an interface.
+func (v *IndexKeyInput) GetSort() SortOrder { return v.Sort }
+
+// GetType returns IndexKeyInput.Type, and is useful for accessing the field via an interface.
+func (v *IndexKeyInput) GetType() *IndexDataType { return v.Type }
+
+// Record types which support custom indexes.
+type IndexOnEnum string
+
+const (
+	IndexOnEnumAccount     IndexOnEnum = "Account"
+	IndexOnEnumAccountset  IndexOnEnum = "AccountSet"
+	IndexOnEnumBalance     IndexOnEnum = "Balance"
+	IndexOnEnumTransaction IndexOnEnum = "Transaction"
+	IndexOnEnumTrancode    IndexOnEnum = "TranCode"
+	IndexOnEnumEntry       IndexOnEnum = "Entry"
+)
+
+var AllIndexOnEnum = []IndexOnEnum{
+	IndexOnEnumAccount,
+	IndexOnEnumAccountset,
+	IndexOnEnumBalance,
+	IndexOnEnumTransaction,
+	IndexOnEnumTrancode,
+	IndexOnEnumEntry,
+}
+
+// JournalLockStatusJournal includes the requested fields of the GraphQL type Journal.
+// The GraphQL type's documentation follows.
+//
+// Journals allow for the organizing of transactions within separate "books".
+//
+// In many cases, users only need a single journal. For this reason, Twisp always contains a default journal with code `DEFAULT`.
+//
+// Journals can be used for a variety of functions. For example, users may create separate journals for different currencies, or product-specific journals.
+type JournalLockStatusJournal struct {
+	// Unique identifier for the journal.
+	JournalId uuid.UUID `json:"journalId"`
+	// Operational status of the journal. `ACTIVE` journals can be written to with `postTransaction`, whereas `LOCKED` journals do not allow transactions to be posted to them.
+	Status Status `json:"status"`
+}
+
+// GetJournalId returns JournalLockStatusJournal.JournalId, and is useful for accessing the field via an interface.
+func (v *JournalLockStatusJournal) GetJournalId() uuid.UUID { return v.JournalId }
+
+// GetStatus returns JournalLockStatusJournal.Status, and is useful for accessing the field via an interface.
+func (v *JournalLockStatusJournal) GetStatus() Status { return v.Status }
+
+// JournalLockStatusResponse is returned by JournalLockStatus on success.
+type JournalLockStatusResponse struct {
+	// Get a single journal by its `journalId`. If `journalId` is omitted, return the default journal.
+	Journal *JournalLockStatusJournal `json:"journal"`
+}
+
+// GetJournal returns JournalLockStatusResponse.Journal, and is useful for accessing the field via an interface.
+func (v *JournalLockStatusResponse) GetJournal() *JournalLockStatusJournal { return v.Journal }
+
+// The ledger can apply a entries to one of three layers: SETTLED, PENDING, and ENCUMBRANCE.
+//
+// The SETTLED layer is what is actually fully settled.
+//
+// The PENDING layer is what's settled but also includes holds and pending charges. This can be used to verify the account will have enough funds after the holds and pending transactions have cleared.
+//
+// The ENCUMBRANCE layer allows us to add future transactions that are scheduled and also goals or budgeting tools to set money aside in the account.
+type Layer string
+
+const (
+	LayerSettled     Layer = "SETTLED"
+	LayerPending     Layer = "PENDING"
+	LayerEncumbrance Layer = "ENCUMBRANCE"
+)
+
+var AllLayer = []Layer{
+	LayerSettled,
+	LayerPending,
+	LayerEncumbrance,
+}
+
+// ListAccountsAccountsAccountConnection includes the requested fields of the GraphQL type AccountConnection.
+// The GraphQL type's documentation follows.
+//
+// Connection to a list of Account nodes.
+// Access Account nodes directly through the `nodes` field, or access information about the connection edges with the `edges` field.
+// Use `pageInfo` to paginate responses using the cursors provided.
+type ListAccountsAccountsAccountConnection struct {
+	Nodes    []*ListAccountsAccountsAccountConnectionNodesAccount `json:"nodes"`
+	PageInfo ListAccountsAccountsAccountConnectionPageInfo        `json:"pageInfo"`
+}
+
+// GetNodes returns ListAccountsAccountsAccountConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ListAccountsAccountsAccountConnection) GetNodes() []*ListAccountsAccountsAccountConnectionNodesAccount {
+	return v.Nodes
+}
+
+// GetPageInfo returns ListAccountsAccountsAccountConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *ListAccountsAccountsAccountConnection) GetPageInfo() ListAccountsAccountsAccountConnectionPageInfo {
+	return v.PageInfo
+}
+
+// ListAccountsAccountsAccountConnectionNodesAccount includes the requested fields of the GraphQL type Account.
+// The GraphQL type's documentation follows.
+//
+// Accounts model all of the economic activity that your ledger provides.
+//
+// The chart of accounts is the basis for creating balance sheets, P&L reports, and for understanding the balances for the customer and business entities your business services.
+//
+// Accounts can be organized into sets with the AccountSet type. Hierarchical tree structures which roll up balances across many accounts can be modeled by nesting sets within other sets.
+type ListAccountsAccountsAccountConnectionNodesAccount struct {
+	// Unique identifier for the account.
+	AccountId uuid.UUID `json:"accountId"`
+	// Shorthand code for the account, often an abbreviated version of the account name.
+	// Example: 'ACH_RECON' for an account named 'ACH Reconciliation'.
+	Code string `json:"code"`
+	// Reference to the balance for a specific journal and currency (defaults to "USD").
+	Balance *ListAccountsAccountsAccountConnectionNodesAccountBalance `json:"balance"`
+}
+
+// GetAccountId returns ListAccountsAccountsAccountConnectionNodesAccount.AccountId, and is useful for accessing the field via an interface.
+func (v *ListAccountsAccountsAccountConnectionNodesAccount) GetAccountId() uuid.UUID {
+	return v.AccountId
+}
+
+// GetCode returns ListAccountsAccountsAccountConnectionNodesAccount.Code, and is useful for accessing the field via an interface.
+func (v *ListAccountsAccountsAccountConnectionNodesAccount) GetCode() string { return v.Code }
+
+// GetBalance returns ListAccountsAccountsAccountConnectionNodesAccount.Balance, and is useful for accessing the field via an interface.
+func (v *ListAccountsAccountsAccountConnectionNodesAccount) GetBalance() *ListAccountsAccountsAccountConnectionNodesAccountBalance {
+	return v.Balance
+}
+
+// ListAccountsAccountsAccountConnectionNodesAccountBalance includes the requested fields of the GraphQL type Balance.
+// The GraphQL type's documentation follows.
+//
+// Balances are auto-calculated sums of the entries for a given account.
+//
+// Every balance record maintains a `drBalance` for entries on the debit side of the ledger and a `crBalance` for credit entries.
+//
+// Additionally, every account has a `normalBalance`, which is equal to `crBalance - drBalance` for credit normal accounts, and `drBalance - crBalance` for debit normal accounts.
+//
+// Each account can have balances across all three layers: SETTLED, PENDING, and ENCUMBRANCE.
+type ListAccountsAccountsAccountConnectionNodesAccountBalance struct {
+	// The balance amounts on the settled layer.
+	Settled ListAccountsAccountsAccountConnectionNodesAccountBalanceSettledBalanceAmount `json:"settled"`
+}
+
+// GetSettled returns ListAccountsAccountsAccountConnectionNodesAccountBalance.Settled, and is useful for accessing the field via an interface.
+func (v *ListAccountsAccountsAccountConnectionNodesAccountBalance) GetSettled() ListAccountsAccountsAccountConnectionNodesAccountBalanceSettledBalanceAmount {
+	return v.Settled
+}
+
+// ListAccountsAccountsAccountConnectionNodesAccountBalanceSettledBalanceAmount includes the requested fields of the GraphQL type BalanceAmount.
+type ListAccountsAccountsAccountConnectionNodesAccountBalanceSettledBalanceAmount struct {
+	// Sum of all amounts for entries on the DEBIT side of the ledger.
+	DrBalance ListAccountsAccountsAccountConnectionNodesAccountBalanceSettledBalanceAmountDrBalanceMoney `json:"drBalance"`
+	// Sum of all amounts for entries on the CREDIT side of the ledger.
+	CrBalance ListAccountsAccountsAccountConnectionNodesAccountBalanceSettledBalanceAmountCrBalanceMoney `json:"crBalance"`
+}
+
+// GetDrBalance returns ListAccountsAccountsAccountConnectionNodesAccountBalanceSettledBalanceAmount.DrBalance, and is useful for accessing the field via an interface.
+func (v *ListAccountsAccountsAccountConnectionNodesAccountBalanceSettledBalanceAmount) GetDrBalance() ListAccountsAccountsAccountConnectionNodesAccountBalanceSettledBalanceAmountDrBalanceMoney {
+	return v.DrBalance
+}
+
+// GetCrBalance returns ListAccountsAccountsAccountConnectionNodesAccountBalanceSettledBalanceAmount.CrBalance, and is useful for accessing the field via an interface.
+func (v *ListAccountsAccountsAccountConnectionNodesAccountBalanceSettledBalanceAmount) GetCrBalance() ListAccountsAccountsAccountConnectionNodesAccountBalanceSettledBalanceAmountCrBalanceMoney {
+	return v.CrBalance
+}
+
+// ListAccountsAccountsAccountConnectionNodesAccountBalanceSettledBalanceAmountCrBalanceMoney includes the requested fields of the GraphQL type Money.
+// The GraphQL type's documentation follows.
+//
+// Money type with multi-currency support.
+//
+// Monetary amounts are represented as decimal units of currency. Fields which use the Money type can be converted to a symbolic representations by specifying a MoneyFormatInput on the `formatted` field.
+//
+// Here is an example table showing different currencies which each have their own divisions of units represented. Japanese yen (JPY) don't have a decimal minor unit, and Bahraini dinars (BHD) use 3 minor unit decimal places. The `formatted` column uses the default values for a an `en-US` locale.
+//
+// | Currency | Units    | Formatted |
+// |----------|----------|-----------|
+// | USD      | `289.27` | $289.27   |
+// | BHD      | `28.927` | 28.927 BD |
+// | JPY      | `28927`  | ¥28927    |
+type ListAccountsAccountsAccountConnectionNodesAccountBalanceSettledBalanceAmountCrBalanceMoney struct {
+	Units Decimal `json:"units"`
+}
+
+// GetUnits returns ListAccountsAccountsAccountConnectionNodesAccountBalanceSettledBalanceAmountCrBalanceMoney.Units, and is useful for accessing the field via an interface.
+func (v *ListAccountsAccountsAccountConnectionNodesAccountBalanceSettledBalanceAmountCrBalanceMoney) GetUnits() Decimal {
+	return v.Units
+}
+
+// ListAccountsAccountsAccountConnectionNodesAccountBalanceSettledBalanceAmountDrBalanceMoney includes the requested fields of the GraphQL type Money.
+// The GraphQL type's documentation follows.
+//
+// Money type with multi-currency support.
+//
+// Monetary amounts are represented as decimal units of currency. Fields which use the Money type can be converted to a symbolic representations by specifying a MoneyFormatInput on the `formatted` field.
+//
+// Here is an example table showing different currencies which each have their own divisions of units represented. Japanese yen (JPY) don't have a decimal minor unit, and Bahraini dinars (BHD) use 3 minor unit decimal places. The `formatted` column uses the default values for a an `en-US` locale.
+//
+// | Currency | Units    | Formatted |
+// |----------|----------|-----------|
+// | USD      | `289.27` | $289.27   |
+// | BHD      | `28.927` | 28.927 BD |
+// | JPY      | `28927`  | ¥28927    |
+type ListAccountsAccountsAccountConnectionNodesAccountBalanceSettledBalanceAmountDrBalanceMoney struct {
+	Units Decimal `json:"units"`
+}
+
+// GetUnits returns ListAccountsAccountsAccountConnectionNodesAccountBalanceSettledBalanceAmountDrBalanceMoney.Units, and is useful for accessing the field via an interface.
+func (v *ListAccountsAccountsAccountConnectionNodesAccountBalanceSettledBalanceAmountDrBalanceMoney) GetUnits() Decimal {
+	return v.Units
+}
+
+// ListAccountsAccountsAccountConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type ListAccountsAccountsAccountConnectionPageInfo struct {
+	// True if there are nodes in the connection after the current page / end cursor.
+	HasNextPage bool `json:"hasNextPage"`
+	// Query cursor for the last node in the current page.
+	EndCursor *string `json:"endCursor"`
+}
+
+// GetHasNextPage returns ListAccountsAccountsAccountConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *ListAccountsAccountsAccountConnectionPageInfo) GetHasNextPage() bool { return v.HasNextPage }
+
+// GetEndCursor returns ListAccountsAccountsAccountConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *ListAccountsAccountsAccountConnectionPageInfo) GetEndCursor() *string { return v.EndCursor }
+
+// ListAccountsByMetadataAccountsAccountConnection includes the requested fields of the GraphQL type AccountConnection.
+// The GraphQL type's documentation follows.
+//
+// Connection to a list of Account nodes.
+// Access Account nodes directly through the `nodes` field, or access information about the connection edges with the `edges` field.
+// Use `pageInfo` to paginate responses using the cursors provided.
+type ListAccountsByMetadataAccountsAccountConnection struct {
+	Nodes    []*ListAccountsByMetadataAccountsAccountConnectionNodesAccount `json:"nodes"`
+	PageInfo ListAccountsByMetadataAccountsAccountConnectionPageInfo        `json:"pageInfo"`
+}
+
+// GetNodes returns ListAccountsByMetadataAccountsAccountConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ListAccountsByMetadataAccountsAccountConnection) GetNodes() []*ListAccountsByMetadataAccountsAccountConnectionNodesAccount {
+	return v.Nodes
+}
+
+// GetPageInfo returns ListAccountsByMetadataAccountsAccountConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *ListAccountsByMetadataAccountsAccountConnection) GetPageInfo() ListAccountsByMetadataAccountsAccountConnectionPageInfo {
+	return v.PageInfo
+}
+
+// ListAccountsByMetadataAccountsAccountConnectionNodesAccount includes the requested fields of the GraphQL type Account.
+// The GraphQL type's documentation follows.
+//
+// Accounts model all of the economic activity that your ledger provides.
+//
+// The chart of accounts is the basis for creating balance sheets, P&L reports, and for understanding the balances for the customer and business entities your business services.
+//
+// Accounts can be organized into sets with the AccountSet type. Hierarchical tree structures which roll up balances across many accounts can be modeled by nesting sets within other sets.
+type ListAccountsByMetadataAccountsAccountConnectionNodesAccount struct {
+	// Unique identifier for the account.
+	AccountId uuid.UUID `json:"accountId"`
+	// Metadata attached to this account.
+	Metadata *map[string]interface{} `json:"metadata"`
+	// Reference to the balance for a specific journal and currency (defaults to "USD").
+	Balance *ListAccountsByMetadataAccountsAccountConnectionNodesAccountBalance `json:"balance"`
+}
+
+// GetAccountId returns ListAccountsByMetadataAccountsAccountConnectionNodesAccount.AccountId, and is useful for accessing the field via an interface.
+func (v *ListAccountsByMetadataAccountsAccountConnectionNodesAccount) GetAccountId() uuid.UUID {
+	return v.AccountId
+}
+
+// GetMetadata returns ListAccountsByMetadataAccountsAccountConnectionNodesAccount.Metadata, and is useful for accessing the field via an interface.
+func (v *ListAccountsByMetadataAccountsAccountConnectionNodesAccount) GetMetadata() *map[string]interface{} {
+	return v.Metadata
+}
+
+// GetBalance returns ListAccountsByMetadataAccountsAccountConnectionNodesAccount.Balance, and is useful for accessing the field via an interface.
+func (v *ListAccountsByMetadataAccountsAccountConnectionNodesAccount) GetBalance() *ListAccountsByMetadataAccountsAccountConnectionNodesAccountBalance {
+	return v.Balance
+}
+
+// ListAccountsByMetadataAccountsAccountConnectionNodesAccountBalance includes the requested fields of the GraphQL type Balance.
+// The GraphQL type's documentation follows.
+//
+// Balances are auto-calculated sums of the entries for a given account.
+//
+// Every balance record maintains a `drBalance` for entries on the debit side of the ledger and a `crBalance` for credit entries.
+//
+// Additionally, every account has a `normalBalance`, which is equal to `crBalance - drBalance` for credit normal accounts, and `drBalance - crBalance` for debit normal accounts.
+//
+// Each account can have balances across all three layers: SETTLED, PENDING, and ENCUMBRANCE.
+type ListAccountsByMetadataAccountsAccountConnectionNodesAccountBalance struct {
+	// The balance amounts on the settled layer.
+	Settled ListAccountsByMetadataAccountsAccountConnectionNodesAccountBalanceSettledBalanceAmount `json:"settled"`
+}
+
+// GetSettled returns ListAccountsByMetadataAccountsAccountConnectionNodesAccountBalance.Settled, and is useful for accessing the field via an interface.
+func (v *ListAccountsByMetadataAccountsAccountConnectionNodesAccountBalance) GetSettled() ListAccountsByMetadataAccountsAccountConnectionNodesAccountBalanceSettledBalanceAmount {
+	return v.Settled
+}
+
+// ListAccountsByMetadataAccountsAccountConnectionNodesAccountBalanceSettledBalanceAmount includes the requested fields of the GraphQL type BalanceAmount.
+type ListAccountsByMetadataAccountsAccountConnectionNodesAccountBalanceSettledBalanceAmount struct {
+	// The "normal balance" for an account is different for credit normal and debit normal accounts.
+	//
+	// For credit normal accounts, the normal balance is equal to `crBalance - drBalance`.
+	// For debit normal accounts, the normal balance is the reverse: `drBalance - crBalance`.
+	NormalBalance ListAccountsByMetadataAccountsAccountConnectionNodesAccountBalanceSettledBalanceAmountNormalBalanceMoney `json:"normalBalance"`
+}
+
+// GetNormalBalance returns ListAccountsByMetadataAccountsAccountConnectionNodesAccountBalanceSettledBalanceAmount.NormalBalance, and is useful for accessing the field via an interface.
+func (v *ListAccountsByMetadataAccountsAccountConnectionNodesAccountBalanceSettledBalanceAmount) GetNormalBalance() ListAccountsByMetadataAccountsAccountConnectionNodesAccountBalanceSettledBalanceAmountNormalBalanceMoney {
+	return v.NormalBalance
+}
+
+// ListAccountsByMetadataAccountsAccountConnectionNodesAccountBalanceSettledBalanceAmountNormalBalanceMoney includes the requested fields of the GraphQL type Money.
+// The GraphQL type's documentation follows.
+//
+// Money type with multi-currency support.
+//
+// Monetary amounts are represented as decimal units of currency. Fields which use the Money type can be converted to a symbolic representations by specifying a MoneyFormatInput on the `formatted` field.
+//
+// Here is an example table showing different currencies which each have their own divisions of units represented. Japanese yen (JPY) don't have a decimal minor unit, and Bahraini dinars (BHD) use 3 minor unit decimal places. The `formatted` column uses the default values for a an `en-US` locale.
+//
+// | Currency | Units    | Formatted |
+// |----------|----------|-----------|
+// | USD      | `289.27` | $289.27   |
+// | BHD      | `28.927` | 28.927 BD |
+// | JPY      | `28927`  | ¥28927    |
+type ListAccountsByMetadataAccountsAccountConnectionNodesAccountBalanceSettledBalanceAmountNormalBalanceMoney struct {
+	Units Decimal `json:"units"`
+}
+
+// GetUnits returns ListAccountsByMetadataAccountsAccountConnectionNodesAccountBalanceSettledBalanceAmountNormalBalanceMoney.Units, and is useful for accessing the field via an interface.
+func (v *ListAccountsByMetadataAccountsAccountConnectionNodesAccountBalanceSettledBalanceAmountNormalBalanceMoney) GetUnits() Decimal {
+	return v.Units
+}
+
+// ListAccountsByMetadataAccountsAccountConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type ListAccountsByMetadataAccountsAccountConnectionPageInfo struct {
+	// True if there are nodes in the connection after the current page / end cursor.
+	HasNextPage bool `json:"hasNextPage"`
+	// Query cursor for the last node in the current page.
+	EndCursor *string `json:"endCursor"`
+}
+
+// GetHasNextPage returns ListAccountsByMetadataAccountsAccountConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *ListAccountsByMetadataAccountsAccountConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns ListAccountsByMetadataAccountsAccountConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *ListAccountsByMetadataAccountsAccountConnectionPageInfo) GetEndCursor() *string {
+	return v.EndCursor
+}
+
+// ListAccountsByMetadataResponse is returned by ListAccountsByMetadata on success.
+type ListAccountsByMetadataResponse struct {
+	// Select one or more accounts. Specify the index to use and apply filters to your query.
+	Accounts ListAccountsByMetadataAccountsAccountConnection `json:"accounts"`
+}
+
+// GetAccounts returns ListAccountsByMetadataResponse.Accounts, and is useful for accessing the field via an interface.
+func (v *ListAccountsByMetadataResponse) GetAccounts() ListAccountsByMetadataAccountsAccountConnection {
+	return v.Accounts
+}
+
+// ListAccountsResponse is returned by ListAccounts on success.
+type ListAccountsResponse struct {
+	// Select one or more accounts. Specify the index to use and apply filters to your query.
+	Accounts ListAccountsAccountsAccountConnection `json:"accounts"`
+}
+
+// GetAccounts returns ListAccountsResponse.Accounts, and is useful for accessing the field via an interface.
+func (v *ListAccountsResponse) GetAccounts() ListAccountsAccountsAccountConnection { return v.Accounts }
+
+// LockAccountDeleteAccount includes the requested fields of the GraphQL type Account.
+// The GraphQL type's documentation follows.
+//
+// Accounts model all of the economic activity that your ledger provides.
+//
+// The chart of accounts is the basis for creating balance sheets, P&L reports, and for understanding the balances for the customer and business entities your business services.
+//
+// Accounts can be organized into sets with the AccountSet type. Hierarchical tree structures which roll up balances across many accounts can be modeled by nesting sets within other sets.
+type LockAccountDeleteAccount struct {
+	// Unique identifier for the account.
+	AccountId uuid.UUID `json:"accountId"`
+	// Current status for the account.
+	Status AccountStatus `json:"status"`
+}
+
+// GetAccountId returns LockAccountDeleteAccount.AccountId, and is useful for accessing the field via an interface.
+func (v *LockAccountDeleteAccount) GetAccountId() uuid.UUID { return v.AccountId }
+
+// GetStatus returns LockAccountDeleteAccount.Status, and is useful for accessing the field via an interface.
+func (v *LockAccountDeleteAccount) GetStatus() AccountStatus { return v.Status }
+
+// LockAccountResponse is returned by LockAccount on success.
+type LockAccountResponse struct {
+	// Delete account moves the account state to `LOCKED`. When an account is in LOCKED, prevents entries from being posted to it.
+	DeleteAccount *LockAccountDeleteAccount `json:"deleteAccount"`
+}
+
+// GetDeleteAccount returns LockAccountResponse.DeleteAccount, and is useful for accessing the field via an interface.
+func (v *LockAccountResponse) GetDeleteAccount() *LockAccountDeleteAccount { return v.DeleteAccount }
+
+// LockJournalDeleteJournal includes the requested fields of the GraphQL type Journal.
+// The GraphQL type's documentation follows.
+//
+// Journals allow for the organizing of transactions within separate "books".
+//
+// In many cases, users only need a single journal. For this reason, Twisp always contains a default journal with code `DEFAULT`.
+//
+// Journals can be used for a variety of functions. For example, users may create separate journals for different currencies, or product-specific journals.
+type LockJournalDeleteJournal struct {
+	// Unique identifier for the journal.
+	JournalId uuid.UUID `json:"journalId"`
+	// Operational status of the journal. `ACTIVE` journals can be written to with `postTransaction`, whereas `LOCKED` journals do not allow transactions to be posted to them.
+	Status Status `json:"status"`
+}
+
+// GetJournalId returns LockJournalDeleteJournal.JournalId, and is useful for accessing the field via an interface.
+func (v *LockJournalDeleteJournal) GetJournalId() uuid.UUID { return v.JournalId }
+
+// GetStatus returns LockJournalDeleteJournal.Status, and is useful for accessing the field via an interface.
+func (v *LockJournalDeleteJournal) GetStatus() Status { return v.Status }
+
+// LockJournalResponse is returned by LockJournal on success.
+type LockJournalResponse struct {
+	// Moves journal into `LOCKED` status. Prevents entries from being posted to the journal.
+	DeleteJournal *LockJournalDeleteJournal `json:"deleteJournal"`
+}
+
+// GetDeleteJournal returns LockJournalResponse.DeleteJournal, and is useful for accessing the field via an interface.
+func (v *LockJournalResponse) GetDeleteJournal() *LockJournalDeleteJournal { return v.DeleteJournal }
+
+// LockTranCodeDeleteTranCode includes the requested fields of the GraphQL type TranCode.
+// The GraphQL type's documentation follows.
+//
+// Transaction Codes (tran codes) are how financial engineers do double-entry accounting. They encode the basic patterns for a type of transaction as a predictable and repeatable formula.
+//
+// You can think of tran codes as function signatures which define how a transaction acts upon the ledger.
+type LockTranCodeDeleteTranCode struct {
+	// Internal UUID for the transaction code record.
+	TranCodeId uuid.UUID `json:"tranCodeId"`
+	// Operational status of the tran code.
+	Status Status `json:"status"`
+}
+
+// GetTranCodeId returns LockTranCodeDeleteTranCode.TranCodeId, and is useful for accessing the field via an interface.
+func (v *LockTranCodeDeleteTranCode) GetTranCodeId() uuid.UUID { return v.TranCodeId }
+
+// GetStatus returns LockTranCodeDeleteTranCode.Status, and is useful for accessing the field via an interface.
+func (v *LockTranCodeDeleteTranCode) GetStatus() Status { return v.Status }
+
+// LockTranCodeResponse is returned by LockTranCode on success.
+type LockTranCodeResponse struct {
+	// Moves the tran code into `LOCKED` status. Prevents transactions from posting using this version of tran code.
+	DeleteTranCode *LockTranCodeDeleteTranCode `json:"deleteTranCode"`
+}
+
+// GetDeleteTranCode returns LockTranCodeResponse.DeleteTranCode, and is useful for accessing the field via an interface.
+func (v *LockTranCodeResponse) GetDeleteTranCode() *LockTranCodeDeleteTranCode {
+	return v.DeleteTranCode
+}
+
+// NetActivityEntriesAccount includes the requested fields of the GraphQL type Account.
+// The GraphQL type's documentation follows.
+//
+// Accounts model all of the economic activity that your ledger provides.
+//
+// The chart of accounts is the basis for creating balance sheets, P&L reports, and for understanding the balances for the customer and business entities your business services.
+//
+// Accounts can be organized into sets with the AccountSet type. Hierarchical tree structures which roll up balances across many accounts can be modeled by nesting sets within other sets.
+type NetActivityEntriesAccount struct {
+	// Flag indicating whether this account uses a "debit normal" or a "credit normal" balance.
+	//
+	// In double-entry accounting, accounts with a debit normal balance use the balance calculation `balance = debits - credits`. This is used for asset and expense account types.
+	//
+	// Accounts with a credit normal balance, in contrast, calculate their balance with the equation `balance = credits - debits`. This is the default type for liabilities, equity, and revenue account types.
+	NormalBalanceType DebitOrCredit `json:"normalBalanceType"`
+	// All ledger entries associated with this account.
+	Entries NetActivityEntriesAccountEntriesEntryConnection `json:"entries"`
+}
+
+// GetNormalBalanceType returns NetActivityEntriesAccount.NormalBalanceType, and is useful for accessing the field via an interface.
+func (v *NetActivityEntriesAccount) GetNormalBalanceType() DebitOrCredit { return v.NormalBalanceType }
+
+// GetEntries returns NetActivityEntriesAccount.Entries, and is useful for accessing the field via an interface.
+func (v *NetActivityEntriesAccount) GetEntries() NetActivityEntriesAccountEntriesEntryConnection {
+	return v.Entries
+}
+
+// NetActivityEntriesAccountEntriesEntryConnection includes the requested fields of the GraphQL type EntryConnection.
+// The GraphQL type's documentation follows.
+//
+// Connection to a list of Entry nodes.
+// Access Entry nodes directly through the `nodes` field, or access information about the connection edges with the `edges` field.
+// Use `pageInfo` to paginate responses using the cursors provided.
+type NetActivityEntriesAccountEntriesEntryConnection struct {
+	Nodes    []*NetActivityEntriesAccountEntriesEntryConnectionNodesEntry `json:"nodes"`
+	PageInfo NetActivityEntriesAccountEntriesEntryConnectionPageInfo      `json:"pageInfo"`
+}
+
+// GetNodes returns NetActivityEntriesAccountEntriesEntryConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *NetActivityEntriesAccountEntriesEntryConnection) GetNodes() []*NetActivityEntriesAccountEntriesEntryConnectionNodesEntry {
+	return v.Nodes
+}
+
+// GetPageInfo returns NetActivityEntriesAccountEntriesEntryConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *NetActivityEntriesAccountEntriesEntryConnection) GetPageInfo() NetActivityEntriesAccountEntriesEntryConnectionPageInfo {
+	return v.PageInfo
+}
+
+// NetActivityEntriesAccountEntriesEntryConnectionNodesEntry includes the requested fields of the GraphQL type Entry.
+// The GraphQL type's documentation follows.
+//
+// An entry represents one side of a transaction in a ledger. In other systems, these may be called "ledger lines" or "journal entries".
+//
+// Entries always have an account, amount, and direction (CREDIT or DEBIT). In addition, Twisp uses the concept of "entry types" to assign every entry to a categorical type.
+//
+// Twisp enforces double-entry accounting, which in practice means that entries can only be entered in the context of a Transaction. Posting a transaction will create _at least 2_ ledger entries.
+type NetActivityEntriesAccountEntriesEntryConnectionNodesEntry struct {
+	// The side of the ledger (DEBIT or CREDIT) this entry is posted on.
+	Direction DebitOrCredit `json:"direction"`
+	// The layer on which this entry is recorded (SETTLED, PENDING, or ENCUMBRANCE).
+	Layer Layer `json:"layer"`
+	// Amount of the ledger entry using the currency-supported Money type.
+	Amount NetActivityEntriesAccountEntriesEntryConnectionNodesEntryAmountMoney `json:"amount"`
+	// Reference to the transaction which posted this entry.
+	Transaction NetActivityEntriesAccountEntriesEntryConnectionNodesEntryTransaction `json:"transaction"`
+}
+
+// GetDirection returns NetActivityEntriesAccountEntriesEntryConnectionNodesEntry.Direction, and is useful for accessing the field via an interface.
+func (v *NetActivityEntriesAccountEntriesEntryConnectionNodesEntry) GetDirection() DebitOrCredit {
+	return v.Direction
+}
+
+// GetLayer returns NetActivityEntriesAccountEntriesEntryConnectionNodesEntry.Layer, and is useful for accessing the field via an interface.
+func (v *NetActivityEntriesAccountEntriesEntryConnectionNodesEntry) GetLayer() Layer { return v.Layer }
+
+// GetAmount returns NetActivityEntriesAccountEntriesEntryConnectionNodesEntry.Amount, and is useful for accessing the field via an interface.
+func (v *NetActivityEntriesAccountEntriesEntryConnectionNodesEntry) GetAmount() NetActivityEntriesAccountEntriesEntryConnectionNodesEntryAmountMoney {
+	return v.Amount
+}
+
+// GetTransaction returns NetActivityEntriesAccountEntriesEntryConnectionNodesEntry.Transaction, and is useful for accessing the field via an interface.
+func (v *NetActivityEntriesAccountEntriesEntryConnectionNodesEntry) GetTransaction() NetActivityEntriesAccountEntriesEntryConnectionNodesEntryTransaction {
+	return v.Transaction
+}
+
+// NetActivityEntriesAccountEntriesEntryConnectionNodesEntryAmountMoney includes the requested fields of the GraphQL type Money.
+// The GraphQL type's documentation follows.
+//
+// Money type with multi-currency support.
+//
+// Monetary amounts are represented as decimal units of currency. Fields which use the Money type can be converted to a symbolic representations by specifying a MoneyFormatInput on the `formatted` field.
+//
+// Here is an example table showing different currencies which each have their own divisions of units represented. Japanese yen (JPY) don't have a decimal minor unit, and Bahraini dinars (BHD) use 3 minor unit decimal places. The `formatted` column uses the default values for a an `en-US` locale.
+//
+// | Currency | Units    | Formatted |
+// |----------|----------|-----------|
+// | USD      | `289.27` | $289.27   |
+// | BHD      | `28.927` | 28.927 BD |
+// | JPY      | `28927`  | ¥28927    |
+type NetActivityEntriesAccountEntriesEntryConnectionNodesEntryAmountMoney struct {
+	Units Decimal `json:"units"`
+}
+
+// GetUnits returns NetActivityEntriesAccountEntriesEntryConnectionNodesEntryAmountMoney.Units, and is useful for accessing the field via an interface.
+func (v *NetActivityEntriesAccountEntriesEntryConnectionNodesEntryAmountMoney) GetUnits() Decimal {
+	return v.Units
+}
+
+// NetActivityEntriesAccountEntriesEntryConnectionNodesEntryTransaction includes the requested fields of the GraphQL type Transaction.
+// The GraphQL type's documentation follows.
+//
+// Transactions record all accounting events in the ledger. In Twisp, the only way to write to a ledger is through a transaction.
+//
+// Every transaction writes two or more entries to the ledger in standard double-entry accounting practice.
+//
+// Twisp expands upon the basic principle of an accounting transaction with additional features like transaction codes and correlations.
+type NetActivityEntriesAccountEntriesEntryConnectionNodesEntryTransaction struct {
+	// The effective date records when the transaction is recorded as occurring for accounting purposes. Determines the accounting period within which the transaction is counted.
+	Effective Date `json:"effective"`
+}
+
+// GetEffective returns NetActivityEntriesAccountEntriesEntryConnectionNodesEntryTransaction.Effective, and is useful for accessing the field via an interface.
+func (v *NetActivityEntriesAccountEntriesEntryConnectionNodesEntryTransaction) GetEffective() Date {
+	return v.Effective
+}
+
+// NetActivityEntriesAccountEntriesEntryConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type NetActivityEntriesAccountEntriesEntryConnectionPageInfo struct {
+	// True if there are nodes in the connection after the current page / end cursor.
+	HasNextPage bool `json:"hasNextPage"`
+	// Query cursor for the last node in the current page.
+	EndCursor *string `json:"endCursor"`
+}
+
+// GetHasNextPage returns NetActivityEntriesAccountEntriesEntryConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *NetActivityEntriesAccountEntriesEntryConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns NetActivityEntriesAccountEntriesEntryConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *NetActivityEntriesAccountEntriesEntryConnectionPageInfo) GetEndCursor() *string {
+	return v.EndCursor
+}
+
+// NetActivityEntriesResponse is returned by NetActivityEntries on success.
+type NetActivityEntriesResponse struct {
+	// Get a single account by its `accountId`.
+	Account *NetActivityEntriesAccount `json:"account"`
+}
+
+// GetAccount returns NetActivityEntriesResponse.Account, and is useful for accessing the field via an interface.
+func (v *NetActivityEntriesResponse) GetAccount() *NetActivityEntriesAccount { return v.Account }
+
+// Specify a named expression to define a partition key.
+type PartitionKeyInput struct {
+	// Identifier for this partition key. Should be a short, human-readable name.
+	Alias string `json:"alias"`
+	// CEL expression which resolves to the value that is to be used for the partition key.
+	//
+	// Within the expression, the `document` object represents the record. To access a field on the document, use `document.<field_name>`.
+	Value string `json:"value"`
+	// Optionally provide explicit type for value. Useful for metadata values which may be list of monomorphic types.
+	//
+	// @example("type: STRING")
+	Type *IndexDataType `json:"type"`
+}
+
+// GetAlias returns PartitionKeyInput.Alias, and is useful for accessing the field via an interface.
+func (v *PartitionKeyInput) GetAlias() string { return v.Alias }
+
+// GetValue returns PartitionKeyInput.Value, and is useful for accessing the field via an interface.
+func (v *PartitionKeyInput) GetValue() string { return v.Value }
+
+// GetType returns PartitionKeyInput.Type, and is useful for accessing the field via an interface.
+func (v *PartitionKeyInput) GetType() *IndexDataType { return v.Type }
+
+// PostPendingTransferPostTransaction includes the requested fields of the GraphQL type Transaction.
+// The GraphQL type's documentation follows.
+//
+// Transactions record all accounting events in the ledger. In Twisp, the only way to write to a ledger is through a transaction.
+//
+// Every transaction writes two or more entries to the ledger in standard double-entry accounting practice.
+//
+// Twisp expands upon the basic principle of an accounting transaction with additional features like transaction codes and correlations.
+type PostPendingTransferPostTransaction struct {
+	// Unique identifier for the transaction.
+	TransactionId uuid.UUID `json:"transactionId"`
+	// Date and time when the transaction was first posted.
+	Created Timestamp `json:"created"`
+}
+
+// GetTransactionId returns PostPendingTransferPostTransaction.TransactionId, and is useful for accessing the field via an interface.
+func (v *PostPendingTransferPostTransaction) GetTransactionId() uuid.UUID { return v.TransactionId }
+
+// GetCreated returns PostPendingTransferPostTransaction.Created, and is useful for accessing the field via an interface.
+func (v *PostPendingTransferPostTransaction) GetCreated() Timestamp { return v.Created }
+
+// PostPendingTransferResponse is returned by PostPendingTransfer on success.
+type PostPendingTransferResponse struct {
+	// Write a transaction to the ledger using the predefined defaults from the `tranCode` provided.
+	PostTransaction PostPendingTransferPostTransaction `json:"postTransaction"`
+}
+
+// GetPostTransaction returns PostPendingTransferResponse.PostTransaction, and is useful for accessing the field via an interface.
+func (v *PostPendingTransferResponse) GetPostTransaction() PostPendingTransferPostTransaction {
+	return v.PostTransaction
+}
+
+// PostSimpleTransferPostTransaction includes the requested fields of the GraphQL type Transaction.
+// The GraphQL type's documentation follows.
+//
+// Transactions record all accounting events in the ledger. In Twisp, the only way to write to a ledger is through a transaction.
+//
+// Every transaction writes two or more entries to the ledger in standard double-entry accounting practice.
+//
+// Twisp expands upon the basic principle of an accounting transaction with additional features like transaction codes and correlations.
+type PostSimpleTransferPostTransaction struct {
+	// Unique identifier for the transaction.
+	TransactionId uuid.UUID `json:"transactionId"`
+	// Date and time when the transaction was first posted.
+	Created Timestamp `json:"created"`
+}
+
+// GetTransactionId returns PostSimpleTransferPostTransaction.TransactionId, and is useful for accessing the field via an interface.
+func (v *PostSimpleTransferPostTransaction) GetTransactionId() uuid.UUID { return v.TransactionId }
+
+// GetCreated returns PostSimpleTransferPostTransaction.Created, and is useful for accessing the field via an interface.
+func (v *PostSimpleTransferPostTransaction) GetCreated() Timestamp { return v.Created }
+
+// PostSimpleTransferResponse is returned by PostSimpleTransfer on success.
+type PostSimpleTransferResponse struct {
+	// Write a transaction to the ledger using the predefined defaults from the `tranCode` provided.
+	PostTransaction PostSimpleTransferPostTransaction `json:"postTransaction"`
+}
+
+// GetPostTransaction returns PostSimpleTransferResponse.PostTransaction, and is useful for accessing the field via an interface.
+func (v *PostSimpleTransferResponse) GetPostTransaction() PostSimpleTransferPostTransaction {
+	return v.PostTransaction
+}
+
+// PostSimpleWithMetadataPostTransaction includes the requested fields of the GraphQL type Transaction.
+// The GraphQL type's documentation follows.
+//
+// Transactions record all accounting events in the ledger. In Twisp, the only way to write to a ledger is through a transaction.
+//
+// Every transaction writes two or more entries to the ledger in standard double-entry accounting practice.
+//
+// Twisp expands upon the basic principle of an accounting transaction with additional features like transaction codes and correlations.
+type PostSimpleWithMetadataPostTransaction struct {
+	// Unique identifier for the transaction.
+	TransactionId uuid.UUID `json:"transactionId"`
+	// Date and time when the transaction was first posted.
+	Created Timestamp `json:"created"`
+}
+
+// GetTransactionId returns PostSimpleWithMetadataPostTransaction.TransactionId, and is useful for accessing the field via an interface.
+func (v *PostSimpleWithMetadataPostTransaction) GetTransactionId() uuid.UUID { return v.TransactionId }
+
+// GetCreated returns PostSimpleWithMetadataPostTransaction.Created, and is useful for accessing the field via an interface.
+func (v *PostSimpleWithMetadataPostTransaction) GetCreated() Timestamp { return v.Created }
+
+// PostSimpleWithMetadataResponse is returned by PostSimpleWithMetadata on success.
+type PostSimpleWithMetadataResponse struct {
+	// Write a transaction to the ledger using the predefined defaults from the `tranCode` provided.
+	PostTransaction PostSimpleWithMetadataPostTransaction `json:"postTransaction"`
+}
+
+// GetPostTransaction returns PostSimpleWithMetadataResponse.PostTransaction, and is useful for accessing the field via an interface.
+func (v *PostSimpleWithMetadataResponse) GetPostTransaction() PostSimpleWithMetadataPostTransaction {
+	return v.PostTransaction
+}
+
+// PostSimpleWithMetadataToJournalPostTransaction includes the requested fields of the GraphQL type Transaction.
+// The GraphQL type's documentation follows.
+//
+// Transactions record all accounting events in the ledger. In Twisp, the only way to write to a ledger is through a transaction.
+//
+// Every transaction writes two or more entries to the ledger in standard double-entry accounting practice.
+//
+// Twisp expands upon the basic principle of an accounting transaction with additional features like transaction codes and correlations.
+type PostSimpleWithMetadataToJournalPostTransaction struct {
+	// Unique identifier for the transaction.
+	TransactionId uuid.UUID `json:"transactionId"`
+	// Date and time when the transaction was first posted.
+	Created Timestamp `json:"created"`
+}
+
+// GetTransactionId returns PostSimpleWithMetadataToJournalPostTransaction.TransactionId, and is useful for accessing the field via an interface.
+func (v *PostSimpleWithMetadataToJournalPostTransaction) GetTransactionId() uuid.UUID {
+	return v.TransactionId
+}
+
+// GetCreated returns PostSimpleWithMetadataToJournalPostTransaction.Created, and is useful for accessing the field via an interface.
+func (v *PostSimpleWithMetadataToJournalPostTransaction) GetCreated() Timestamp { return v.Created }
+
+// PostSimpleWithMetadataToJournalResponse is returned by PostSimpleWithMetadataToJournal on success.
+type PostSimpleWithMetadataToJournalResponse struct {
+	// Write a transaction to the ledger using the predefined defaults from the `tranCode` provided.
+	PostTransaction PostSimpleWithMetadataToJournalPostTransaction `json:"postTransaction"`
+}
+
+// GetPostTransaction returns PostSimpleWithMetadataToJournalResponse.PostTransaction, and is useful for accessing the field via an interface.
+func (v *PostSimpleWithMetadataToJournalResponse) GetPostTransaction() PostSimpleWithMetadataToJournalPostTransaction {
+	return v.PostTransaction
+}
+
+// PostTransactionPostTransaction includes the requested fields of the GraphQL type Transaction.
+// The GraphQL type's documentation follows.
+//
+// Transactions record all accounting events in the ledger. In Twisp, the only way to write to a ledger is through a transaction.
+//
+// Every transaction writes two or more entries to the ledger in standard double-entry accounting practice.
+//
+// Twisp expands upon the basic principle of an accounting transaction with additional features like transaction codes and correlations.
+type PostTransactionPostTransaction struct {
+	// Unique identifier for the transaction.
+	TransactionId uuid.UUID `json:"transactionId"`
+	// Date and time when the transaction was first posted.
+	Created Timestamp `json:"created"`
+}
+
+// GetTransactionId returns PostTransactionPostTransaction.TransactionId, and is useful for accessing the field via an interface.
+func (v *PostTransactionPostTransaction) GetTransactionId() uuid.UUID { return v.TransactionId }
+
+// GetCreated returns PostTransactionPostTransaction.Created, and is useful for accessing the field via an interface.
+func (v *PostTransactionPostTransaction) GetCreated() Timestamp { return v.Created }
+
+// PostTransactionResponse is returned by PostTransaction on success.
+type PostTransactionResponse struct {
+	// Write a transaction to the ledger using the predefined defaults from the `tranCode` provided.
+	PostTransaction PostTransactionPostTransaction `json:"postTransaction"`
+}
+
+// GetPostTransaction returns PostTransactionResponse.PostTransaction, and is useful for accessing the field via an interface.
+func (v *PostTransactionResponse) GetPostTransaction() PostTransactionPostTransaction {
+	return v.PostTransaction
+}
+
+// PostTransactionToJournalPostTransaction includes the requested fields of the GraphQL type Transaction.
+// The GraphQL type's documentation follows.
+//
+// Transactions record all accounting events in the ledger. In Twisp, the only way to write to a ledger is through a transaction.
+//
+// Every transaction writes two or more entries to the ledger in standard double-entry accounting practice.
+//
+// Twisp expands upon the basic principle of an accounting transaction with additional features like transaction codes and correlations.
+type PostTransactionToJournalPostTransaction struct {
+	// Unique identifier for the transaction.
+	TransactionId uuid.UUID `json:"transactionId"`
+	// Date and time when the transaction was first posted.
+	Created Timestamp `json:"created"`
+}
+
+// GetTransactionId returns PostTransactionToJournalPostTransaction.TransactionId, and is useful for accessing the field via an interface.
+func (v *PostTransactionToJournalPostTransaction) GetTransactionId() uuid.UUID {
+	return v.TransactionId
+}
+
+// GetCreated returns PostTransactionToJournalPostTransaction.Created, and is useful for accessing the field via an interface.
+func (v *PostTransactionToJournalPostTransaction) GetCreated() Timestamp { return v.Created }
+
+// PostTransactionToJournalResponse is returned by PostTransactionToJournal on success.
+type PostTransactionToJournalResponse struct {
+	// Write a transaction to the ledger using the predefined defaults from the `tranCode` provided.
+	PostTransaction PostTransactionToJournalPostTransaction `json:"postTransaction"`
+}
+
+// GetPostTransaction returns PostTransactionToJournalResponse.PostTransaction, and is useful for accessing the field via an interface.
+func (v *PostTransactionToJournalResponse) GetPostTransaction() PostTransactionToJournalPostTransaction {
+	return v.PostTransaction
+}
+
+// PostTransactionWithStatementDatePostTransaction includes the requested fields of the GraphQL type Transaction.
+// The GraphQL type's documentation follows.
+//
+// Transactions record all accounting events in the ledger. In Twisp, the only way to write to a ledger is through a transaction.
+//
+// Every transaction writes two or more entries to the ledger in standard double-entry accounting practice.
+//
+// Twisp expands upon the basic principle of an accounting transaction with additional features like transaction codes and correlations.
+type PostTransactionWithStatementDatePostTransaction struct {
+	// Unique identifier for the transaction.
+	TransactionId uuid.UUID `json:"transactionId"`
+	// Date and time when the transaction was first posted.
+	Created Timestamp `json:"created"`
+}
+
+// GetTransactionId returns PostTransactionWithStatementDatePostTransaction.TransactionId, and is useful for accessing the field via an interface.
+func (v *PostTransactionWithStatementDatePostTransaction) GetTransactionId() uuid.UUID {
+	return v.TransactionId
+}
+
+// GetCreated returns PostTransactionWithStatementDatePostTransaction.Created, and is useful for accessing the field via an interface.
+func (v *PostTransactionWithStatementDatePostTransaction) GetCreated() Timestamp { return v.Created }
+
+// PostTransactionWithStatementDateResponse is returned by PostTransactionWithStatementDate on success.
+type PostTransactionWithStatementDateResponse struct {
+	// Write a transaction to the ledger using the predefined defaults from the `tranCode` provided.
+	PostTransaction PostTransactionWithStatementDatePostTransaction `json:"postTransaction"`
+}
+
+// GetPostTransaction returns PostTransactionWithStatementDateResponse.PostTransaction, and is useful for accessing the field via an interface.
+func (v *PostTransactionWithStatementDateResponse) GetPostTransaction() PostTransactionWithStatementDatePostTransaction {
+	return v.PostTransaction
+}
+
+// PostTransferInCurrencyPostTransaction includes the requested fields of the GraphQL type Transaction.
+// The GraphQL type's documentation follows.
+//
+// Transactions record all accounting events in the ledger. In Twisp, the only way to write to a ledger is through a transaction.
+//
+// Every transaction writes two or more entries to the ledger in standard double-entry accounting practice.
+//
+// Twisp expands upon the basic principle of an accounting transaction with additional features like transaction codes and correlations.
+type PostTransferInCurrencyPostTransaction struct {
+	// Unique identifier for the transaction.
+	TransactionId uuid.UUID `json:"transactionId"`
+	// Date and time when the transaction was first posted.
+	Created Timestamp `json:"created"`
+}
+
+// GetTransactionId returns PostTransferInCurrencyPostTransaction.TransactionId, and is useful for accessing the field via an interface.
+func (v *PostTransferInCurrencyPostTransaction) GetTransactionId() uuid.UUID { return v.TransactionId }
+
+// GetCreated returns PostTransferInCurrencyPostTransaction.Created, and is useful for accessing the field via an interface.
+func (v *PostTransferInCurrencyPostTransaction) GetCreated() Timestamp { return v.Created }
+
+// PostTransferInCurrencyResponse is returned by PostTransferInCurrency on success.
+type PostTransferInCurrencyResponse struct {
+	// Write a transaction to the ledger using the predefined defaults from the `tranCode` provided.
+	PostTransaction PostTransferInCurrencyPostTransaction `json:"postTransaction"`
+}
+
+// GetPostTransaction returns PostTransferInCurrencyResponse.PostTransaction, and is useful for accessing the field via an interface.
+func (v *PostTransferInCurrencyResponse) GetPostTransaction() PostTransferInCurrencyPostTransaction {
+	return v.PostTransaction
+}
+
+// PostTransferPostTransaction includes the requested fields of the GraphQL type Transaction.
+// The GraphQL type's documentation follows.
+//
+// Transactions record all accounting events in the ledger. In Twisp, the only way to write to a ledger is through a transaction.
+//
+// Every transaction writes two or more entries to the ledger in standard double-entry accounting practice.
+//
+// Twisp expands upon the basic principle of an accounting transaction with additional features like transaction codes and correlations.
+type PostTransferPostTransaction struct {
+	// Unique identifier for the transaction.
+	TransactionId uuid.UUID `json:"transactionId"`
+	// Date and time when the transaction was first posted.
+	Created Timestamp `json:"created"`
+}
+
+// GetTransactionId returns PostTransferPostTransaction.TransactionId, and is useful for accessing the field via an interface.
+func (v *PostTransferPostTransaction) GetTransactionId() uuid.UUID { return v.TransactionId }
+
+// GetCreated returns PostTransferPostTransaction.Created, and is useful for accessing the field via an interface.
+func (v *PostTransferPostTransaction) GetCreated() Timestamp { return v.Created }
+
+// PostTransferResponse is returned by PostTransfer on success.
+type PostTransferResponse struct {
+	// Write a transaction to the ledger using the predefined defaults from the `tranCode` provided.
+	PostTransaction PostTransferPostTransaction `json:"postTransaction"`
+}
+
+// GetPostTransaction returns PostTransferResponse.PostTransaction, and is useful for accessing the field via an interface.
+func (v *PostTransferResponse) GetPostTransaction() PostTransferPostTransaction {
+	return v.PostTransaction
+}
+
+// SchemaReadyResponse is returned by SchemaReady on success.
+type SchemaReadyResponse struct {
+	Schema SchemaReadySchema `json:"__schema"`
+}
+
+// GetSchema returns SchemaReadyResponse.Schema, and is useful for accessing the field via an interface.
+func (v *SchemaReadyResponse) GetSchema() SchemaReadySchema { return v.Schema }
+
+// SchemaReadySchema includes the requested fields of the GraphQL type __Schema.
+type SchemaReadySchema struct {
+	QueryType SchemaReadySchemaQueryType `json:"queryType"`
+}
+
+// GetQueryType returns SchemaReadySchema.QueryType, and is useful for accessing the field via an interface.
+func (v *SchemaReadySchema) GetQueryType() SchemaReadySchemaQueryType { return v.QueryType }
+
+// SchemaReadySchemaQueryType includes the requested fields of the GraphQL type __Type.
+type SchemaReadySchemaQueryType struct {
+	Name *string `json:"name"`
+}
+
+// GetName returns SchemaReadySchemaQueryType.Name, and is useful for accessing the field via an interface.
+func (v *SchemaReadySchemaQueryType) GetName() *string { return v.Name }
+
+// SetupBert_checkingAccount includes the requested fields of the GraphQL type Account.
+// The GraphQL type's documentation follows.
+//
+// Accounts model all of the economic activity that your ledger provides.
+//
+// The chart of accounts is the basis for creating balance sheets, P&L reports, and for understanding the balances for the customer and business entities your business services.
+//
+// Accounts can be organized into sets with the AccountSet type. Hierarchical tree structures which roll up balances across many accounts can be modeled by nesting sets within other sets.
+type SetupBert_checkingAccount struct {
+	// Unique identifier for the account.
+	AccountId uuid.UUID `json:"accountId"`
+	// Account name. @example("Bill Pay Settlement") @example("Courtesy Credit")
+	Name string `json:"name"`
+}
+
+// GetAccountId returns SetupBert_checkingAccount.AccountId, and is useful for accessing the field via an interface.
+func (v *SetupBert_checkingAccount) GetAccountId() uuid.UUID { return v.AccountId }
+
+// GetName returns SetupBert_checkingAccount.Name, and is useful for accessing the field via an interface.
+func (v *SetupBert_checkingAccount) GetName() string { return v.Name }
+
+// SetupCreateJournal includes the requested fields of the GraphQL type Journal.
+// The GraphQL type's documentation follows.
+//
+// Journals allow for the organizing of transactions within separate "books".
+//
+// In many cases, users only need a single journal. For this reason, Twisp always contains a default journal with code `DEFAULT`.
+//
+// Journals can be used for a variety of functions. For example, users may create separate journals for different currencies, or product-specific journals.
+type SetupCreateJournal struct {
+	// Unique identifier for the journal.
+	JournalId uuid.UUID `json:"journalId"`
+}
+
+// GetJournalId returns SetupCreateJournal.JournalId, and is useful for accessing the field via an interface.
+func (v *SetupCreateJournal) GetJournalId() uuid.UUID { return v.JournalId }
+
+// SetupCreateTranCode includes the requested fields of the GraphQL type TranCode.
+// The GraphQL type's documentation follows.
+//
+// Transaction Codes (tran codes) are how financial engineers do double-entry accounting. They encode the basic patterns for a type of transaction as a predictable and repeatable formula.
+//
+// You can think of tran codes as function signatures which define how a transaction acts upon the ledger.
+type SetupCreateTranCode struct {
+	// Internal UUID for the transaction code record.
+	TranCodeId uuid.UUID `json:"tranCodeId"`
+}
+
+// GetTranCodeId returns SetupCreateTranCode.TranCodeId, and is useful for accessing the field via an interface.
+func (v *SetupCreateTranCode) GetTranCodeId() uuid.UUID { return v.TranCodeId }
+
+// SetupErnie_checkingAccount includes the requested fields of the GraphQL type Account.
+// The GraphQL type's documentation follows.
+//
+// Accounts model all of the economic activity that your ledger provides.
+//
+// The chart of accounts is the basis for creating balance sheets, P&L reports, and for understanding the balances for the customer and business entities your business services.
+//
+// Accounts can be organized into sets with the AccountSet type. Hierarchical tree structures which roll up balances across many accounts can be modeled by nesting sets within other sets.
+type SetupErnie_checkingAccount struct {
+	// Unique identifier for the account.
+	AccountId uuid.UUID `json:"accountId"`
+	// Account name. @example("Bill Pay Settlement") @example("Courtesy Credit")
+	Name string `json:"name"`
+}
+
+// GetAccountId returns SetupErnie_checkingAccount.AccountId, and is useful for accessing the field via an interface.
+func (v *SetupErnie_checkingAccount) GetAccountId() uuid.UUID { return v.AccountId }
+
+// GetName returns SetupErnie_checkingAccount.Name, and is useful for accessing the field via an interface.
+func (v *SetupErnie_checkingAccount) GetName() string { return v.Name }
+
+// SetupResponse is returned by Setup on success.
+type SetupResponse struct {
+	// Create a new journal for recording transactions in the ledger.
+	CreateJournal SetupCreateJournal `json:"createJournal"`
+	// Create a new transaction code (tran code).
+	CreateTranCode SetupCreateTranCode `json:"createTranCode"`
+	// Create a new account.
+	Ernie_checking SetupErnie_checkingAccount `json:"ernie_checking"`
+	// Create a new account.
+	Bert_checking SetupBert_checkingAccount `json:"bert_checking"`
+}
+
+// GetCreateJournal returns SetupResponse.CreateJournal, and is useful for accessing the field via an interface.
+func (v *SetupResponse) GetCreateJournal() SetupCreateJournal { return v.CreateJournal }
+
+// GetCreateTranCode returns SetupResponse.CreateTranCode, and is useful for accessing the field via an interface.
+func (v *SetupResponse) GetCreateTranCode() SetupCreateTranCode { return v.CreateTranCode }
+
+// GetErnie_checking returns SetupResponse.Ernie_checking, and is useful for accessing the field via an interface.
+func (v *SetupResponse) GetErnie_checking() SetupErnie_checkingAccount { return v.Ernie_checking }
+
+// GetBert_checking returns SetupResponse.Bert_checking, and is useful for accessing the field via an interface.
+func (v *SetupResponse) GetBert_checking() SetupBert_checkingAccount { return v.Bert_checking }
+
+// SetupRetailBankingCashAccount includes the requested fields of the GraphQL type Account.
+// The GraphQL type's documentation follows.
+//
+// Accounts model all of the economic activity that your ledger provides.
+//
+// The chart of accounts is the basis for creating balance sheets, P&L reports, and for understanding the balances for the customer and business entities your business services.
+//
+// Accounts can be organized into sets with the AccountSet type. Hierarchical tree structures which roll up balances across many accounts can be modeled by nesting sets within other sets.
+type SetupRetailBankingCashAccount struct {
+	// Unique identifier for the account.
+	AccountId uuid.UUID `json:"accountId"`
+}
+
+// GetAccountId returns SetupRetailBankingCashAccount.AccountId, and is useful for accessing the field via an interface.
+func (v *SetupRetailBankingCashAccount) GetAccountId() uuid.UUID { return v.AccountId }
+
+// SetupRetailBankingCheckingAccount includes the requested fields of the GraphQL type Account.
+// The GraphQL type's documentation follows.
+//
+// Accounts model all of the economic activity that your ledger provides.
+//
+// The chart of accounts is the basis for creating balance sheets, P&L reports, and for understanding the balances for the customer and business entities your business services.
+//
+// Accounts can be organized into sets with the AccountSet type. Hierarchical tree structures which roll up balances across many accounts can be modeled by nesting sets within other sets.
+type SetupRetailBankingCheckingAccount struct {
+	// Unique identifier for the account.
+	AccountId uuid.UUID `json:"accountId"`
+}
+
+// GetAccountId returns SetupRetailBankingCheckingAccount.AccountId, and is useful for accessing the field via an interface.
+func (v *SetupRetailBankingCheckingAccount) GetAccountId() uuid.UUID { return v.AccountId }
+
+// SetupRetailBankingCreateJournal includes the requested fields of the GraphQL type Journal.
+// The GraphQL type's documentation follows.
+//
+// Journals allow for the organizing of transactions within separate "books".
+//
+// In many cases, users only need a single journal. For this reason, Twisp always contains a default journal with code `DEFAULT`.
+//
+// Journals can be used for a variety of functions. For example, users may create separate journals for different currencies, or product-specific journals.
+type SetupRetailBankingCreateJournal struct {
+	// Unique identifier for the journal.
+	JournalId uuid.UUID `json:"journalId"`
+}
+
+// GetJournalId returns SetupRetailBankingCreateJournal.JournalId, and is useful for accessing the field via an interface.
+func (v *SetupRetailBankingCreateJournal) GetJournalId() uuid.UUID { return v.JournalId }
+
+// SetupRetailBankingResponse is returned by SetupRetailBanking on success.
+type SetupRetailBankingResponse struct {
+	// Create a new journal for recording transactions in the ledger.
+	CreateJournal SetupRetailBankingCreateJournal `json:"createJournal"`
+	// Create a new transaction code (tran code).
+	Transfer SetupRetailBankingTransferTranCode `json:"transfer"`
+	// Create a new account.
+	Checking SetupRetailBankingCheckingAccount `json:"checking"`
+	// Create a new account.
+	Savings SetupRetailBankingSavingsAccount `json:"savings"`
+	// Create a new account.
+	Cash SetupRetailBankingCashAccount `json:"cash"`
+}
+
+// GetCreateJournal returns SetupRetailBankingResponse.CreateJournal, and is useful for accessing the field via an interface.
+func (v *SetupRetailBankingResponse) GetCreateJournal() SetupRetailBankingCreateJournal {
+	return v.CreateJournal
+}
+
+// GetTransfer returns SetupRetailBankingResponse.Transfer, and is useful for accessing the field via an interface.
+func (v *SetupRetailBankingResponse) GetTransfer() SetupRetailBankingTransferTranCode {
+	return v.Transfer
+}
+
+// GetChecking returns SetupRetailBankingResponse.Checking, and is useful for accessing the field via an interface.
+func (v *SetupRetailBankingResponse) GetChecking() SetupRetailBankingCheckingAccount {
+	return v.Checking
+}
+
+// GetSavings returns SetupRetailBankingResponse.Savings, and is useful for accessing the field via an interface.
+func (v *SetupRetailBankingResponse) GetSavings() SetupRetailBankingSavingsAccount { return v.Savings }
+
+// GetCash returns SetupRetailBankingResponse.Cash, and is useful for accessing the field via an interface.
+func (v *SetupRetailBankingResponse) GetCash() SetupRetailBankingCashAccount { return v.Cash }
+
+// SetupRetailBankingSavingsAccount includes the requested fields of the GraphQL type Account.
+// The GraphQL type's documentation follows.
+//
+// Accounts model all of the economic activity that your ledger provides.
+//
+// The chart of accounts is the basis for creating balance sheets, P&L reports, and for understanding the balances for the customer and business entities your business services.
+//
+// Accounts can be organized into sets with the AccountSet type. Hierarchical tree structures which roll up balances across many accounts can be modeled by nesting sets within other sets.
+type SetupRetailBankingSavingsAccount struct {
+	// Unique identifier for the account.
+	AccountId uuid.UUID `json:"accountId"`
+}
+
+// GetAccountId returns SetupRetailBankingSavingsAccount.AccountId, and is useful for accessing the field via an interface.
+func (v *SetupRetailBankingSavingsAccount) GetAccountId() uuid.UUID { return v.AccountId }
+
+// SetupRetailBankingTransferTranCode includes the requested fields of the GraphQL type TranCode.
+// The GraphQL type's documentation follows.
+//
+// Transaction Codes (tran codes) are how financial engineers do double-entry accounting. They encode the basic patterns for a type of transaction as a predictable and repeatable formula.
+//
+// You can think of tran codes as function signatures which define how a transaction acts upon the ledger.
+type SetupRetailBankingTransferTranCode struct {
+	// Internal UUID for the transaction code record.
+	TranCodeId uuid.UUID `json:"tranCodeId"`
+}
+
+// GetTranCodeId returns SetupRetailBankingTransferTranCode.TranCodeId, and is useful for accessing the field via an interface.
+func (v *SetupRetailBankingTransferTranCode) GetTranCodeId() uuid.UUID { return v.TranCodeId }
+
+// SetupSimpleMetaCreateTranCode includes the requested fields of the GraphQL type TranCode.
+// The GraphQL type's documentation follows.
+//
+// Transaction Codes (tran codes) are how financial engineers do double-entry accounting. They encode the basic patterns for a type of transaction as a predictable and repeatable formula.
+//
+// You can think of tran codes as function signatures which define how a transaction acts upon the ledger.
+type SetupSimpleMetaCreateTranCode struct {
+	// Internal UUID for the transaction code record.
+	TranCodeId uuid.UUID `json:"tranCodeId"`
+}
+
+// GetTranCodeId returns SetupSimpleMetaCreateTranCode.TranCodeId, and is useful for accessing the field via an interface.
+func (v *SetupSimpleMetaCreateTranCode) GetTranCodeId() uuid.UUID { return v.TranCodeId }
+
+// SetupSimpleMetaResponse is returned by SetupSimpleMeta on success.
+type SetupSimpleMetaResponse struct {
+	// Create a new transaction code (tran code).
+	CreateTranCode SetupSimpleMetaCreateTranCode `json:"createTranCode"`
+}
+
+// GetCreateTranCode returns SetupSimpleMetaResponse.CreateTranCode, and is useful for accessing the field via an interface.
+func (v *SetupSimpleMetaResponse) GetCreateTranCode() SetupSimpleMetaCreateTranCode {
+	return v.CreateTranCode
+}
+
+// `ASC` (ascending) or `DESC` (descending).
+type SortOrder string
+
+const (
+	SortOrderAsc  SortOrder = "ASC"
+	SortOrderDesc SortOrder = "DESC"
+)
+
+var AllSortOrder = []SortOrder{
+	SortOrderAsc,
+	SortOrderDesc,
+}
+
+// StatementBalanceClosedBalance includes the requested fields of the GraphQL type Balance.
+// The GraphQL type's documentation follows.
+//
+// Balances are auto-calculated sums of the entries for a given account.
+//
+// Every balance record maintains a `drBalance` for entries on the debit side of the ledger and a `crBalance` for credit entries.
+//
+// Additionally, every account has a `normalBalance`, which is equal to `crBalance - drBalance` for credit normal accounts, and `drBalance - crBalance` for debit normal accounts.
+//
+// Each account can have balances across all three layers: SETTLED, PENDING, and ENCUMBRANCE.
+type StatementBalanceClosedBalance struct {
+	// Time of the last change. Especially useful when reviewing the `history`.
+	Modified Timestamp `json:"modified"`
+	// The balance amounts available by combining the provided layer with all layers above.
+	Available StatementBalanceClosedBalanceAvailableBalanceAmount `json:"available"`
+	// History of changes to this Balance record.
+	// Because ledgers are immutable and append-only, all changes are recorded as sequenced versions of the record, providing an unbroken lineage of the current state.
+	History StatementBalanceClosedBalanceHistoryBalanceConnection `json:"history"`
+}
+
+// GetModified returns StatementBalanceClosedBalance.Modified, and is useful for accessing the field via an interface.
+func (v *StatementBalanceClosedBalance) GetModified() Timestamp { return v.Modified }
+
+// GetAvailable returns StatementBalanceClosedBalance.Available, and is useful for accessing the field via an interface.
+func (v *StatementBalanceClosedBalance) GetAvailable() StatementBalanceClosedBalanceAvailableBalanceAmount {
+	return v.Available
+}
+
+// GetHistory returns StatementBalanceClosedBalance.History, and is useful for accessing the field via an interface.
+func (v *StatementBalanceClosedBalance) GetHistory() StatementBalanceClosedBalanceHistoryBalanceConnection {
+	return v.History
+}
+
+// StatementBalanceClosedBalanceAvailableBalanceAmount includes the requested fields of the GraphQL type BalanceAmount.
+type StatementBalanceClosedBalanceAvailableBalanceAmount struct {
+	// The "normal balance" for an account is different for credit normal and debit normal accounts.
+	//
+	// For credit normal accounts, the normal balance is equal to `crBalance - drBalance`.
+	// For debit normal accounts, the normal balance is the reverse: `drBalance - crBalance`.
+	NormalBalance StatementBalanceClosedBalanceAvailableBalanceAmountNormalBalanceMoney `json:"normalBalance"`
+}
+
+// GetNormalBalance returns StatementBalanceClosedBalanceAvailableBalanceAmount.NormalBalance, and is useful for accessing the field via an interface.
+func (v *StatementBalanceClosedBalanceAvailableBalanceAmount) GetNormalBalance() StatementBalanceClosedBalanceAvailableBalanceAmountNormalBalanceMoney {
+	return v.NormalBalance
+}
+
+// StatementBalanceClosedBalanceAvailableBalanceAmountNormalBalanceMoney includes the requested fields of the GraphQL type Money.
+// The GraphQL type's documentation follows.
+//
+// Money type with multi-currency support.
+//
+// Monetary amounts are represented as decimal units of currency. Fields which use the Money type can be converted to a symbolic representations by specifying a MoneyFormatInput on the `formatted` field.
+//
+// Here is an example table showing different currencies which each have their own divisions of units represented. Japanese yen (JPY) don't have a decimal minor unit, and Bahraini dinars (BHD) use 3 minor unit decimal places. The `formatted` column uses the default values for a an `en-US` locale.
+//
+// | Currency | Units    | Formatted |
+// |----------|----------|-----------|
+// | USD      | `289.27` | $289.27   |
+// | BHD      | `28.927` | 28.927 BD |
+// | JPY      | `28927`  | ¥28927    |
+type StatementBalanceClosedBalanceAvailableBalanceAmountNormalBalanceMoney struct {
+	Units Decimal `json:"units"`
+}
+
+// GetUnits returns StatementBalanceClosedBalanceAvailableBalanceAmountNormalBalanceMoney.Units, and is useful for accessing the field via an interface.
+func (v *StatementBalanceClosedBalanceAvailableBalanceAmountNormalBalanceMoney) GetUnits() Decimal {
+	return v.Units
+}
+
+// StatementBalanceClosedBalanceHistoryBalanceConnection includes the requested fields of the GraphQL type BalanceConnection.
+// The GraphQL type's documentation follows.
+//
+// Connection to a list of Balance nodes.
+// Access Balance nodes directly through the `nodes` field, or access information about the connection edges with the `edges` field.
+// Use `pageInfo` to paginate responses using the cursors provided.
+type StatementBalanceClosedBalanceHistoryBalanceConnection struct {
+	Nodes []*StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalance `json:"nodes"`
+}
+
+// GetNodes returns StatementBalanceClosedBalanceHistoryBalanceConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *StatementBalanceClosedBalanceHistoryBalanceConnection) GetNodes() []*StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalance {
+	return v.Nodes
+}
+
+// StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalance includes the requested fields of the GraphQL type Balance.
+// The GraphQL type's documentation follows.
+//
+// Balances are auto-calculated sums of the entries for a given account.
+//
+// Every balance record maintains a `drBalance` for entries on the debit side of the ledger and a `crBalance` for credit entries.
+//
+// Additionally, every account has a `normalBalance`, which is equal to `crBalance - drBalance` for credit normal accounts, and `drBalance - crBalance` for debit normal accounts.
+//
+// Each account can have balances across all three layers: SETTLED, PENDING, and ENCUMBRANCE.
+type StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalance struct {
+	// Reference to the most recent entry used to calculate the balance.
+	Entry StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalanceEntry `json:"entry"`
+}
+
+// GetEntry returns StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalance.Entry, and is useful for accessing the field via an interface.
+func (v *StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalance) GetEntry() StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalanceEntry {
+	return v.Entry
+}
+
+// StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalanceEntry includes the requested fields of the GraphQL type Entry.
+// The GraphQL type's documentation follows.
+//
+// An entry represents one side of a transaction in a ledger. In other systems, these may be called "ledger lines" or "journal entries".
+//
+// Entries always have an account, amount, and direction (CREDIT or DEBIT). In addition, Twisp uses the concept of "entry types" to assign every entry to a categorical type.
+//
+// Twisp enforces double-entry accounting, which in practice means that entries can only be entered in the context of a Transaction. Posting a transaction will create _at least 2_ ledger entries.
+type StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalanceEntry struct {
+	// Arbitrary structured data about this entry.
+	Metadata *map[string]interface{} `json:"metadata"`
+	// Amount of the ledger entry using the currency-supported Money type.
+	Amount StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalanceEntryAmountMoney `json:"amount"`
+}
+
+// GetMetadata returns StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalanceEntry.Metadata, and is useful for accessing the field via an interface.
+func (v *StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalanceEntry) GetMetadata() *map[string]interface{} {
+	return v.Metadata
+}
+
+// GetAmount returns StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalanceEntry.Amount, and is useful for accessing the field via an interface.
+func (v *StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalanceEntry) GetAmount() StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalanceEntryAmountMoney {
+	return v.Amount
+}
+
+// StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalanceEntryAmountMoney includes the requested fields of the GraphQL type Money.
+// The GraphQL type's documentation follows.
+//
+// Money type with multi-currency support.
+//
+// Monetary amounts are represented as decimal units of currency. Fields which use the Money type can be converted to a symbolic representations by specifying a MoneyFormatInput on the `formatted` field.
+//
+// Here is an example table showing different currencies which each have their own divisions of units represented. Japanese yen (JPY) don't have a decimal minor unit, and Bahraini dinars (BHD) use 3 minor unit decimal places. The `formatted` column uses the default values for a an `en-US` locale.
+//
+// | Currency | Units    | Formatted |
+// |----------|----------|-----------|
+// | USD      | `289.27` | $289.27   |
+// | BHD      | `28.927` | 28.927 BD |
+// | JPY      | `28927`  | ¥28927    |
+type StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalanceEntryAmountMoney struct {
+	Units Decimal `json:"units"`
+}
+
+// GetUnits returns StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalanceEntryAmountMoney.Units, and is useful for accessing the field via an interface.
+func (v *StatementBalanceClosedBalanceHistoryBalanceConnectionNodesBalanceEntryAmountMoney) GetUnits() Decimal {
+	return v.Units
+}
+
+// StatementBalanceOpenBalance includes the requested fields of the GraphQL type Balance.
+// The GraphQL type's documentation follows.
+//
+// Balances are auto-calculated sums of the entries for a given account.
+//
+// Every balance record maintains a `drBalance` for entries on the debit side of the ledger and a `crBalance` for credit entries.
+//
+// Additionally, every account has a `normalBalance`, which is equal to `crBalance - drBalance` for credit normal accounts, and `drBalance - crBalance` for debit normal accounts.
+//
+// Each account can have balances across all three layers: SETTLED, PENDING, and ENCUMBRANCE.
+type StatementBalanceOpenBalance struct {
+	// Time of the last change. Especially useful when reviewing the `history`.
+	Modified Timestamp `json:"modified"`
+	// The balance amounts available by combining the provided layer with all layers above.
+	Available StatementBalanceOpenBalanceAvailableBalanceAmount `json:"available"`
+	// History of changes to this Balance record.
+	// Because ledgers are immutable and append-only, all changes are recorded as sequenced versions of the record, providing an unbroken lineage of the current state.
+	History StatementBalanceOpenBalanceHistoryBalanceConnection `json:"history"`
+}
+
+// GetModified returns StatementBalanceOpenBalance.Modified, and is useful for accessing the field via an interface.
+func (v *StatementBalanceOpenBalance) GetModified() Timestamp { return v.Modified }
+
+// GetAvailable returns StatementBalanceOpenBalance.Available, and is useful for accessing the field via an interface.
+func (v *StatementBalanceOpenBalance) GetAvailable() StatementBalanceOpenBalanceAvailableBalanceAmount {
+	return v.Available
+}
+
+// GetHistory returns StatementBalanceOpenBalance.History, and is useful for accessing the field via an interface.
+func (v *StatementBalanceOpenBalance) GetHistory() StatementBalanceOpenBalanceHistoryBalanceConnection {
+	return v.History
+}
+
+// StatementBalanceOpenBalanceAvailableBalanceAmount includes the requested fields of the GraphQL type BalanceAmount.
+type StatementBalanceOpenBalanceAvailableBalanceAmount struct {
+	// The "normal balance" for an account is different for credit normal and debit normal accounts.
+	//
+	// For credit normal accounts, the normal balance is equal to `crBalance - drBalance`.
+	// For debit normal accounts, the normal balance is the reverse: `drBalance - crBalance`.
+	NormalBalance StatementBalanceOpenBalanceAvailableBalanceAmountNormalBalanceMoney `json:"normalBalance"`
+}
+
+// GetNormalBalance returns StatementBalanceOpenBalanceAvailableBalanceAmount.NormalBalance, and is useful for accessing the field via an interface.
+func (v *StatementBalanceOpenBalanceAvailableBalanceAmount) GetNormalBalance() StatementBalanceOpenBalanceAvailableBalanceAmountNormalBalanceMoney {
+	return v.NormalBalance
+}
+
+// StatementBalanceOpenBalanceAvailableBalanceAmountNormalBalanceMoney includes the requested fields of the GraphQL type Money.
+// The GraphQL type's documentation follows.
+//
+// Money type with multi-currency support.
+//
+// Monetary amounts are represented as decimal units of currency. Fields which use the Money type can be converted to a symbolic representations by specifying a MoneyFormatInput on the `formatted` field.
+//
+// Here is an example table showing different currencies which each have their own divisions of units represented. Japanese yen (JPY) don't have a decimal minor unit, and Bahraini dinars (BHD) use 3 minor unit decimal places. The `formatted` column uses the default values for a an `en-US` locale.
+//
+// | Currency | Units    | Formatted |
+// |----------|----------|-----------|
+// | USD      | `289.27` | $289.27   |
+// | BHD      | `28.927` | 28.927 BD |
+// | JPY      | `28927`  | ¥28927    |
+type StatementBalanceOpenBalanceAvailableBalanceAmountNormalBalanceMoney struct {
+	Units Decimal `json:"units"`
+}
+
+// GetUnits returns StatementBalanceOpenBalanceAvailableBalanceAmountNormalBalanceMoney.Units, and is useful for accessing the field via an interface.
+func (v *StatementBalanceOpenBalanceAvailableBalanceAmountNormalBalanceMoney) GetUnits() Decimal {
+	return v.Units
+}
+
+// StatementBalanceOpenBalanceHistoryBalanceConnection includes the requested fields of the GraphQL type BalanceConnection.
+// The GraphQL type's documentation follows.
+//
+// Connection to a list of Balance nodes.
+// Access Balance nodes directly through the `nodes` field, or access information about the connection edges with the `edges` field.
+// Use `pageInfo` to paginate responses using the cursors provided.
+type StatementBalanceOpenBalanceHistoryBalanceConnection struct {
+	Nodes []*StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalance `json:"nodes"`
+}
+
+// GetNodes returns StatementBalanceOpenBalanceHistoryBalanceConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *StatementBalanceOpenBalanceHistoryBalanceConnection) GetNodes() []*StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalance {
+	return v.Nodes
+}
+
+// StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalance includes the requested fields of the GraphQL type Balance.
+// The GraphQL type's documentation follows.
+//
+// Balances are auto-calculated sums of the entries for a given account.
+//
+// Every balance record maintains a `drBalance` for entries on the debit side of the ledger and a `crBalance` for credit entries.
+//
+// Additionally, every account has a `normalBalance`, which is equal to `crBalance - drBalance` for credit normal accounts, and `drBalance - crBalance` for debit normal accounts.
+//
+// Each account can have balances across all three layers: SETTLED, PENDING, and ENCUMBRANCE.
+type StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalance struct {
+	// Reference to the most recent entry used to calculate the balance.
+	Entry StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalanceEntry `json:"entry"`
+}
+
+// GetEntry returns StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalance.Entry, and is useful for accessing the field via an interface.
+func (v *StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalance) GetEntry() StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalanceEntry {
+	return v.Entry
+}
+
+// StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalanceEntry includes the requested fields of the GraphQL type Entry.
+// The GraphQL type's documentation follows.
+//
+// An entry represents one side of a transaction in a ledger. In other systems, these may be called "ledger lines" or "journal entries".
+//
+// Entries always have an account, amount, and direction (CREDIT or DEBIT). In addition, Twisp uses the concept of "entry types" to assign every entry to a categorical type.
+//
+// Twisp enforces double-entry accounting, which in practice means that entries can only be entered in the context of a Transaction. Posting a transaction will create _at least 2_ ledger entries.
+type StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalanceEntry struct {
+	// Arbitrary structured data about this entry.
+	Metadata *map[string]interface{} `json:"metadata"`
+	// Amount of the ledger entry using the currency-supported Money type.
+	Amount StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalanceEntryAmountMoney `json:"amount"`
+}
+
+// GetMetadata returns StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalanceEntry.Metadata, and is useful for accessing the field via an interface.
+func (v *StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalanceEntry) GetMetadata() *map[string]interface{} {
+	return v.Metadata
+}
+
+// GetAmount returns StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalanceEntry.Amount, and is useful for accessing the field via an interface.
+func (v *StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalanceEntry) GetAmount() StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalanceEntryAmountMoney {
+	return v.Amount
+}
+
+// StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalanceEntryAmountMoney includes the requested fields of the GraphQL type Money.
+// The GraphQL type's documentation follows.
+//
+// Money type with multi-currency support.
+//
+// Monetary amounts are represented as decimal units of currency. Fields which use the Money type can be converted to a symbolic representations by specifying a MoneyFormatInput on the `formatted` field.
+//
+// Here is an example table showing different currencies which each have their own divisions of units represented. Japanese yen (JPY) don't have a decimal minor unit, and Bahraini dinars (BHD) use 3 minor unit decimal places. The `formatted` column uses the default values for a an `en-US` locale.
+//
+// | Currency | Units    | Formatted |
+// |----------|----------|-----------|
+// | USD      | `289.27` | $289.27   |
+// | BHD      | `28.927` | 28.927 BD |
+// | JPY      | `28927`  | ¥28927    |
+type StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalanceEntryAmountMoney struct {
+	Units Decimal `json:"units"`
+}
+
+// GetUnits returns StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalanceEntryAmountMoney.Units, and is useful for accessing the field via an interface.
+func (v *StatementBalanceOpenBalanceHistoryBalanceConnectionNodesBalanceEntryAmountMoney) GetUnits() Decimal {
+	return v.Units
+}
+
+// StatementBalanceResponse is returned by StatementBalance on success.
+type StatementBalanceResponse struct {
+	// Get a balance for an account.
+	Open *StatementBalanceOpenBalance `json:"open"`
+	// Get a balance for an account.
+	Closed *StatementBalanceClosedBalance `json:"closed"`
+}
+
+// GetOpen returns StatementBalanceResponse.Open, and is useful for accessing the field via an interface.
+func (v *StatementBalanceResponse) GetOpen() *StatementBalanceOpenBalance { return v.Open }
+
+// GetClosed returns StatementBalanceResponse.Closed, and is useful for accessing the field via an interface.
+func (v *StatementBalanceResponse) GetClosed() *StatementBalanceClosedBalance { return v.Closed }
+
+// Record status. All records are `ACTIVE` by default.
+//
+// To avoid rewriting accounting history, most records are not deleted but simply marked `LOCKED`, indicating that they should not be used.
+type Status string
+
+const (
+	StatusActive   Status = "ACTIVE"
+	StatusLocked   Status = "LOCKED"
+	StatusInactive Status = "INACTIVE"
+)
+
+var AllStatus = []Status{
+	StatusActive,
+	StatusLocked,
+	StatusInactive,
+}
+
+// TouchJournalResponse is returned by TouchJournal on success.
+type TouchJournalResponse struct {
+	// Update an existing journal. To ensure data integrity, only a subset of fields are allowed.
+	UpdateJournal TouchJournalUpdateJournal `json:"updateJournal"`
+}
+
+// GetUpdateJournal returns TouchJournalResponse.UpdateJournal, and is useful for accessing the field via an interface.
+func (v *TouchJournalResponse) GetUpdateJournal() TouchJournalUpdateJournal { return v.UpdateJournal }
+
+// TouchJournalUpdateJournal includes the requested fields of the GraphQL type Journal.
+// The GraphQL type's documentation follows.
+//
+// Journals allow for the organizing of transactions within separate "books".
+//
+// In many cases, users only need a single journal. For this reason, Twisp always contains a default journal with code `DEFAULT`.
+//
+// Journals can be used for a variety of functions. For example, users may create separate journals for different currencies, or product-specific journals.
+type TouchJournalUpdateJournal struct {
+	// Time of the last change. Especially useful when reviewing the `history`.
+	Modified Timestamp `json:"modified"`
+	// The current version number of this journal. Previous versions are tracked in `history`.
+	Version int `json:"version"`
+}
+
+// GetModified returns TouchJournalUpdateJournal.Modified, and is useful for accessing the field via an interface.
+func (v *TouchJournalUpdateJournal) GetModified() Timestamp { return v.Modified }
+
+// GetVersion returns TouchJournalUpdateJournal.Version, and is useful for accessing the field via an interface.
+func (v *TouchJournalUpdateJournal) GetVersion() int { return v.Version }
+
+// TranCodeLockStatusResponse is returned by TranCodeLockStatus on success.
+type TranCodeLockStatusResponse struct {
+	// Get a single tran code by its `tranCodeId`.
+	TranCode *TranCodeLockStatusTranCode `json:"tranCode"`
+}
+
+// GetTranCode returns TranCodeLockStatusResponse.TranCode, and is useful for accessing the field via an interface.
+func (v *TranCodeLockStatusResponse) GetTranCode() *TranCodeLockStatusTranCode { return v.TranCode }
+
+// TranCodeLockStatusTranCode includes the requested fields of the GraphQL type TranCode.
+// The GraphQL type's documentation follows.
+//
+// Transaction Codes (tran codes) are how financial engineers do double-entry accounting. They encode the basic patterns for a type of transaction as a predictable and repeatable formula.
+//
+// You can think of tran codes as function signatures which define how a transaction acts upon the ledger.
+type TranCodeLockStatusTranCode struct {
+	// Internal UUID for the transaction code record.
+	TranCodeId uuid.UUID `json:"tranCodeId"`
+	// Operational status of the tran code.
+	Status Status `json:"status"`
+}
+
+// GetTranCodeId returns TranCodeLockStatusTranCode.TranCodeId, and is useful for accessing the field via an interface.
+func (v *TranCodeLockStatusTranCode) GetTranCodeId() uuid.UUID { return v.TranCodeId }
+
+// GetStatus returns TranCodeLockStatusTranCode.Status, and is useful for accessing the field via an interface.
+func (v *TranCodeLockStatusTranCode) GetStatus() Status { return v.Status }
+
+// UpdateAccountMetadataResponse is returned by UpdateAccountMetadata on success.
+type UpdateAccountMetadataResponse struct {
+	// Update fields on an existing account. To ensure data integrity, only a subset of fields are allowed.
+	UpdateAccount UpdateAccountMetadataUpdateAccount `json:"updateAccount"`
+}
+
+// GetUpdateAccount returns UpdateAccountMetadataResponse.UpdateAccount, and is useful for accessing the field via an interface.
+func (v *UpdateAccountMetadataResponse) GetUpdateAccount() UpdateAccountMetadataUpdateAccount {
+	return v.UpdateAccount
+}
+
+// UpdateAccountMetadataUpdateAccount includes the requested fields of the GraphQL type Account.
+// The GraphQL type's documentation follows.
+//
+// Accounts model all of the economic activity that your ledger provides.
+//
+// The chart of accounts is the basis for creating balance sheets, P&L reports, and for understanding the balances for the customer and business entities your business services.
+//
+// Accounts can be organized into sets with the AccountSet type. Hierarchical tree structures which roll up balances across many accounts can be modeled by nesting sets within other sets.
+type UpdateAccountMetadataUpdateAccount struct {
+	// Unique identifier for the account.
+	AccountId uuid.UUID `json:"accountId"`
+	// Metadata attached to this account.
+	Metadata *map[string]interface{} `json:"metadata"`
+}
+
+// GetAccountId returns UpdateAccountMetadataUpdateAccount.AccountId, and is useful for accessing the field via an interface.
+func (v *UpdateAccountMetadataUpdateAccount) GetAccountId() uuid.UUID { return v.AccountId }
+
+// GetMetadata returns UpdateAccountMetadataUpdateAccount.Metadata, and is useful for accessing the field via an interface.
+func (v *UpdateAccountMetadataUpdateAccount) GetMetadata() *map[string]interface{} { return v.Metadata }
+
+// __AccountBalanceLayersInput is used internally by genqlient
+type __AccountBalanceLayersInput struct {
+	AccountId uuid.UUID `json:"accountId"`
+	JournalId uuid.UUID `json:"journalId"`
+	AsOf      Date      `json:"asOf"`
+}
+
+// GetAccountId returns __AccountBalanceLayersInput.AccountId, and is useful for accessing the field via an interface.
+func (v *__AccountBalanceLayersInput) GetAccountId() uuid.UUID { return v.AccountId }
+
+// GetJournalId returns __AccountBalanceLayersInput.JournalId, and is useful for accessing the field via an interface.
+func (v *__AccountBalanceLayersInput) GetJournalId() uuid.UUID { return v.JournalId }
+
+// GetAsOf returns __AccountBalanceLayersInput.AsOf, and is useful for accessing the field via an interface.
+func (v *__AccountBalanceLayersInput) GetAsOf() Date { return v.AsOf }
+
+// __AccountCurrenciesInput is used internally by genqlient
+type __AccountCurrenciesInput struct {
+	AccountId string `json:"accountId"`
+	JournalId string `json:"journalId"`
+	First     int    `json:"first"`
+}
+
+// GetAccountId returns __AccountCurrenciesInput.AccountId, and is useful for accessing the field via an interface.
+func (v *__AccountCurrenciesInput) GetAccountId() string { return v.AccountId }
+
+// GetJournalId returns __AccountCurrenciesInput.JournalId, and is useful for accessing the field via an interface.
+func (v *__AccountCurrenciesInput) GetJournalId() string { return v.JournalId }
+
+// GetFirst returns __AccountCurrenciesInput.First, and is useful for accessing the field via an interface.
+func (v *__AccountCurrenciesInput) GetFirst() int { return v.First }
+
+// __AccountLockStatusInput is used internally by genqlient
+type __AccountLockStatusInput struct {
+	Id uuid.UUID `json:"id"`
+}
+
+// GetId returns __AccountLockStatusInput.Id, and is useful for accessing the field via an interface.
+func (v *__AccountLockStatusInput) GetId() uuid.UUID { return v.Id }
+
+// __ActivityIndexQueryInput is used internally by genqlient
+type __ActivityIndexQueryInput struct {
+	Index     string  `json:"index"`
+	JournalId *string `json:"journalId"`
+	AccountId *string `json:"accountId"`
+	Period    *string `json:"period"`
+	First     int     `json:"first"`
+	After     *string `json:"after"`
+}
+
+// GetIndex returns __ActivityIndexQueryInput.Index, and is useful for accessing the field via an interface.
+func (v *__ActivityIndexQueryInput) GetIndex() string { return v.Index }
+
+// GetJournalId returns __ActivityIndexQueryInput.JournalId, and is useful for accessing the field via an interface.
+func (v *__ActivityIndexQueryInput) GetJournalId() *string { return v.JournalId }
+
+// GetAccountId returns __ActivityIndexQueryInput.AccountId, and is useful for accessing the field via an interface.
+func (v *__ActivityIndexQueryInput) GetAccountId() *string { return v.AccountId }
+
+// GetPeriod returns __ActivityIndexQueryInput.Period, and is useful for accessing the field via an interface.
+func (v *__ActivityIndexQueryInput) GetPeriod() *string { return v.Period }
+
+// GetFirst returns __ActivityIndexQueryInput.First, and is useful for accessing the field via an interface.
+func (v *__ActivityIndexQueryInput) GetFirst() int { return v.First }
+
+// GetAfter returns __ActivityIndexQueryInput.After, and is useful for accessing the field via an interface.
+func (v *__ActivityIndexQueryInput) GetAfter() *string { return v.After }
+
+// __ActivityQueryInput is used internally by genqlient
+type __ActivityQueryInput struct {
+	JournalId *string `json:"journalId"`
+	AccountId *string `json:"accountId"`
+	Period    *string `json:"period"`
+}
+
+// GetJournalId returns __ActivityQueryInput.JournalId, and is useful for accessing the field via an interface.
+func (v *__ActivityQueryInput) GetJournalId() *string { return v.JournalId }
+
+// GetAccountId returns __ActivityQueryInput.AccountId, and is useful for accessing the field via an interface.
+func (v *__ActivityQueryInput) GetAccountId() *string { return v.AccountId }
+
+// GetPeriod returns __ActivityQueryInput.Period, and is useful for accessing the field via an interface.
+func (v *__ActivityQueryInput) GetPeriod() *string { return v.Period }
+
+// __BalanceAtCutoffInput is used internally by genqlient
+type __BalanceAtCutoffInput struct {
+	AccountId uuid.UUID `json:"accountId"`
+	JournalId uuid.UUID `json:"journalId"`
+	AsOf      Date      `json:"asOf"`
+	Cutoff    string    `json:"cutoff"`
+}
+
+// GetAccountId returns __BalanceAtCutoffInput.AccountId, and is useful for accessing the field via an interface.
+func (v *__BalanceAtCutoffInput) GetAccountId() uuid.UUID { return v.AccountId }
+
+// GetJournalId returns __BalanceAtCutoffInput.JournalId, and is useful for accessing the field via an interface.
+func (v *__BalanceAtCutoffInput) GetJournalId() uuid.UUID { return v.JournalId }
+
+// GetAsOf returns __BalanceAtCutoffInput.AsOf, and is useful for accessing the field via an interface.
+func (v *__BalanceAtCutoffInput) GetAsOf() Date { return v.AsOf }
+
+// GetCutoff returns __BalanceAtCutoffInput.Cutoff, and is useful for accessing the field via an interface.
+func (v *__BalanceAtCutoffInput) GetCutoff() string { return v.Cutoff }
+
+// __BalanceVersionsInput is used internally by genqlient
+type __BalanceVersionsInput struct {
+	AccountId uuid.UUID `json:"accountId"`
+	JournalId uuid.UUID `json:"journalId"`
+	First     int       `json:"first"`
+	After     *string   `json:"after"`
+}
+
+// GetAccountId returns __BalanceVersionsInput.AccountId, and is useful for accessing the field via an interface.
+func (v *__BalanceVersionsInput) GetAccountId() uuid.UUID { return v.AccountId }
+
+// GetJournalId returns __BalanceVersionsInput.JournalId, and is useful for accessing the field via an interface.
+func (v *__BalanceVersionsInput) GetJournalId() uuid.UUID { return v.JournalId }
+
+// GetFirst returns __BalanceVersionsInput.First, and is useful for accessing the field via an interface.
+func (v *__BalanceVersionsInput) GetFirst() int { return v.First }
+
+// GetAfter returns __BalanceVersionsInput.After, and is useful for accessing the field via an interface.
+func (v *__BalanceVersionsInput) GetAfter() *string { return v.After }
+
+// __CountActivityEntriesInput is used internally by genqlient
+type __CountActivityEntriesInput struct {
+	Index     string  `json:"index"`
+	JournalId string  `json:"journalId"`
+	AccountId string  `json:"accountId"`
+	Period    string  `json:"period"`
+	First     int     `json:"first"`
+	After     *string `json:"after"`
+}
+
+// GetIndex returns __CountActivityEntriesInput.Index, and is useful for accessing the field via an interface.
+func (v *__CountActivityEntriesInput) GetIndex() string { return v.Index }
+
+// GetJournalId returns __CountActivityEntriesInput.JournalId, and is useful for accessing the field via an interface.
+func (v *__CountActivityEntriesInput) GetJournalId() string { return v.JournalId }
+
+// GetAccountId returns __CountActivityEntriesInput.AccountId, and is useful for accessing the field via an interface.
+func (v *__CountActivityEntriesInput) GetAccountId() string { return v.AccountId }
+
+// GetPeriod returns __CountActivityEntriesInput.Period, and is useful for accessing the field via an interface.
+func (v *__CountActivityEntriesInput) GetPeriod() string { return v.Period }
+
+// GetFirst returns __CountActivityEntriesInput.First, and is useful for accessing the field via an interface.
+func (v *__CountActivityEntriesInput) GetFirst() int { return v.First }
+
+// GetAfter returns __CountActivityEntriesInput.After, and is useful for accessing the field via an interface.
+func (v *__CountActivityEntriesInput) GetAfter() *string { return v.After }
+
+// __CreateAccountInput is used internally by genqlient
+type __CreateAccountInput struct {
+	Input AccountInput `json:"input"`
+}
+
+// GetInput returns __CreateAccountInput.Input, and is useful for accessing the field via an interface.
+func (v *__CreateAccountInput) GetInput() AccountInput { return v.Input }
+
+// __CreateCustomIndexInput is used internally by genqlient
+type __CreateCustomIndexInput struct {
+	Input CreateIndexInput `json:"input"`
+}
+
+// GetInput returns __CreateCustomIndexInput.Input, and is useful for accessing the field via an interface.
+func (v *__CreateCustomIndexInput) GetInput() CreateIndexInput { return v.Input }
+
+// __CustomIndexEntriesInput is used internally by genqlient
+type __CustomIndexEntriesInput struct {
+	Index     string                   `json:"index"`
+	Partition []CustomIndexFilterValue `json:"partition"`
+	Sort      []CustomIndexFilterValue `json:"sort"`
+	First     int                      `json:"first"`
+}
+
+// GetIndex returns __CustomIndexEntriesInput.Index, and is useful for accessing the field via an interface.
+func (v *__CustomIndexEntriesInput) GetIndex() string { return v.Index }
+
+// GetPartition returns __CustomIndexEntriesInput.Partition, and is useful for accessing the field via an interface.
+func (v *__CustomIndexEntriesInput) GetPartition() []CustomIndexFilterValue { return v.Partition }
+
+// GetSort returns __CustomIndexEntriesInput.Sort, and is useful for accessing the field via an interface.
+func (v *__CustomIndexEntriesInput) GetSort() []CustomIndexFilterValue { return v.Sort }
+
+// GetFirst returns __CustomIndexEntriesInput.First, and is useful for accessing the field via an interface.
+func (v *__CustomIndexEntriesInput) GetFirst() int { return v.First }
+
+// __DumpAccountEntriesInput is used internally by genqlient
+type __DumpAccountEntriesInput struct {
+	AccountId uuid.UUID `json:"accountId"`
+	JournalId string    `json:"journalId"`
+	First     int       `json:"first"`
+}
+
+// GetAccountId returns __DumpAccountEntriesInput.AccountId, and is useful for accessing the field via an interface.
+func (v *__DumpAccountEntriesInput) GetAccountId() uuid.UUID { return v.AccountId }
+
+// GetJournalId returns __DumpAccountEntriesInput.JournalId, and is useful for accessing the field via an interface.
+func (v *__DumpAccountEntriesInput) GetJournalId() string { return v.JournalId }
+
+// GetFirst returns __DumpAccountEntriesInput.First, and is useful for accessing the field via an interface.
+func (v *__DumpAccountEntriesInput) GetFirst() int { return v.First }
+
+// __DumpAccountsInput is used internally by genqlient
+type __DumpAccountsInput struct {
+	JournalId uuid.UUID `json:"journalId"`
+	First     int       `json:"first"`
+}
+
+// GetJournalId returns __DumpAccountsInput.JournalId, and is useful for accessing the field via an interface.
+func (v *__DumpAccountsInput) GetJournalId() uuid.UUID { return v.JournalId }
+
+// GetFirst returns __DumpAccountsInput.First, and is useful for accessing the field via an interface.
+func (v *__DumpAccountsInput) GetFirst() int { return v.First }
+
+// __FetchJournalInput is used internally by genqlient
+type __FetchJournalInput struct {
+	Id uuid.UUID `json:"id"`
+}
+
+// GetId returns __FetchJournalInput.Id, and is useful for accessing the field via an interface.
+func (v *__FetchJournalInput) GetId() uuid.UUID { return v.Id }
+
+// __FetchTransactionEntriesInput is used internally by genqlient
+type __FetchTransactionEntriesInput struct {
+	Id    uuid.UUID `json:"id"`
+	First int       `json:"first"`
+	After *string   `json:"after"`
+}
+
+// GetId returns __FetchTransactionEntriesInput.Id, and is useful for accessing the field via an interface.
+func (v *__FetchTransactionEntriesInput) GetId() uuid.UUID { return v.Id }
+
+// GetFirst returns __FetchTransactionEntriesInput.First, and is useful for accessing the field via an interface.
+func (v *__FetchTransactionEntriesInput) GetFirst() int { return v.First }
+
+// GetAfter returns __FetchTransactionEntriesInput.After, and is useful for accessing the field via an interface.
+func (v *__FetchTransactionEntriesInput) GetAfter() *string { return v.After }
+
+// __JournalLockStatusInput is used internally by genqlient
+type __JournalLockStatusInput struct {
+	Id uuid.UUID `json:"id"`
+}
+
+// GetId returns __JournalLockStatusInput.Id, and is useful for accessing the field via an interface.
+func (v *__JournalLockStatusInput) GetId() uuid.UUID { return v.Id }
+
+// __ListAccountsByMetadataInput is used internally by genqlient
+type __ListAccountsByMetadataInput struct {
+	JournalId uuid.UUID `json:"journalId"`
+	AsOf      Date      `json:"asOf"`
+	First     int       `json:"first"`
+	After     *string   `json:"after"`
+}
+
+// GetJournalId returns __ListAccountsByMetadataInput.JournalId, and is useful for accessing the field via an interface.
+func (v *__ListAccountsByMetadataInput) GetJournalId() uuid.UUID { return v.JournalId }
+
+// GetAsOf returns __ListAccountsByMetadataInput.AsOf, and is useful for accessing the field via an interface.
+func (v *__ListAccountsByMetadataInput) GetAsOf() Date { return v.AsOf }
+
+// GetFirst returns __ListAccountsByMetadataInput.First, and is useful for accessing the field via an interface.
+func (v *__ListAccountsByMetadataInput) GetFirst() int { return v.First }
+
+// GetAfter returns __ListAccountsByMetadataInput.After, and is useful for accessing the field via an interface.
+func (v *__ListAccountsByMetadataInput) GetAfter() *string { return v.After }
+
+// __ListAccountsInput is used internally by genqlient
+type __ListAccountsInput struct {
+	JournalId uuid.UUID `json:"journalId"`
+	AsOf      Date      `json:"asOf"`
+	First     int       `json:"first"`
+	After     *string   `json:"after"`
+}
+
+// GetJournalId returns __ListAccountsInput.JournalId, and is useful for accessing the field via an interface.
+func (v *__ListAccountsInput) GetJournalId() uuid.UUID { return v.JournalId }
+
+// GetAsOf returns __ListAccountsInput.AsOf, and is useful for accessing the field via an interface.
+func (v *__ListAccountsInput) GetAsOf() Date { return v.AsOf }
+
+// GetFirst returns __ListAccountsInput.First, and is useful for accessing the field via an interface.
+func (v *__ListAccountsInput) GetFirst() int { return v.First }
+
+// GetAfter returns __ListAccountsInput.After, and is useful for accessing the field via an interface.
+func (v *__ListAccountsInput) GetAfter() *string { return v.After }
+
+// __LockAccountInput is used internally by genqlient
+type __LockAccountInput struct {
+	Id uuid.UUID `json:"id"`
+}
+
+// GetId returns __LockAccountInput.Id, and is useful for accessing the field via an interface.
+func (v *__LockAccountInput) GetId() uuid.UUID { return v.Id }
+
+// __LockJournalInput is used internally by genqlient
+type __LockJournalInput struct {
+	Id uuid.UUID `json:"id"`
+}
+
+// GetId returns __LockJournalInput.Id, and is useful for accessing the field via an interface.
+func (v *__LockJournalInput) GetId() uuid.UUID { return v.Id }
+
+// __LockTranCodeInput is used internally by genqlient
+type __LockTranCodeInput struct {
+	Id uuid.UUID `json:"id"`
+}
+
+// GetId returns __LockTranCodeInput.Id, and is useful for accessing the field via an interface.
+func (v *__LockTranCodeInput) GetId() uuid.UUID { return v.Id }
+
+// __NetActivityEntriesInput is used internally by genqlient
+type __NetActivityEntriesInput struct {
+	AccountId uuid.UUID `json:"accountId"`
+	JournalId string    `json:"journalId"`
+	First     int       `json:"first"`
+	After     *string   `json:"after"`
+}
+
+// GetAccountId returns __NetActivityEntriesInput.AccountId, and is useful for accessing the field via an interface.
+func (v *__NetActivityEntriesInput) GetAccountId() uuid.UUID { return v.AccountId }
+
+// GetJournalId returns __NetActivityEntriesInput.JournalId, and is useful for accessing the field via an interface.
+func (v *__NetActivityEntriesInput) GetJournalId() string { return v.JournalId }
+
+// GetFirst returns __NetActivityEntriesInput.First, and is useful for accessing the field via an interface.
+func (v *__NetActivityEntriesInput) GetFirst() int { return v.First }
+
+// GetAfter returns __NetActivityEntriesInput.After, and is useful for accessing the field via an interface.
+func (v *__NetActivityEntriesInput) GetAfter() *string { return v.After }
+
+// __PostPendingTransferInput is used internally by genqlient
+type __PostPendingTransferInput struct {
+	TransactionId uuid.UUID `json:"transactionId"`
+	TranCode      string    `json:"tranCode"`
+	From          uuid.UUID `json:"from"`
+	To            uuid.UUID `json:"to"`
+	Amount        Decimal   `json:"amount"`
+	Effective     Date      `json:"effective"`
+}
+
+// GetTransactionId returns __PostPendingTransferInput.TransactionId, and is useful for accessing the field via an interface.
+func (v *__PostPendingTransferInput) GetTransactionId() uuid.UUID { return v.TransactionId }
+
+// GetTranCode returns __PostPendingTransferInput.TranCode, and is useful for accessing the field via an interface.
+func (v *__PostPendingTransferInput) GetTranCode() string { return v.TranCode }
+
+// GetFrom returns __PostPendingTransferInput.From, and is useful for accessing the field via an interface.
+func (v *__PostPendingTransferInput) GetFrom() uuid.UUID { return v.From }
+
+// GetTo returns __PostPendingTransferInput.To, and is useful for accessing the field via an interface.
+func (v *__PostPendingTransferInput) GetTo() uuid.UUID { return v.To }
+
+// GetAmount returns __PostPendingTransferInput.Amount, and is useful for accessing the field via an interface.
+func (v *__PostPendingTransferInput) GetAmount() Decimal { return v.Amount }
+
+// GetEffective returns __PostPendingTransferInput.Effective, and is useful for accessing the field via an interface.
+func (v *__PostPendingTransferInput) GetEffective() Date { return v.Effective }
+
+// __PostSimpleTransferInput is used internally by genqlient
+type __PostSimpleTransferInput struct {
+	TransactionId uuid.UUID `json:"transactionId"`
+	JournalId     uuid.UUID `json:"journalId"`
+	From          uuid.UUID `json:"from"`
+	To            uuid.UUID `json:"to"`
+	Amount        Decimal   `json:"amount"`
+	Effective     Date      `json:"effective"`
+}
+
+// GetTransactionId returns __PostSimpleTransferInput.TransactionId, and is useful for accessing the field via an interface.
+func (v *__PostSimpleTransferInput) GetTransactionId() uuid.UUID { return v.TransactionId }
+
+// GetJournalId returns __PostSimpleTransferInput.JournalId, and is useful for accessing the field via an interface.
+func (v *__PostSimpleTransferInput) GetJournalId() uuid.UUID { return v.JournalId }
+
+// GetFrom returns __PostSimpleTransferInput.From, and is useful for accessing the field via an interface.
+func (v *__PostSimpleTransferInput) GetFrom() uuid.UUID { return v.From }
+
+// GetTo returns __PostSimpleTransferInput.To, and is useful for accessing the field via an interface.
+func (v *__PostSimpleTransferInput) GetTo() uuid.UUID { return v.To }
+
+// GetAmount returns __PostSimpleTransferInput.Amount, and is useful for accessing the field via an interface.
+func (v *__PostSimpleTransferInput) GetAmount() Decimal { return v.Amount }
+
+// GetEffective returns __PostSimpleTransferInput.Effective, and is useful for accessing the field via an interface.
+func (v *__PostSimpleTransferInput) GetEffective() Date { return v.Effective }
+
+// __PostSimpleWithMetadataInput is used internally by genqlient
+type __PostSimpleWithMetadataInput struct {
+	TransactionId uuid.UUID              `json:"transactionId"`
+	Effective     Date                   `json:"effective"`
+	Metadata      map[string]interface{} `json:"metadata"`
+}
+
+// GetTransactionId returns __PostSimpleWithMetadataInput.TransactionId, and is useful for accessing the field via an interface.
+func (v *__PostSimpleWithMetadataInput) GetTransactionId() uuid.UUID { return v.TransactionId }
+
+// GetEffective returns __PostSimpleWithMetadataInput.Effective, and is useful for accessing the field via an interface.
+func (v *__PostSimpleWithMetadataInput) GetEffective() Date { return v.Effective }
+
+// GetMetadata returns __PostSimpleWithMetadataInput.Metadata, and is useful for accessing the field via an interface.
+func (v *__PostSimpleWithMetadataInput) GetMetadata() map[string]interface{} { return v.Metadata }
+
+// __PostSimpleWithMetadataToJournalInput is used internally by genqlient
+type __PostSimpleWithMetadataToJournalInput struct {
+	TransactionId uuid.UUID              `json:"transactionId"`
+	JournalId     uuid.UUID              `json:"journalId"`
+	Effective     Date                   `json:"effective"`
+	Metadata      map[string]interface{} `json:"metadata"`
+}
+
+// GetTransactionId returns __PostSimpleWithMetadataToJournalInput.TransactionId, and is useful for accessing the field via an interface.
+func (v *__PostSimpleWithMetadataToJournalInput) GetTransactionId() uuid.UUID { return v.TransactionId }
+
+// GetJournalId returns __PostSimpleWithMetadataToJournalInput.JournalId, and is useful for accessing the field via an interface.
+func (v *__PostSimpleWithMetadataToJournalInput) GetJournalId() uuid.UUID { return v.JournalId }
+
+// GetEffective returns __PostSimpleWithMetadataToJournalInput.Effective, and is useful for accessing the field via an interface.
+func (v *__PostSimpleWithMetadataToJournalInput) GetEffective() Date { return v.Effective }
+
+// GetMetadata returns __PostSimpleWithMetadataToJournalInput.Metadata, and is useful for accessing the field via an interface.
+func (v *__PostSimpleWithMetadataToJournalInput) GetMetadata() map[string]interface{} {
+	return v.Metadata
+}
+
+// __PostTransactionInput is used internally by genqlient
+type __PostTransactionInput struct {
+	TransactionId uuid.UUID `json:"transactionId"`
+	Effective     Date      `json:"effective"`
+}
+
+// GetTransactionId returns __PostTransactionInput.TransactionId, and is useful for accessing the field via an interface.
+func (v *__PostTransactionInput) GetTransactionId() uuid.UUID { return v.TransactionId }
+
+// GetEffective returns __PostTransactionInput.Effective, and is useful for accessing the field via an interface.
+func (v *__PostTransactionInput) GetEffective() Date { return v.Effective }
+
+// __PostTransactionToJournalInput is used internally by genqlient
+type __PostTransactionToJournalInput struct {
+	TransactionId uuid.UUID `json:"transactionId"`
+	JournalId     uuid.UUID `json:"journalId"`
+	Effective     Date      `json:"effective"`
+}
+
+// GetTransactionId returns __PostTransactionToJournalInput.TransactionId, and is useful for accessing the field via an interface.
+func (v *__PostTransactionToJournalInput) GetTransactionId() uuid.UUID { return v.TransactionId }
+
+// GetJournalId returns __PostTransactionToJournalInput.JournalId, and is useful for accessing the field via an interface.
+func (v *__PostTransactionToJournalInput) GetJournalId() uuid.UUID { return v.JournalId }
+
+// GetEffective returns __PostTransactionToJournalInput.Effective, and is useful for accessing the field via an interface.
+func (v *__PostTransactionToJournalInput) GetEffective() Date { return v.Effective }
+
+// __PostTransactionWithStatementDateInput is used internally by genqlient
+type __PostTransactionWithStatementDateInput struct {
+	TransactionId uuid.UUID `json:"transactionId"`
+	Effective     Date      `json:"effective"`
+	StatementDate Date      `json:"statementDate"`
+}
+
+// GetTransactionId returns __PostTransactionWithStatementDateInput.TransactionId, and is useful for accessing the field via an interface.
+func (v *__PostTransactionWithStatementDateInput) GetTransactionId() uuid.UUID {
+	return v.TransactionId
+}
+
+// GetEffective returns __PostTransactionWithStatementDateInput.Effective, and is useful for accessing the field via an interface.
+func (v *__PostTransactionWithStatementDateInput) GetEffective() Date { return v.Effective }
+
+// GetStatementDate returns __PostTransactionWithStatementDateInput.StatementDate, and is useful for accessing the field via an interface.
+func (v *__PostTransactionWithStatementDateInput) GetStatementDate() Date { return v.StatementDate }
+
+// __PostTransferInCurrencyInput is used internally by genqlient
+type __PostTransferInCurrencyInput struct {
+	TransactionId uuid.UUID `json:"transactionId"`
+	TranCode      string    `json:"tranCode"`
+	From          uuid.UUID `json:"from"`
+	To            uuid.UUID `json:"to"`
+	Amount        Decimal   `json:"amount"`
+	Currency      string    `json:"currency"`
+	Effective     Date      `json:"effective"`
+}
+
+// GetTransactionId returns __PostTransferInCurrencyInput.TransactionId, and is useful for accessing the field via an interface.
+func (v *__PostTransferInCurrencyInput) GetTransactionId() uuid.UUID { return v.TransactionId }
+
+// GetTranCode returns __PostTransferInCurrencyInput.TranCode, and is useful for accessing the field via an interface.
+func (v *__PostTransferInCurrencyInput) GetTranCode() string { return v.TranCode }
+
+// GetFrom returns __PostTransferInCurrencyInput.From, and is useful for accessing the field via an interface.
+func (v *__PostTransferInCurrencyInput) GetFrom() uuid.UUID { return v.From }
+
+// GetTo returns __PostTransferInCurrencyInput.To, and is useful for accessing the field via an interface.
+func (v *__PostTransferInCurrencyInput) GetTo() uuid.UUID { return v.To }
+
+// GetAmount returns __PostTransferInCurrencyInput.Amount, and is useful for accessing the field via an interface.
+func (v *__PostTransferInCurrencyInput) GetAmount() Decimal { return v.Amount }
+
+// GetCurrency returns __PostTransferInCurrencyInput.Currency, and is useful for accessing the field via an interface.
+func (v *__PostTransferInCurrencyInput) GetCurrency() string { return v.Currency }
+
+// GetEffective returns __PostTransferInCurrencyInput.Effective, and is useful for accessing the field via an interface.
+func (v *__PostTransferInCurrencyInput) GetEffective() Date { return v.Effective }
+
+// __PostTransferInput is used internally by genqlient
+type __PostTransferInput struct {
+	TransactionId uuid.UUID `json:"transactionId"`
+	TranCode      string    `json:"tranCode"`
+	From          uuid.UUID `json:"from"`
+	To            uuid.UUID `json:"to"`
+	Amount        Decimal   `json:"amount"`
+	Effective     Date      `json:"effective"`
+}
+
+// GetTransactionId returns __PostTransferInput.TransactionId, and is useful for accessing the field via an interface.
+func (v *__PostTransferInput) GetTransactionId() uuid.UUID { return v.TransactionId }
+
+// GetTranCode returns __PostTransferInput.TranCode, and is useful for accessing the field via an interface.
+func (v *__PostTransferInput) GetTranCode() string { return v.TranCode }
+
+// GetFrom returns __PostTransferInput.From, and is useful for accessing the field via an interface.
+func (v *__PostTransferInput) GetFrom() uuid.UUID { return v.From }
+
+// GetTo returns __PostTransferInput.To, and is useful for accessing the field via an interface.
+func (v *__PostTransferInput) GetTo() uuid.UUID { return v.To }
+
+// GetAmount returns __PostTransferInput.Amount, and is useful for accessing the field via an interface.
+func (v *__PostTransferInput) GetAmount() Decimal { return v.Amount }
+
+// GetEffective returns __PostTransferInput.Effective, and is useful for accessing the field via an interface.
+func (v *__PostTransferInput) GetEffective() Date { return v.Effective }
+
+// __SetupInput is used internally by genqlient
+type __SetupInput struct {
+	JournalId  uuid.UUID `json:"journalId"`
+	TranCodeId uuid.UUID `json:"tranCodeId"`
+	Account1Id uuid.UUID `json:"account1Id"`
 	Account2Id uuid.UUID `json:"account2Id"`
 }
 
-// GetJournalId returns __SetupInput.JournalId, and is useful for accessing the field via an interface.
-func (v *__SetupInput) GetJournalId() uuid.UUID { return v.JournalId }
+// GetJournalId returns __SetupInput.JournalId, and is useful for accessing the field via an interface.
+func (v *__SetupInput) GetJournalId() uuid.UUID { return v.JournalId }
+
+// GetTranCodeId returns __SetupInput.TranCodeId, and is useful for accessing the field via an interface.
+func (v *__SetupInput) GetTranCodeId() uuid.UUID { return v.TranCodeId }
+
+// GetAccount1Id returns __SetupInput.Account1Id, and is useful for accessing the field via an interface.
+func (v *__SetupInput) GetAccount1Id() uuid.UUID { return v.Account1Id }
+
+// GetAccount2Id returns __SetupInput.Account2Id, and is useful for accessing the field via an interface.
+func (v *__SetupInput) GetAccount2Id() uuid.UUID { return v.Account2Id }
+
+// __SetupRetailBankingInput is used internally by genqlient
+type __SetupRetailBankingInput struct {
+	JournalId    uuid.UUID `json:"journalId"`
+	JournalCode  string    `json:"journalCode"`
+	JournalExpr  string    `json:"journalExpr"`
+	TransferId   uuid.UUID `json:"transferId"`
+	TransferCode string    `json:"transferCode"`
+	CheckingId   uuid.UUID `json:"checkingId"`
+	CheckingCode string    `json:"checkingCode"`
+	SavingsId    uuid.UUID `json:"savingsId"`
+	SavingsCode  string    `json:"savingsCode"`
+	CashId       uuid.UUID `json:"cashId"`
+	CashCode     string    `json:"cashCode"`
+}
+
+// GetJournalId returns __SetupRetailBankingInput.JournalId, and is useful for accessing the field via an interface.
+func (v *__SetupRetailBankingInput) GetJournalId() uuid.UUID { return v.JournalId }
+
+// GetJournalCode returns __SetupRetailBankingInput.JournalCode, and is useful for accessing the field via an interface.
+func (v *__SetupRetailBankingInput) GetJournalCode() string { return v.JournalCode }
+
+// GetJournalExpr returns __SetupRetailBankingInput.JournalExpr, and is useful for accessing the field via an interface.
+func (v *__SetupRetailBankingInput) GetJournalExpr() string { return v.JournalExpr }
+
+// GetTransferId returns __SetupRetailBankingInput.TransferId, and is useful for accessing the field via an interface.
+func (v *__SetupRetailBankingInput) GetTransferId() uuid.UUID { return v.TransferId }
+
+// GetTransferCode returns __SetupRetailBankingInput.TransferCode, and is useful for accessing the field via an interface.
+func (v *__SetupRetailBankingInput) GetTransferCode() string { return v.TransferCode }
+
+// GetCheckingId returns __SetupRetailBankingInput.CheckingId, and is useful for accessing the field via an interface.
+func (v *__SetupRetailBankingInput) GetCheckingId() uuid.UUID { return v.CheckingId }
+
+// GetCheckingCode returns __SetupRetailBankingInput.CheckingCode, and is useful for accessing the field via an interface.
+func (v *__SetupRetailBankingInput) GetCheckingCode() string { return v.CheckingCode }
+
+// GetSavingsId returns __SetupRetailBankingInput.SavingsId, and is useful for accessing the field via an interface.
+func (v *__SetupRetailBankingInput) GetSavingsId() uuid.UUID { return v.SavingsId }
+
+// GetSavingsCode returns __SetupRetailBankingInput.SavingsCode, and is useful for accessing the field via an interface.
+func (v *__SetupRetailBankingInput) GetSavingsCode() string { return v.SavingsCode }
+
+// GetCashId returns __SetupRetailBankingInput.CashId, and is useful for accessing the field via an interface.
+func (v *__SetupRetailBankingInput) GetCashId() uuid.UUID { return v.CashId }
+
+// GetCashCode returns __SetupRetailBankingInput.CashCode, and is useful for accessing the field via an interface.
+func (v *__SetupRetailBankingInput) GetCashCode() string { return v.CashCode }
+
+// __SetupSimpleMetaInput is used internally by genqlient
+type __SetupSimpleMetaInput struct {
+	TranCodeId uuid.UUID `json:"tranCodeId"`
+}
+
+// GetTranCodeId returns __SetupSimpleMetaInput.TranCodeId, and is useful for accessing the field via an interface.
+func (v *__SetupSimpleMetaInput) GetTranCodeId() uuid.UUID { return v.TranCodeId }
+
+// __StatementBalanceInput is used internally by genqlient
+type __StatementBalanceInput struct {
+	AccountID             uuid.UUID `json:"accountID"`
+	JournalID             uuid.UUID `json:"journalID"`
+	OpenDate              Date      `json:"openDate"`
+	CloseDate             Date      `json:"closeDate"`
+	PriorPeriodCloseStamp string    `json:"priorPeriodCloseStamp"`
+	ThisPeriodCloseStamp  string    `json:"thisPeriodCloseStamp"`
+}
+
+// GetAccountID returns __StatementBalanceInput.AccountID, and is useful for accessing the field via an interface.
+func (v *__StatementBalanceInput) GetAccountID() uuid.UUID { return v.AccountID }
+
+// GetJournalID returns __StatementBalanceInput.JournalID, and is useful for accessing the field via an interface.
+func (v *__StatementBalanceInput) GetJournalID() uuid.UUID { return v.JournalID }
+
+// GetOpenDate returns __StatementBalanceInput.OpenDate, and is useful for accessing the field via an interface.
+func (v *__StatementBalanceInput) GetOpenDate() Date { return v.OpenDate }
+
+// GetCloseDate returns __StatementBalanceInput.CloseDate, and is useful for accessing the field via an interface.
+func (v *__StatementBalanceInput) GetCloseDate() Date { return v.CloseDate }
+
+// GetPriorPeriodCloseStamp returns __StatementBalanceInput.PriorPeriodCloseStamp, and is useful for accessing the field via an interface.
+func (v *__StatementBalanceInput) GetPriorPeriodCloseStamp() string { return v.PriorPeriodCloseStamp }
+
+// GetThisPeriodCloseStamp returns __StatementBalanceInput.ThisPeriodCloseStamp, and is useful for accessing the field via an interface.
+func (v *__StatementBalanceInput) GetThisPeriodCloseStamp() string { return v.ThisPeriodCloseStamp }
+
+// __TouchJournalInput is used internally by genqlient
+type __TouchJournalInput struct {
+	JournalId   uuid.UUID `json:"journalId"`
+	Description string    `json:"description"`
+}
+
+// GetJournalId returns __TouchJournalInput.JournalId, and is useful for accessing the field via an interface.
+func (v *__TouchJournalInput) GetJournalId() uuid.UUID { return v.JournalId }
+
+// GetDescription returns __TouchJournalInput.Description, and is useful for accessing the field via an interface.
+func (v *__TouchJournalInput) GetDescription() string { return v.Description }
+
+// __TranCodeLockStatusInput is used internally by genqlient
+type __TranCodeLockStatusInput struct {
+	Id uuid.UUID `json:"id"`
+}
+
+// GetId returns __TranCodeLockStatusInput.Id, and is useful for accessing the field via an interface.
+func (v *__TranCodeLockStatusInput) GetId() uuid.UUID { return v.Id }
+
+// __UpdateAccountMetadataInput is used internally by genqlient
+type __UpdateAccountMetadataInput struct {
+	AccountId uuid.UUID              `json:"accountId"`
+	Metadata  map[string]interface{} `json:"metadata"`
+}
+
+// GetAccountId returns __UpdateAccountMetadataInput.AccountId, and is useful for accessing the field via an interface.
+func (v *__UpdateAccountMetadataInput) GetAccountId() uuid.UUID { return v.AccountId }
+
+// GetMetadata returns __UpdateAccountMetadataInput.Metadata, and is useful for accessing the field via an interface.
+func (v *__UpdateAccountMetadataInput) GetMetadata() map[string]interface{} { return v.Metadata }
+
+// The query executed by AccountBalanceLayers.
+const AccountBalanceLayers_Operation = `
+query AccountBalanceLayers ($accountId: UUID!, $journalId: UUID!, $asOf: Date!) {
+	balance(accountId: $accountId, journalId: $journalId, effective: {cumulative:$asOf}) {
+		settled {
+			normalBalance {
+				units
+			}
+		}
+		pending {
+			normalBalance {
+				units
+			}
+		}
+		available(layer: PENDING) {
+			normalBalance {
+				units
+			}
+		}
+	}
+}
+`
+
+func AccountBalanceLayers(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	accountId uuid.UUID,
+	journalId uuid.UUID,
+	asOf Date,
+) (data_ *AccountBalanceLayersResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "AccountBalanceLayers",
+		Query:  AccountBalanceLayers_Operation,
+		Variables: &__AccountBalanceLayersInput{
+			AccountId: accountId,
+			JournalId: journalId,
+			AsOf:      asOf,
+		},
+	}
+
+	data_ = &AccountBalanceLayersResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by AccountCurrencies.
+const AccountCurrencies_Operation = `
+query AccountCurrencies ($accountId: String!, $journalId: String!, $first: Int!) {
+	balances(index: {name:ACCOUNT_ID}, where: {accountId:{eq:$accountId},journalId:{eq:$journalId}}, first: $first) {
+		nodes {
+			currency
+		}
+	}
+}
+`
+
+func AccountCurrencies(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	accountId string,
+	journalId string,
+	first int,
+) (data_ *AccountCurrenciesResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "AccountCurrencies",
+		Query:  AccountCurrencies_Operation,
+		Variables: &__AccountCurrenciesInput{
+			AccountId: accountId,
+			JournalId: journalId,
+			First:     first,
+		},
+	}
+
+	data_ = &AccountCurrenciesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by AccountLockStatus.
+const AccountLockStatus_Operation = `
+query AccountLockStatus ($id: UUID!) {
+	account(id: $id) {
+		accountId
+		status
+	}
+}
+`
+
+func AccountLockStatus(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id uuid.UUID,
+) (data_ *AccountLockStatusResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "AccountLockStatus",
+		Query:  AccountLockStatus_Operation,
+		Variables: &__AccountLockStatusInput{
+			Id: id,
+		},
+	}
+
+	data_ = &AccountLockStatusResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by ActivityIndexQuery.
+const ActivityIndexQuery_Operation = `
+query ActivityIndexQuery ($index: String!, $journalId: String, $accountId: String, $period: String, $first: Int!, $after: String) {
+	entries(index: {name:CUSTOM}, where: {custom:{index:$index,partition:[{alias:"journalId",value:{eq:$journalId}},{alias:"accountId",value:{eq:$accountId}},{alias:"settled",value:{eq:"true"}},{alias:"period",value:{eq:$period}}],sort:[]}}, first: $first, after: $after) {
+		nodes {
+			entryId
+			journalId
+			metadata
+			amount {
+				units
+			}
+			transaction {
+				metadata
+				entries(first: 10) {
+					nodes {
+						account {
+							code
+						}
+					}
+				}
+			}
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+	}
+}
+`
+
+// ActivityIndexQuery is ActivityQuery on a named index, paged, with each
+// node's entry and journal IDs. Its nodes select a superset of
+// ActivityQuery's, so a page decodes into ActivityQueryResponse too.
+func ActivityIndexQuery(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	index string,
+	journalId *string,
+	accountId *string,
+	period *string,
+	first int,
+	after *string,
+) (data_ *ActivityIndexQueryResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ActivityIndexQuery",
+		Query:  ActivityIndexQuery_Operation,
+		Variables: &__ActivityIndexQueryInput{
+			Index:     index,
+			JournalId: journalId,
+			AccountId: accountId,
+			Period:    period,
+			First:     first,
+			After:     after,
+		},
+	}
+
+	data_ = &ActivityIndexQueryResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by ActivityQuery.
+const ActivityQuery_Operation = `
+query ActivityQuery ($journalId: String, $accountId: String, $period: String) {
+	entries(index: {name:CUSTOM}, where: {custom:{index:"activity",partition:[{alias:"journalId",value:{eq:$journalId}},{alias:"accountId",value:{eq:$accountId}},{alias:"settled",value:{eq:"true"}},{alias:"period",value:{eq:$period}}],sort:[]}}, first: 100) {
+		nodes {
+			metadata
+			amount {
+				units
+			}
+			transaction {
+				metadata
+				entries(first: 10) {
+					nodes {
+						account {
+							code
+						}
+					}
+				}
+			}
+		}
+	}
+}
+`
+
+func ActivityQuery(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	journalId *string,
+	accountId *string,
+	period *string,
+) (data_ *ActivityQueryResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ActivityQuery",
+		Query:  ActivityQuery_Operation,
+		Variables: &__ActivityQueryInput{
+			JournalId: journalId,
+			AccountId: accountId,
+			Period:    period,
+		},
+	}
+
+	data_ = &ActivityQueryResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by BalanceAtCutoff.
+const BalanceAtCutoff_Operation = `
+query BalanceAtCutoff ($accountId: UUID!, $journalId: UUID!, $asOf: Date!, $cutoff: String!) {
+	balance(accountId: $accountId, journalId: $journalId, effective: {cumulative:$asOf,where:{modified:{lt:$cutoff}}}, type: PREPARED) {
+		available(layer: SETTLED) {
+			normalBalance {
+				units
+			}
+		}
+	}
+}
+`
+
+func BalanceAtCutoff(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	accountId uuid.UUID,
+	journalId uuid.UUID,
+	asOf Date,
+	cutoff string,
+) (data_ *BalanceAtCutoffResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "BalanceAtCutoff",
+		Query:  BalanceAtCutoff_Operation,
+		Variables: &__BalanceAtCutoffInput{
+			AccountId: accountId,
+			JournalId: journalId,
+			AsOf:      asOf,
+			Cutoff:    cutoff,
+		},
+	}
+
+	data_ = &BalanceAtCutoffResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by BalanceVersions.
+const BalanceVersions_Operation = `
+query BalanceVersions ($accountId: UUID!, $journalId: UUID!, $first: Int!, $after: String) {
+	balance(accountId: $accountId, journalId: $journalId) {
+		version
+		available(layer: SETTLED) {
+			normalBalance {
+				units
+			}
+		}
+		history(first: $first, after: $after) {
+			nodes {
+				version
+				available(layer: SETTLED) {
+					normalBalance {
+						units
+					}
+				}
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
+	}
+}
+`
+
+func BalanceVersions(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	accountId uuid.UUID,
+	journalId uuid.UUID,
+	first int,
+	after *string,
+) (data_ *BalanceVersionsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "BalanceVersions",
+		Query:  BalanceVersions_Operation,
+		Variables: &__BalanceVersionsInput{
+			AccountId: accountId,
+			JournalId: journalId,
+			First:     first,
+			After:     after,
+		},
+	}
+
+	data_ = &BalanceVersionsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by CountActivityEntries.
+const CountActivityEntries_Operation = `
+query CountActivityEntries ($index: String!, $journalId: String!, $accountId: String!, $period: String!, $first: Int!, $after: String) {
+	entries(index: {name:CUSTOM}, where: {custom:{index:$index,partition:[{alias:"journalId",value:{eq:$journalId}},{alias:"accountId",value:{eq:$accountId}},{alias:"settled",value:{eq:"true"}},{alias:"period",value:{eq:$period}}],sort:[]}}, first: $first, after: $after) {
+		nodes {
+			entryId
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+	}
+}
+`
+
+func CountActivityEntries(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	index string,
+	journalId string,
+	accountId string,
+	period string,
+	first int,
+	after *string,
+) (data_ *CountActivityEntriesResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "CountActivityEntries",
+		Query:  CountActivityEntries_Operation,
+		Variables: &__CountActivityEntriesInput{
+			Index:     index,
+			JournalId: journalId,
+			AccountId: accountId,
+			Period:    period,
+			First:     first,
+			After:     after,
+		},
+	}
+
+	data_ = &CountActivityEntriesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by CreateAccount.
+const CreateAccount_Operation = `
+mutation CreateAccount ($input: AccountInput!) {
+	createAccount(input: $input) {
+		accountId
+		code
+	}
+}
+`
+
+func CreateAccount(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input AccountInput,
+) (data_ *CreateAccountResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "CreateAccount",
+		Query:  CreateAccount_Operation,
+		Variables: &__CreateAccountInput{
+			Input: input,
+		},
+	}
+
+	data_ = &CreateAccountResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by CreateActivityIndex.
+const CreateActivityIndex_Operation = `
+mutation CreateActivityIndex {
+	schema {
+		createIndex(input: {name:"activity",on:Entry,partition:[{alias:"journalId",value:"document.journal_id"},{alias:"accountId",value:"document.parent_account_ids+[document.account_id]"},{alias:"settled",value:"string(bool(document.layer == 0))"},{alias:"period",value:"string(date(document.?metadata.?statementDate.orValue(document.?metadata.?effective.orValue(document.created)))).take(7)",type:STRING}],sort:[{alias:"created",value:"document.created",sort:DESC},{alias:"entryId",value:"document.entry_id",sort:DESC}],constraints:{isNotVoidEntry:"!document.is_void_entry",isNotVoidedEntry:"!document.is_voided_entry"}}) {
+			name
+			on
+		}
+	}
+}
+`
+
+func CreateActivityIndex(
+	ctx_ context.Context,
+	client_ graphql.Client,
+) (data_ *CreateActivityIndexResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "CreateActivityIndex",
+		Query:  CreateActivityIndex_Operation,
+	}
+
+	data_ = &CreateActivityIndexResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by CreateCustomIndex.
+const CreateCustomIndex_Operation = `
+mutation CreateCustomIndex ($input: CreateIndexInput!) {
+	schema {
+		createIndex(input: $input) {
+			name
+			on
+			partition {
+				alias
+				value
+				type
+			}
+			range {
+				alias
+				value
+				sort
+				type
+			}
+			constraints
+		}
+	}
+}
+`
+
+func CreateCustomIndex(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input CreateIndexInput,
+) (data_ *CreateCustomIndexResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "CreateCustomIndex",
+		Query:  CreateCustomIndex_Operation,
+		Variables: &__CreateCustomIndexInput{
+			Input: input,
+		},
+	}
+
+	data_ = &CreateCustomIndexResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by CustomIndexEntries.
+const CustomIndexEntries_Operation = `
+query CustomIndexEntries ($index: String!, $partition: [CustomIndexFilterValue!], $sort: [CustomIndexFilterValue!], $first: Int!) {
+	entries(index: {name:CUSTOM}, where: {custom:{index:$index,partition:$partition,sort:$sort}}, first: $first) {
+		nodes {
+			entryId
+			metadata
+			amount {
+				units
+			}
+		}
+	}
+}
+`
+
+func CustomIndexEntries(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	index string,
+	partition []CustomIndexFilterValue,
+	sort []CustomIndexFilterValue,
+	first int,
+) (data_ *CustomIndexEntriesResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "CustomIndexEntries",
+		Query:  CustomIndexEntries_Operation,
+		Variables: &__CustomIndexEntriesInput{
+			Index:     index,
+			Partition: partition,
+			Sort:      sort,
+			First:     first,
+		},
+	}
+
+	data_ = &CustomIndexEntriesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by DumpAccountEntries.
+const DumpAccountEntries_Operation = `
+query DumpAccountEntries ($accountId: UUID!, $journalId: String!, $first: Int!) {
+	account(id: $accountId) {
+		entries(where: {journalId:{eq:$journalId}}, first: $first) {
+			nodes {
+				transactionId
+				entryType
+				direction
+				layer
+				amount {
+					units
+					currency
+				}
+				created
+				transaction {
+					effective
+					tranCode {
+						code
+					}
+				}
+			}
+		}
+	}
+}
+`
+
+func DumpAccountEntries(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	accountId uuid.UUID,
+	journalId string,
+	first int,
+) (data_ *DumpAccountEntriesResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "DumpAccountEntries",
+		Query:  DumpAccountEntries_Operation,
+		Variables: &__DumpAccountEntriesInput{
+			AccountId: accountId,
+			JournalId: journalId,
+			First:     first,
+		},
+	}
+
+	data_ = &DumpAccountEntriesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by DumpAccounts.
+const DumpAccounts_Operation = `
+query DumpAccounts ($journalId: UUID!, $first: Int!) {
+	accounts(index: {name:CODE}, first: $first) {
+		nodes {
+			accountId
+			code
+			name
+			normalBalanceType
+			balance(journalId: $journalId) {
+				available(layer: SETTLED) {
+					normalBalance {
+						units
+						currency
+					}
+				}
+			}
+		}
+	}
+}
+`
+
+func DumpAccounts(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	journalId uuid.UUID,
+	first int,
+) (data_ *DumpAccountsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "DumpAccounts",
+		Query:  DumpAccounts_Operation,
+		Variables: &__DumpAccountsInput{
+			JournalId: journalId,
+			First:     first,
+		},
+	}
+
+	data_ = &DumpAccountsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by FetchJournal.
+const FetchJournal_Operation = `
+query FetchJournal ($id: UUID!) {
+	journal(id: $id) {
+		journalId
+		name
+		description
+		code
+		status
+		config {
+			enableEffectiveBalances
+		}
+		created
+		modified
+	}
+}
+`
+
+func FetchJournal(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id uuid.UUID,
+) (data_ *FetchJournalResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "FetchJournal",
+		Query:  FetchJournal_Operation,
+		Variables: &__FetchJournalInput{
+			Id: id,
+		},
+	}
+
+	data_ = &FetchJournalResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by FetchTransactionEntries.
+const FetchTransactionEntries_Operation = `
+query FetchTransactionEntries ($id: UUID!, $first: Int!, $after: String) {
+	transaction(id: $id) {
+		entries(first: $first, after: $after) {
+			nodes {
+				entryId
+				accountId
+				journalId
+				entryType
+				layer
+				direction
+				sequence
+				amount {
+					units
+					currency
+				}
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
+	}
+}
+`
+
+func FetchTransactionEntries(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id uuid.UUID,
+	first int,
+	after *string,
+) (data_ *FetchTransactionEntriesResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "FetchTransactionEntries",
+		Query:  FetchTransactionEntries_Operation,
+		Variables: &__FetchTransactionEntriesInput{
+			Id:    id,
+			First: first,
+			After: after,
+		},
+	}
+
+	data_ = &FetchTransactionEntriesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by JournalLockStatus.
+const JournalLockStatus_Operation = `
+query JournalLockStatus ($id: UUID!) {
+	journal(id: $id) {
+		journalId
+		status
+	}
+}
+`
+
+func JournalLockStatus(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id uuid.UUID,
+) (data_ *JournalLockStatusResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "JournalLockStatus",
+		Query:  JournalLockStatus_Operation,
+		Variables: &__JournalLockStatusInput{
+			Id: id,
+		},
+	}
+
+	data_ = &JournalLockStatusResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by ListAccounts.
+const ListAccounts_Operation = `
+query ListAccounts ($journalId: UUID!, $asOf: Date!, $first: Int!, $after: String) {
+	accounts(index: {name:CODE}, first: $first, after: $after) {
+		nodes {
+			accountId
+			code
+			balance(journalId: $journalId, effective: {cumulative:$asOf}) {
+				settled {
+					drBalance {
+						units
+					}
+					crBalance {
+						units
+					}
+				}
+			}
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+	}
+}
+`
 
-// GetTranCodeId returns __SetupInput.TranCodeId, and is useful for accessing the field via an interface.
-func (v *__SetupInput) GetTranCodeId() uuid.UUID { return v.TranCodeId }
+func ListAccounts(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	journalId uuid.UUID,
+	asOf Date,
+	first int,
+	after *string,
+) (data_ *ListAccountsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ListAccounts",
+		Query:  ListAccounts_Operation,
+		Variables: &__ListAccountsInput{
+			JournalId: journalId,
+			AsOf:      asOf,
+			First:     first,
+			After:     after,
+		},
+	}
 
-// GetAccount1Id returns __SetupInput.Account1Id, and is useful for accessing the field via an interface.
-func (v *__SetupInput) GetAccount1Id() uuid.UUID { return v.Account1Id }
+	data_ = &ListAccountsResponse{}
+	resp_ := &graphql.Response{Data: data_}
 
-// GetAccount2Id returns __SetupInput.Account2Id, and is useful for accessing the field via an interface.
-func (v *__SetupInput) GetAccount2Id() uuid.UUID { return v.Account2Id }
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
 
-// __StatementBalanceInput is used internally by genqlient
-type __StatementBalanceInput struct {
-	AccountID             uuid.UUID `json:"accountID"`
-	JournalID             uuid.UUID `json:"journalID"`
-	OpenDate              Date      `json:"openDate"`
-	CloseDate             Date      `json:"closeDate"`
-	PriorPeriodCloseStamp string    `json:"priorPeriodCloseStamp"`
-	ThisPeriodCloseStamp  string    `json:"thisPeriodCloseStamp"`
+	return data_, err_
 }
 
-// GetAccountID returns __StatementBalanceInput.AccountID, and is useful for accessing the field via an interface.
-func (v *__StatementBalanceInput) GetAccountID() uuid.UUID { return v.AccountID }
+// The query executed by ListAccountsByMetadata.
+const ListAccountsByMetadata_Operation = `
+query ListAccountsByMetadata ($journalId: UUID!, $asOf: Date!, $first: Int!, $after: String) {
+	accounts(index: {name:CODE}, first: $first, after: $after) {
+		nodes {
+			accountId
+			metadata
+			balance(journalId: $journalId, effective: {cumulative:$asOf}) {
+				settled {
+					normalBalance {
+						units
+					}
+				}
+			}
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+	}
+}
+`
 
-// GetJournalID returns __StatementBalanceInput.JournalID, and is useful for accessing the field via an interface.
-func (v *__StatementBalanceInput) GetJournalID() uuid.UUID { return v.JournalID }
+func ListAccountsByMetadata(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	journalId uuid.UUID,
+	asOf Date,
+	first int,
+	after *string,
+) (data_ *ListAccountsByMetadataResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ListAccountsByMetadata",
+		Query:  ListAccountsByMetadata_Operation,
+		Variables: &__ListAccountsByMetadataInput{
+			JournalId: journalId,
+			AsOf:      asOf,
+			First:     first,
+			After:     after,
+		},
+	}
 
-// GetOpenDate returns __StatementBalanceInput.OpenDate, and is useful for accessing the field via an interface.
-func (v *__StatementBalanceInput) GetOpenDate() Date { return v.OpenDate }
+	data_ = &ListAccountsByMetadataResponse{}
+	resp_ := &graphql.Response{Data: data_}
 
-// GetCloseDate returns __StatementBalanceInput.CloseDate, and is useful for accessing the field via an interface.
-func (v *__StatementBalanceInput) GetCloseDate() Date { return v.CloseDate }
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
 
-// GetPriorPeriodCloseStamp returns __StatementBalanceInput.PriorPeriodCloseStamp, and is useful for accessing the field via an interface.
-func (v *__StatementBalanceInput) GetPriorPeriodCloseStamp() string { return v.PriorPeriodCloseStamp }
+	return data_, err_
+}
 
-// GetThisPeriodCloseStamp returns __StatementBalanceInput.ThisPeriodCloseStamp, and is useful for accessing the field via an interface.
-func (v *__StatementBalanceInput) GetThisPeriodCloseStamp() string { return v.ThisPeriodCloseStamp }
+// The mutation executed by LockAccount.
+const LockAccount_Operation = `
+mutation LockAccount ($id: UUID!) {
+	deleteAccount(id: $id) {
+		accountId
+		status
+	}
+}
+`
 
-// The query executed by ActivityQuery.
-const ActivityQuery_Operation = `
-query ActivityQuery ($journalId: String, $accountId: String, $period: String) {
-	entries(index: {name:CUSTOM}, where: {custom:{index:"activity",partition:[{alias:"journalId",value:{eq:$journalId}},{alias:"accountId",value:{eq:$accountId}},{alias:"settled",value:{eq:"true"}},{alias:"period",value:{eq:$period}}],sort:[]}}, first: 100) {
-		nodes {
-			metadata
-			amount {
-				units
-			}
-			transaction {
-				metadata
-				entries(first: 10) {
-					nodes {
-						account {
-							code
-						}
-					}
+func LockAccount(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id uuid.UUID,
+) (data_ *LockAccountResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "LockAccount",
+		Query:  LockAccount_Operation,
+		Variables: &__LockAccountInput{
+			Id: id,
+		},
+	}
+
+	data_ = &LockAccountResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by LockJournal.
+const LockJournal_Operation = `
+mutation LockJournal ($id: UUID!) {
+	deleteJournal(id: $id) {
+		journalId
+		status
+	}
+}
+`
+
+func LockJournal(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id uuid.UUID,
+) (data_ *LockJournalResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "LockJournal",
+		Query:  LockJournal_Operation,
+		Variables: &__LockJournalInput{
+			Id: id,
+		},
+	}
+
+	data_ = &LockJournalResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by LockTranCode.
+const LockTranCode_Operation = `
+mutation LockTranCode ($id: UUID!) {
+	deleteTranCode(id: $id) {
+		tranCodeId
+		status
+	}
+}
+`
+
+func LockTranCode(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id uuid.UUID,
+) (data_ *LockTranCodeResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "LockTranCode",
+		Query:  LockTranCode_Operation,
+		Variables: &__LockTranCodeInput{
+			Id: id,
+		},
+	}
+
+	data_ = &LockTranCodeResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by NetActivityEntries.
+const NetActivityEntries_Operation = `
+query NetActivityEntries ($accountId: UUID!, $journalId: String!, $first: Int!, $after: String) {
+	account(id: $accountId) {
+		normalBalanceType
+		entries(where: {journalId:{eq:$journalId}}, first: $first, after: $after) {
+			nodes {
+				direction
+				layer
+				amount {
+					units
+				}
+				transaction {
+					effective
 				}
 			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
 		}
 	}
 }
 `
 
-func ActivityQuery(
+// NetActivityEntries pages through an account's entries in one journal for
+// NetActivity, with what it needs to sign and date each one.
+func NetActivityEntries(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	journalId *string,
-	accountId *string,
-	period *string,
-) (data_ *ActivityQueryResponse, err_ error) {
+	accountId uuid.UUID,
+	journalId string,
+	first int,
+	after *string,
+) (data_ *NetActivityEntriesResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "NetActivityEntries",
+		Query:  NetActivityEntries_Operation,
+		Variables: &__NetActivityEntriesInput{
+			AccountId: accountId,
+			JournalId: journalId,
+			First:     first,
+			After:     after,
+		},
+	}
+
+	data_ = &NetActivityEntriesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by PostPendingTransfer.
+const PostPendingTransfer_Operation = `
+mutation PostPendingTransfer ($transactionId: UUID!, $tranCode: String!, $from: UUID!, $to: UUID!, $amount: Decimal!, $effective: Date!) {
+	postTransaction(input: {transactionId:$transactionId,tranCode:$tranCode,params:{from:$from,to:$to,amount:$amount,effective:$effective,layer:"PENDING"}}) {
+		transactionId
+		created
+	}
+}
+`
+
+func PostPendingTransfer(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	transactionId uuid.UUID,
+	tranCode string,
+	from uuid.UUID,
+	to uuid.UUID,
+	amount Decimal,
+	effective Date,
+) (data_ *PostPendingTransferResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "PostPendingTransfer",
+		Query:  PostPendingTransfer_Operation,
+		Variables: &__PostPendingTransferInput{
+			TransactionId: transactionId,
+			TranCode:      tranCode,
+			From:          from,
+			To:            to,
+			Amount:        amount,
+			Effective:     effective,
+		},
+	}
+
+	data_ = &PostPendingTransferResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by PostSimpleTransfer.
+const PostSimpleTransfer_Operation = `
+mutation PostSimpleTransfer ($transactionId: UUID!, $journalId: UUID!, $from: UUID!, $to: UUID!, $amount: Decimal!, $effective: Date!) {
+	postTransaction(input: {transactionId:$transactionId,tranCode:"SIMPLE",params:{account1:$to,account2:$from,journal:$journalId,amount:$amount,effective:$effective}}) {
+		transactionId
+		created
+	}
+}
+`
+
+func PostSimpleTransfer(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	transactionId uuid.UUID,
+	journalId uuid.UUID,
+	from uuid.UUID,
+	to uuid.UUID,
+	amount Decimal,
+	effective Date,
+) (data_ *PostSimpleTransferResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "PostSimpleTransfer",
+		Query:  PostSimpleTransfer_Operation,
+		Variables: &__PostSimpleTransferInput{
+			TransactionId: transactionId,
+			JournalId:     journalId,
+			From:          from,
+			To:            to,
+			Amount:        amount,
+			Effective:     effective,
+		},
+	}
+
+	data_ = &PostSimpleTransferResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by PostSimpleWithMetadata.
+const PostSimpleWithMetadata_Operation = `
+mutation PostSimpleWithMetadata ($transactionId: UUID!, $effective: Date!, $metadata: JSON!) {
+	postTransaction(input: {transactionId:$transactionId,tranCode:"SIMPLE_META",params:{account1:"1fd1dd3e-33fe-4ef5-9d58-676ef8d306b5",account2:"6c6affb0-5cf5-402b-8d84-01bfc1624a2c",effective:$effective,amount:"1.00",metadata:$metadata}}) {
+		transactionId
+		created
+	}
+}
+`
+
+func PostSimpleWithMetadata(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	transactionId uuid.UUID,
+	effective Date,
+	metadata map[string]interface{},
+) (data_ *PostSimpleWithMetadataResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "ActivityQuery",
-		Query:  ActivityQuery_Operation,
-		Variables: &__ActivityQueryInput{
-			JournalId: journalId,
-			AccountId: accountId,
-			Period:    period,
+		OpName: "PostSimpleWithMetadata",
+		Query:  PostSimpleWithMetadata_Operation,
+		Variables: &__PostSimpleWithMetadataInput{
+			TransactionId: transactionId,
+			Effective:     effective,
+			Metadata:      metadata,
 		},
 	}
 
-	data_ = &ActivityQueryResponse{}
+	data_ = &PostSimpleWithMetadataResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -856,27 +5553,36 @@ func ActivityQuery(
 	return data_, err_
 }
 
-// The mutation executed by CreateActivityIndex.
-const CreateActivityIndex_Operation = `
-mutation CreateActivityIndex {
-	schema {
-		createIndex(input: {name:"activity",on:Entry,partition:[{alias:"journalId",value:"document.journal_id"},{alias:"accountId",value:"document.parent_account_ids+[document.account_id]"},{alias:"settled",value:"string(bool(document.layer == 0))"},{alias:"period",value:"string(date(document.?metadata.?statementDate.orValue(document.?metadata.?effective.orValue(document.created)))).take(7)",type:STRING}],sort:[{alias:"created",value:"document.created",sort:DESC}],constraints:{isNotVoidEntry:"!document.is_void_entry",isNotVoidedEntry:"!document.is_voided_entry"}}) {
-			on
-		}
+// The mutation executed by PostSimpleWithMetadataToJournal.
+const PostSimpleWithMetadataToJournal_Operation = `
+mutation PostSimpleWithMetadataToJournal ($transactionId: UUID!, $journalId: UUID!, $effective: Date!, $metadata: JSON!) {
+	postTransaction(input: {transactionId:$transactionId,tranCode:"SIMPLE_META",params:{account1:"1fd1dd3e-33fe-4ef5-9d58-676ef8d306b5",account2:"6c6affb0-5cf5-402b-8d84-01bfc1624a2c",journal:$journalId,effective:$effective,amount:"1.00",metadata:$metadata}}) {
+		transactionId
+		created
 	}
 }
 `
 
-func CreateActivityIndex(
+func PostSimpleWithMetadataToJournal(
 	ctx_ context.Context,
 	client_ graphql.Client,
-) (data_ *CreateActivityIndexResponse, err_ error) {
+	transactionId uuid.UUID,
+	journalId uuid.UUID,
+	effective Date,
+	metadata map[string]interface{},
+) (data_ *PostSimpleWithMetadataToJournalResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "CreateActivityIndex",
-		Query:  CreateActivityIndex_Operation,
+		OpName: "PostSimpleWithMetadataToJournal",
+		Query:  PostSimpleWithMetadataToJournal_Operation,
+		Variables: &__PostSimpleWithMetadataToJournalInput{
+			TransactionId: transactionId,
+			JournalId:     journalId,
+			Effective:     effective,
+			Metadata:      metadata,
+		},
 	}
 
-	data_ = &CreateActivityIndexResponse{}
+	data_ = &PostSimpleWithMetadataToJournalResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -925,6 +5631,45 @@ func PostTransaction(
 	return data_, err_
 }
 
+// The mutation executed by PostTransactionToJournal.
+const PostTransactionToJournal_Operation = `
+mutation PostTransactionToJournal ($transactionId: UUID!, $journalId: UUID!, $effective: Date!) {
+	postTransaction(input: {transactionId:$transactionId,tranCode:"SIMPLE",params:{account1:"1fd1dd3e-33fe-4ef5-9d58-676ef8d306b5",account2:"6c6affb0-5cf5-402b-8d84-01bfc1624a2c",journal:$journalId,effective:$effective,amount:"1.00"}}) {
+		transactionId
+		created
+	}
+}
+`
+
+func PostTransactionToJournal(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	transactionId uuid.UUID,
+	journalId uuid.UUID,
+	effective Date,
+) (data_ *PostTransactionToJournalResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "PostTransactionToJournal",
+		Query:  PostTransactionToJournal_Operation,
+		Variables: &__PostTransactionToJournalInput{
+			TransactionId: transactionId,
+			JournalId:     journalId,
+			Effective:     effective,
+		},
+	}
+
+	data_ = &PostTransactionToJournalResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
 // The mutation executed by PostTransactionWithStatementDate.
 const PostTransactionWithStatementDate_Operation = `
 mutation PostTransactionWithStatementDate ($transactionId: UUID!, $effective: Date!, $statementDate: Date!) {
@@ -964,13 +5709,137 @@ func PostTransactionWithStatementDate(
 	return data_, err_
 }
 
+// The mutation executed by PostTransfer.
+const PostTransfer_Operation = `
+mutation PostTransfer ($transactionId: UUID!, $tranCode: String!, $from: UUID!, $to: UUID!, $amount: Decimal!, $effective: Date!) {
+	postTransaction(input: {transactionId:$transactionId,tranCode:$tranCode,params:{from:$from,to:$to,amount:$amount,effective:$effective}}) {
+		transactionId
+		created
+	}
+}
+`
+
+func PostTransfer(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	transactionId uuid.UUID,
+	tranCode string,
+	from uuid.UUID,
+	to uuid.UUID,
+	amount Decimal,
+	effective Date,
+) (data_ *PostTransferResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "PostTransfer",
+		Query:  PostTransfer_Operation,
+		Variables: &__PostTransferInput{
+			TransactionId: transactionId,
+			TranCode:      tranCode,
+			From:          from,
+			To:            to,
+			Amount:        amount,
+			Effective:     effective,
+		},
+	}
+
+	data_ = &PostTransferResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by PostTransferInCurrency.
+const PostTransferInCurrency_Operation = `
+mutation PostTransferInCurrency ($transactionId: UUID!, $tranCode: String!, $from: UUID!, $to: UUID!, $amount: Decimal!, $currency: String!, $effective: Date!) {
+	postTransaction(input: {transactionId:$transactionId,tranCode:$tranCode,params:{from:$from,to:$to,amount:$amount,currency:$currency,effective:$effective}}) {
+		transactionId
+		created
+	}
+}
+`
+
+func PostTransferInCurrency(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	transactionId uuid.UUID,
+	tranCode string,
+	from uuid.UUID,
+	to uuid.UUID,
+	amount Decimal,
+	currency string,
+	effective Date,
+) (data_ *PostTransferInCurrencyResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "PostTransferInCurrency",
+		Query:  PostTransferInCurrency_Operation,
+		Variables: &__PostTransferInCurrencyInput{
+			TransactionId: transactionId,
+			TranCode:      tranCode,
+			From:          from,
+			To:            to,
+			Amount:        amount,
+			Currency:      currency,
+			Effective:     effective,
+		},
+	}
+
+	data_ = &PostTransferInCurrencyResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by SchemaReady.
+const SchemaReady_Operation = `
+query SchemaReady {
+	__schema {
+		queryType {
+			name
+		}
+	}
+}
+`
+
+func SchemaReady(
+	ctx_ context.Context,
+	client_ graphql.Client,
+) (data_ *SchemaReadyResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "SchemaReady",
+		Query:  SchemaReady_Operation,
+	}
+
+	data_ = &SchemaReadyResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
 // The mutation executed by Setup.
 const Setup_Operation = `
 mutation Setup ($journalId: UUID!, $tranCodeId: UUID!, $account1Id: UUID!, $account2Id: UUID!) {
 	createJournal(input: {journalId:$journalId,name:"Sample",code:"SAMPLE",config:{enableEffectiveBalances:true}}) {
 		journalId
 	}
-	createTranCode(input: {tranCodeId:$tranCodeId,code:"SIMPLE",description:"simple tran code",params:[{name:"account1",type:UUID,description:"Acct 1"},{name:"account2",type:UUID,description:"Acct 2"},{name:"amount",type:DECIMAL,description:"Decimal amount"},{name:"effective",type:DATE,description:"effective"},{name:"statementDate",type:DATE,description:"statement dates for backdated transactions",default:"1970-01-01"},{name:"currency",type:STRING,description:"Currency",default:"USD"}],vars:{statementDate:"params.statementDate == date('1970-01-01') ? string(params.effective) : string(params.statementDate)"},transaction:{effective:"params.effective",journalId:"uuid('b125f5a0-e803-11f0-a078-069b540ea27c')"},entries:[{accountId:"params.account1",units:"params.amount",currency:"params.currency",entryType:"'SIMPLE_CR'",direction:"CREDIT",layer:"SETTLED",metadata:"{ 'effective':string(params.effective), 'statementDate': vars.statementDate }"},{accountId:"params.account2",units:"params.amount",currency:"params.currency",entryType:"'SIMPLE_DR'",direction:"DEBIT",layer:"SETTLED",metadata:"{ 'effective':string(params.effective), 'statementDate': vars.statementDate }"}]}) {
+	createTranCode(input: {tranCodeId:$tranCodeId,code:"SIMPLE",description:"simple tran code",params:[{name:"account1",type:UUID,description:"Acct 1"},{name:"account2",type:UUID,description:"Acct 2"},{name:"amount",type:DECIMAL,description:"Decimal amount"},{name:"effective",type:DATE,description:"effective"},{name:"statementDate",type:DATE,description:"statement dates for backdated transactions",default:"1970-01-01"},{name:"currency",type:STRING,description:"Currency",default:"USD"},{name:"journal",type:UUID,description:"Journal to post into",default:"b125f5a0-e803-11f0-a078-069b540ea27c"}],vars:{statementDate:"params.statementDate == date('1970-01-01') ? string(params.effective) : string(params.statementDate)"},transaction:{effective:"params.effective",journalId:"params.journal"},entries:[{accountId:"params.account1",units:"params.amount",currency:"params.currency",entryType:"'SIMPLE_CR'",direction:"CREDIT",layer:"SETTLED",metadata:"{ 'effective':string(params.effective), 'statementDate': vars.statementDate }"},{accountId:"params.account2",units:"params.amount",currency:"params.currency",entryType:"'SIMPLE_DR'",direction:"DEBIT",layer:"SETTLED",metadata:"{ 'effective':string(params.effective), 'statementDate': vars.statementDate }"}]}) {
 		tranCodeId
 	}
 	ernie_checking: createAccount(input: {accountId:$account1Id,name:"Ernie Bishop - Checking",code:"ERNIE.CHECKING",description:"Ernie's checking account",normalBalanceType:CREDIT}) {
@@ -1015,6 +5884,110 @@ func Setup(
 	return data_, err_
 }
 
+// The mutation executed by SetupRetailBanking.
+const SetupRetailBanking_Operation = `
+mutation SetupRetailBanking ($journalId: UUID!, $journalCode: String!, $journalExpr: Expression!, $transferId: UUID!, $transferCode: String!, $checkingId: UUID!, $checkingCode: String!, $savingsId: UUID!, $savingsCode: String!, $cashId: UUID!, $cashCode: String!) {
+	createJournal(input: {journalId:$journalId,name:"Retail Banking",code:$journalCode,config:{enableEffectiveBalances:true}}) {
+		journalId
+	}
+	transfer: createTranCode(input: {tranCodeId:$transferId,code:$transferCode,description:"move funds between two accounts",params:[{name:"from",type:UUID,description:"Debited account"},{name:"to",type:UUID,description:"Credited account"},{name:"amount",type:DECIMAL,description:"Decimal amount"},{name:"effective",type:DATE,description:"effective"},{name:"currency",type:STRING,description:"Currency",default:"USD"},{name:"layer",type:STRING,description:"Ledger layer",default:"SETTLED"}],transaction:{effective:"params.effective",journalId:$journalExpr},entries:[{accountId:"params.from",units:"params.amount",currency:"params.currency",entryType:"'TRANSFER_DR'",direction:"DEBIT",layer:"params.layer",metadata:"{ 'effective': string(params.effective), 'statementDate': string(params.effective) }"},{accountId:"params.to",units:"params.amount",currency:"params.currency",entryType:"'TRANSFER_CR'",direction:"CREDIT",layer:"params.layer",metadata:"{ 'effective': string(params.effective), 'statementDate': string(params.effective) }"}]}) {
+		tranCodeId
+	}
+	checking: createAccount(input: {accountId:$checkingId,name:"Customer - Checking",code:$checkingCode,normalBalanceType:CREDIT}) {
+		accountId
+	}
+	savings: createAccount(input: {accountId:$savingsId,name:"Customer - Savings",code:$savingsCode,normalBalanceType:CREDIT}) {
+		accountId
+	}
+	cash: createAccount(input: {accountId:$cashId,name:"Bank - Cash",code:$cashCode,normalBalanceType:DEBIT}) {
+		accountId
+	}
+}
+`
+
+func SetupRetailBanking(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	journalId uuid.UUID,
+	journalCode string,
+	journalExpr string,
+	transferId uuid.UUID,
+	transferCode string,
+	checkingId uuid.UUID,
+	checkingCode string,
+	savingsId uuid.UUID,
+	savingsCode string,
+	cashId uuid.UUID,
+	cashCode string,
+) (data_ *SetupRetailBankingResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "SetupRetailBanking",
+		Query:  SetupRetailBanking_Operation,
+		Variables: &__SetupRetailBankingInput{
+			JournalId:    journalId,
+			JournalCode:  journalCode,
+			JournalExpr:  journalExpr,
+			TransferId:   transferId,
+			TransferCode: transferCode,
+			CheckingId:   checkingId,
+			CheckingCode: checkingCode,
+			SavingsId:    savingsId,
+			SavingsCode:  savingsCode,
+			CashId:       cashId,
+			CashCode:     cashCode,
+		},
+	}
+
+	data_ = &SetupRetailBankingResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by SetupSimpleMeta.
+const SetupSimpleMeta_Operation = `
+mutation SetupSimpleMeta ($tranCodeId: UUID!) {
+	createTranCode(input: {tranCodeId:$tranCodeId,code:"SIMPLE_META",description:"simple tran code with caller-supplied entry metadata",params:[{name:"account1",type:UUID,description:"Acct 1"},{name:"account2",type:UUID,description:"Acct 2"},{name:"amount",type:DECIMAL,description:"Decimal amount"},{name:"effective",type:DATE,description:"effective"},{name:"metadata",type:JSON,description:"entry metadata"},{name:"journal",type:UUID,description:"Journal to post into",default:"b125f5a0-e803-11f0-a078-069b540ea27c"}],transaction:{effective:"params.effective",journalId:"params.journal"},entries:[{accountId:"params.account1",units:"params.amount",currency:"'USD'",entryType:"'SIMPLE_CR'",direction:"CREDIT",layer:"SETTLED",metadata:"params.metadata"},{accountId:"params.account2",units:"params.amount",currency:"'USD'",entryType:"'SIMPLE_DR'",direction:"DEBIT",layer:"SETTLED",metadata:"params.metadata"}]}) {
+		tranCodeId
+	}
+}
+`
+
+// SetupSimpleMeta creates SIMPLE_META, the variant of SIMPLE whose entries
+// carry caller-supplied metadata, for PostSimpleWithMetadata and
+// PostSimpleWithMetadataToJournal. Like SIMPLE, it posts into the Setup
+// journal unless given another. Run it after Setup.
+func SetupSimpleMeta(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	tranCodeId uuid.UUID,
+) (data_ *SetupSimpleMetaResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "SetupSimpleMeta",
+		Query:  SetupSimpleMeta_Operation,
+		Variables: &__SetupSimpleMetaInput{
+			TranCodeId: tranCodeId,
+		},
+	}
+
+	data_ = &SetupSimpleMetaResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
 // The query executed by StatementBalance.
 const StatementBalance_Operation = `
 query StatementBalance ($accountID: UUID!, $journalID: UUID!, $openDate: Date!, $closeDate: Date!, $priorPeriodCloseStamp: String!, $thisPeriodCloseStamp: String!) {
@@ -1091,3 +6064,112 @@ func StatementBalance(
 
 	return data_, err_
 }
+
+// The mutation executed by TouchJournal.
+const TouchJournal_Operation = `
+mutation TouchJournal ($journalId: UUID!, $description: String!) {
+	updateJournal(id: $journalId, input: {description:$description}) {
+		modified
+		version
+	}
+}
+`
+
+func TouchJournal(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	journalId uuid.UUID,
+	description string,
+) (data_ *TouchJournalResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "TouchJournal",
+		Query:  TouchJournal_Operation,
+		Variables: &__TouchJournalInput{
+			JournalId:   journalId,
+			Description: description,
+		},
+	}
+
+	data_ = &TouchJournalResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by TranCodeLockStatus.
+const TranCodeLockStatus_Operation = `
+query TranCodeLockStatus ($id: UUID!) {
+	tranCode(id: $id) {
+		tranCodeId
+		status
+	}
+}
+`
+
+func TranCodeLockStatus(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id uuid.UUID,
+) (data_ *TranCodeLockStatusResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "TranCodeLockStatus",
+		Query:  TranCodeLockStatus_Operation,
+		Variables: &__TranCodeLockStatusInput{
+			Id: id,
+		},
+	}
+
+	data_ = &TranCodeLockStatusResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by UpdateAccountMetadata.
+const UpdateAccountMetadata_Operation = `
+mutation UpdateAccountMetadata ($accountId: UUID!, $metadata: JSON!) {
+	updateAccount(id: $accountId, input: {metadata:$metadata}) {
+		accountId
+		metadata
+	}
+}
+`
+
+func UpdateAccountMetadata(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	accountId uuid.UUID,
+	metadata map[string]interface{},
+) (data_ *UpdateAccountMetadataResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "UpdateAccountMetadata",
+		Query:  UpdateAccountMetadata_Operation,
+		Variables: &__UpdateAccountMetadataInput{
+			AccountId: accountId,
+			Metadata:  metadata,
+		},
+	}
+
+	data_ = &UpdateAccountMetadataResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
@@ -0,0 +1,114 @@
+package eff
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// goldenDir is where golden files live, relative to the package under test.
+var goldenDir = "testdata"
+
+// RequireActivityGolden compares resp against testdata/<name>.golden.json.
+// Connection nodes are sorted before comparison so ordering differences
+// between equal entries don't fail the assertion. Run the tests with
+// -update to (re)write the golden file from resp; the calling test package
+// defines that flag, as this package's own tests do.
+func RequireActivityGolden(tb testing.TB, resp *ActivityQueryResponse, name string) {
+	tb.Helper()
+
+	got, err := normalizeNodes(resp)
+	require.NoError(tb, err, "normalizing activity response")
+
+	path := filepath.Join(goldenDir, name+".golden.json")
+	if goldenUpdate() {
+		require.NoError(tb, os.MkdirAll(goldenDir, 0o755))
+		require.NoError(tb, os.WriteFile(path, append(got, '\n'), 0o644))
+	}
+
+	want, err := os.ReadFile(path)
+	require.NoError(tb, err, "reading golden file (run with -update to create it)")
+	require.JSONEq(tb, string(want), string(got))
+}
+
+// goldenUpdate reports whether the test binary was run with -update. The
+// flag is looked up rather than registered here so importing eff doesn't
+// claim the name for every consumer.
+func goldenUpdate() bool {
+	f := flag.Lookup("update")
+	if f == nil {
+		return false
+	}
+	g, ok := f.Value.(flag.Getter)
+	if !ok {
+		return false
+	}
+	update, _ := g.Get().(bool)
+	return update
+}
+
+// normalizeNodes marshals v and sorts every "nodes" array by the JSON
+// encoding of its elements, returning indented JSON.
+func normalizeNodes(v any) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var doc any
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+	if err := sortNodes(doc); err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+func sortNodes(v any) error {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, child := range v {
+			if err := sortNodes(child); err != nil {
+				return err
+			}
+			if nodes, ok := child.([]any); ok && key == "nodes" {
+				if err := sortByEncoding(nodes); err != nil {
+					return err
+				}
+			}
+		}
+	case []any:
+		for _, child := range v {
+			if err := sortNodes(child); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func sortByEncoding(nodes []any) error {
+	type keyed struct {
+		key  string
+		node any
+	}
+	ks := make([]keyed, len(nodes))
+	for i, n := range nodes {
+		b, err := json.Marshal(n)
+		if err != nil {
+			return err
+		}
+		ks[i] = keyed{string(b), n}
+	}
+	slices.SortStableFunc(ks, func(a, b keyed) int { return strings.Compare(a.key, b.key) })
+	for i := range ks {
+		nodes[i] = ks[i].node
+	}
+	return nil
+}
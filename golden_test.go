@@ -0,0 +1,38 @@
+package eff
+
+import (
+	"flag"
+	"slices"
+	"testing"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite golden files under testdata/")
+
+func TestRequireActivityGolden(t *testing.T) {
+	dir := goldenDir
+	goldenDir = t.TempDir()
+	t.Cleanup(func() { goldenDir = dir })
+
+	node := func(effective, units string) *ActivityQueryEntriesEntryConnectionNodesEntry {
+		return &ActivityQueryEntriesEntryConnectionNodesEntry{
+			Metadata: Ptr(map[string]any{"effective": effective, "statementDate": effective}),
+			Amount:   ActivityQueryEntriesEntryConnectionNodesEntryAmountMoney{Units: Decimal(units)},
+		}
+	}
+	resp := &ActivityQueryResponse{
+		Entries: ActivityQueryEntriesEntryConnection{
+			Nodes: []*ActivityQueryEntriesEntryConnectionNodesEntry{
+				node("2026-01-31", "1.00"),
+				node("2026-01-15", "1.00"),
+				node("2026-01-01", "1.00"),
+			},
+		},
+	}
+
+	*updateGolden = true
+	RequireActivityGolden(t, resp, "activity_jan")
+	*updateGolden = false
+
+	slices.Reverse(resp.Entries.Nodes)
+	RequireActivityGolden(t, resp, "activity_jan")
+}
@@ -0,0 +1,88 @@
+package eff
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// IdempotencyHeader carries a mutation's idempotency key. Every attempt of
+// one operation, including retries by the client's transport, sends the same
+// key, so a server or gateway that remembers keys can apply the mutation
+// once and replay its response to the retry.
+//
+// Twisp itself dedupes by record ID rather than by this header: creating a
+// transaction, account, journal, tran code or account set with an ID that
+// already exists fails instead of creating a second record, so a retried
+// create is applied at most once. Updates, deletes, voids and schema
+// mutations have no such guard and rely on the header being honored by
+// whatever sits in front of Twisp.
+const IdempotencyHeader = "Idempotency-Key"
+
+type idempotencyKey struct{}
+
+// WithIdempotency returns a context whose mutations carry key as their
+// IdempotencyHeader. Use a key that identifies the intended change, e.g. a
+// request ID from the caller, and a fresh one for each distinct change;
+// operations that share a key may be treated as one. Without it, each
+// mutation gets a random key of its own.
+func WithIdempotency(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKey{}, key)
+}
+
+// IdempotencyKey returns the key set on ctx by WithIdempotency.
+func IdempotencyKey(ctx context.Context) (string, bool) {
+	key, ok := ctx.Value(idempotencyKey{}).(string)
+	return key, ok && key != ""
+}
+
+// idempotentAs sets key on ctx for a mutation wrapper that has a natural
+// key, such as the ID of the record it creates, unless the caller already
+// chose one.
+func idempotentAs(ctx context.Context, key string) context.Context {
+	if _, ok := IdempotencyKey(ctx); ok {
+		return ctx
+	}
+	return WithIdempotency(ctx, key)
+}
+
+// idempotencyTransport stamps every mutation with an IdempotencyHeader. It
+// sits outside the retry transport, so the key it picks is shared by all
+// attempts.
+type idempotencyTransport struct {
+	base http.RoundTripper
+}
+
+func (t *idempotencyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get(IdempotencyHeader) != "" || !isMutation(req) {
+		return t.base.RoundTrip(req)
+	}
+	key, ok := IdempotencyKey(req.Context())
+	if !ok {
+		key = uuid.NewString()
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set(IdempotencyHeader, key)
+	return t.base.RoundTrip(req)
+}
+
+// isMutation reports whether req's GraphQL document is a mutation.
+func isMutation(req *http.Request) bool {
+	if req.Body == nil || req.GetBody == nil {
+		return false
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return false
+	}
+	defer body.Close()
+	var gqlReq struct {
+		Query string `json:"query"`
+	}
+	if err := json.NewDecoder(body).Decode(&gqlReq); err != nil {
+		return false
+	}
+	return operationType(gqlReq.Query) == "mutation"
+}
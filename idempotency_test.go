@@ -0,0 +1,126 @@
+package eff
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// dedupeServer applies each mutation once per idempotency key and replays
+// the stored response to later attempts with the same key. With dropFirst
+// set it resets the connection after applying the first mutation, as if the
+// response were lost, so the client retries.
+type dedupeServer struct {
+	respond   func(key string) string
+	dropFirst bool
+
+	mu      sync.Mutex
+	applied int
+	keys    []string
+	seen    map[string]bool
+}
+
+func (s *dedupeServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	io.Copy(io.Discard, r.Body)
+	key := r.Header.Get(IdempotencyHeader)
+
+	s.mu.Lock()
+	s.keys = append(s.keys, key)
+	replay := key != "" && s.seen[key]
+	if !replay {
+		s.applied++
+		s.seen[key] = true
+	}
+	drop := s.dropFirst && s.applied == 1 && !replay
+	s.mu.Unlock()
+
+	if drop {
+		conn, _, err := http.NewResponseController(w).Hijack()
+		if err != nil {
+			panic(err)
+		}
+		// A zero linger turns Close into a reset, which the client retries.
+		conn.(*net.TCPConn).SetLinger(0)
+		conn.Close()
+		return
+	}
+	fmt.Fprint(w, s.respond(key))
+}
+
+func (s *dedupeServer) snapshot() (int, []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.applied, append([]string(nil), s.keys...)
+}
+
+func TestIdempotencyAcrossRetries(t *testing.T) {
+	stub := &dedupeServer{
+		respond: func(string) string {
+			return `{"data": {"deleteJournal": {"journalId": "` + journalID.String() + `"}}}`
+		},
+		dropFirst: true,
+		seen:      map[string]bool{},
+	}
+	srv := httptest.NewServer(stub)
+	t.Cleanup(srv.Close)
+
+	client := (&TwispContainer{GraphQLEndpoint: srv.URL}).NewGraphQLClient(tenantHeader())
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := LockJournal(WithIdempotency(ctx, "lock-journal-1"), client, journalID)
+	require.NoError(t, err)
+	applied, keys := stub.snapshot()
+	require.Equal(t, 1, applied, "the retry must not apply the mutation again")
+	require.Equal(t, []string{"lock-journal-1", "lock-journal-1"}, keys)
+
+	// Without a caller key each operation gets its own.
+	_, err = LockJournal(ctx, client, journalID)
+	require.NoError(t, err)
+	_, err = LockJournal(ctx, client, journalID)
+	require.NoError(t, err)
+	applied, keys = stub.snapshot()
+	require.Equal(t, 3, applied)
+	require.NotEmpty(t, keys[2])
+	require.NotEqual(t, keys[2], keys[3])
+
+	// Queries carry no key.
+	GetJournal(ctx, client, journalID)
+	_, keys = stub.snapshot()
+	require.Empty(t, keys[4])
+}
+
+func TestTransferIdempotencyKey(t *testing.T) {
+	stub := &dedupeServer{
+		// Echo the posted transaction ID back.
+		respond: func(key string) string {
+			return `{"data": {"postTransaction": {"transactionId": "` + strings.TrimPrefix(key, "transfer:") + `"}}}`
+		},
+		seen: map[string]bool{},
+	}
+	srv := httptest.NewServer(stub)
+	t.Cleanup(srv.Close)
+
+	client := (&TwispContainer{GraphQLEndpoint: srv.URL}).NewGraphQLClient(tenantHeader())
+	txID, err := Transfer(context.Background(), client, journalID, account2ID, account1ID, "5.00", NewDate(2026, time.January, 15))
+	require.NoError(t, err)
+	_, keys := stub.snapshot()
+	require.Equal(t, []string{"transfer:" + txID.String()}, keys)
+
+	// A caller's key wins over the derived one.
+	ctx := WithIdempotency(context.Background(), "transfer:"+uuid.Nil.String())
+	_, err = Transfer(ctx, client, journalID, account2ID, account1ID, "5.00", NewDate(2026, time.January, 15))
+	require.NoError(t, err)
+	_, keys = stub.snapshot()
+	require.Equal(t, "transfer:"+uuid.Nil.String(), keys[1])
+}
@@ -0,0 +1,72 @@
+package eff
+
+import (
+	"encoding/binary"
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+
+	"github.com/google/uuid"
+)
+
+// IDSource is the package's source of IDs for the records its helpers
+// create, such as RetailBankingJournal's accounts or Transfer's
+// transaction.
+type IDSource interface {
+	NewID() UUID
+}
+
+var idSource atomic.Pointer[IDSource]
+
+// WithIDSource makes the package take new IDs from s instead of generating
+// random ones, and returns a func that restores random generation:
+//
+//	t.Cleanup(eff.WithIDSource(eff.DeterministicIDSource(1)))
+//
+// Like WithClock it is package-wide, so tests that replace it must not run
+// in parallel with tests that create records, and it panics while another
+// source is installed.
+func WithIDSource(s IDSource) (restore func()) {
+	installed := &s
+	if !idSource.CompareAndSwap(nil, installed) {
+		panic("eff: WithIDSource called while another ID source is installed; the package ID source can't be replaced by parallel tests")
+	}
+	return func() { idSource.CompareAndSwap(installed, nil) }
+}
+
+// nextID returns the next ID from the package IDSource, or fallback() if
+// none is set.
+func nextID(fallback func() UUID) UUID {
+	if s := idSource.Load(); s != nil {
+		return (*s).NewID()
+	}
+	return fallback()
+}
+
+// newRandomID returns the next ID for helpers that default to random v4
+// IDs.
+func newRandomID() UUID {
+	return nextID(uuid.New)
+}
+
+// DeterministicIDSource returns an IDSource whose sequence of v4 UUIDs is
+// fixed by seed, so a failing run's IDs are the same when it is replayed.
+// It is safe for concurrent use, but concurrent callers get the IDs in
+// whatever order they ask, so only sequential creation is reproducible.
+func DeterministicIDSource(seed int64) IDSource {
+	var key [32]byte
+	binary.BigEndian.PutUint64(key[:], uint64(seed))
+	return &deterministicIDs{rng: rand.NewChaCha8(key)}
+}
+
+type deterministicIDs struct {
+	mu  sync.Mutex
+	rng *rand.ChaCha8
+}
+
+func (s *deterministicIDs) NewID() UUID {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// ChaCha8's Read never fails.
+	return uuid.Must(uuid.NewRandomFromReader(s.rng))
+}
@@ -0,0 +1,45 @@
+package eff
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeterministicIDSource(t *testing.T) {
+	run := func(seed int64) []UUID {
+		restore := WithIDSource(DeterministicIDSource(seed))
+		defer restore()
+		ids := []UUID{NewID(), newRandomID()}
+		for range 3 {
+			ids = append(ids, NewID())
+		}
+		return ids
+	}
+
+	first, second := run(42), run(42)
+	require.Equal(t, first, second)
+	require.Len(t, uniq(first), len(first))
+	for _, id := range first {
+		require.Equal(t, uuid.Version(4), id.Version())
+		require.Equal(t, uuid.RFC4122, id.Variant())
+	}
+	require.NotEqual(t, first, run(43))
+
+	restore := WithIDSource(DeterministicIDSource(1))
+	require.Panics(t, func() { WithIDSource(DeterministicIDSource(2)) }, "only one source at a time")
+	restore()
+
+	// Restored, NewID is random and time-ordered again.
+	require.Equal(t, uuid.Version(7), NewID().Version())
+	require.Equal(t, uuid.Version(4), newRandomID().Version())
+}
+
+func uniq(ids []UUID) map[UUID]bool {
+	m := make(map[UUID]bool, len(ids))
+	for _, id := range ids {
+		m[id] = true
+	}
+	return m
+}
@@ -0,0 +1,76 @@
+package eff
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+// IndexKey is one partition or sort key of a custom index. Value is a CEL
+// expression over document, e.g. "document.metadata.statementDate". Type
+// is optional and lets Twisp infer it when empty. Order only applies to
+// sort keys and defaults to ascending.
+type IndexKey struct {
+	Alias string
+	Value Expression
+	Type  IndexDataType
+	Order SortOrder
+}
+
+// IndexSpec declares a custom index for CreateIndex.
+type IndexSpec struct {
+	Name      string
+	On        IndexOnEnum
+	Partition []IndexKey
+	Sort      []IndexKey
+	// Constraints are named CEL expressions that must all be true for a
+	// record to be indexed.
+	Constraints ExpressionMap
+}
+
+// IndexDescriptor is the index as stored by Twisp.
+type IndexDescriptor = CreateCustomIndexSchemaSchemaMutationCreateIndex
+
+// CreateIndex creates the custom index described by spec and returns it as
+// Twisp reports it. Query it with CustomIndexEntries.
+func CreateIndex(ctx context.Context, client graphql.Client, spec IndexSpec) (*IndexDescriptor, error) {
+	if spec.Name == "" || len(spec.Partition) == 0 {
+		return nil, errors.New("creating index: name and at least one partition key are required")
+	}
+
+	input := CreateIndexInput{
+		Name:      spec.Name,
+		On:        spec.On,
+		Partition: make([]*PartitionKeyInput, len(spec.Partition)),
+		Sort:      make([]*IndexKeyInput, len(spec.Sort)),
+	}
+	for i, k := range spec.Partition {
+		input.Partition[i] = &PartitionKeyInput{Alias: k.Alias, Value: k.Value, Type: indexDataType(k.Type)}
+	}
+	for i, k := range spec.Sort {
+		order := k.Order
+		if order == "" {
+			order = SortOrderAsc
+		}
+		input.Sort[i] = &IndexKeyInput{Alias: k.Alias, Value: k.Value, Sort: order, Type: indexDataType(k.Type)}
+	}
+	if spec.Constraints != nil {
+		input.Constraints = &spec.Constraints
+	}
+
+	resp, err := CreateCustomIndex(ctx, client, input)
+	if err != nil {
+		return nil, fmt.Errorf("creating index %s: %w", spec.Name, err)
+	}
+	return &resp.Schema.CreateIndex, nil
+}
+
+// indexDataType maps the zero value to nil so Twisp infers the type.
+func indexDataType(t IndexDataType) *IndexDataType {
+	if t == "" {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,84 @@
+package eff
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateIndexInput(t *testing.T) {
+	var got CreateIndexInput
+	stub := clientFunc(func(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+		got = req.Variables.(*__CreateCustomIndexInput).Input
+		return json.Unmarshal([]byte(`{"schema": {"createIndex": {"name": "byStatementDate", "on": "Entry"}}}`), resp.Data)
+	})
+
+	idx, err := CreateIndex(context.Background(), stub, IndexSpec{
+		Name:      "byStatementDate",
+		On:        IndexOnEnumEntry,
+		Partition: []IndexKey{{Alias: "accountId", Value: "document.account_id"}},
+		Sort:      []IndexKey{{Alias: "statementDate", Value: "document.metadata.statementDate", Type: IndexDataTypeString}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "byStatementDate", idx.Name)
+
+	require.Nil(t, got.Partition[0].Type)
+	require.Equal(t, SortOrderAsc, got.Sort[0].Sort)
+	require.Equal(t, IndexDataTypeString, *got.Sort[0].Type)
+	require.Nil(t, got.Constraints)
+
+	_, err = CreateIndex(context.Background(), stub, IndexSpec{Name: "empty", On: IndexOnEnumEntry})
+	require.Error(t, err)
+}
+
+func TestCreateIndex(t *testing.T) {
+	ctx, client := newLiveClient(t)
+
+	idx, err := CreateIndex(ctx, client, IndexSpec{
+		Name:      "byStatementDate",
+		On:        IndexOnEnumEntry,
+		Partition: []IndexKey{{Alias: "accountId", Value: "string(document.account_id)", Type: IndexDataTypeString}},
+		Sort: []IndexKey{{
+			Alias: "statementDate",
+			Value: "string(document.metadata.statementDate)",
+			Type:  IndexDataTypeString,
+		}},
+		Constraints: ExpressionMap{"hasStatementDate": "has(document.metadata.statementDate)"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "byStatementDate", idx.Name)
+	require.Equal(t, IndexOnEnumEntry, idx.On)
+	require.Len(t, idx.Range, 1)
+	require.Equal(t, SortOrderAsc, idx.Range[0].Sort)
+
+	_, err = Setup(ctx, client, journalID, tranCodeID, account1ID, account2ID)
+	require.NoError(t, err)
+	_, err = SetupSimpleMeta(ctx, client, simpleMetaTranCodeID)
+	require.NoError(t, err)
+	for _, day := range []int{15, 5, 10} {
+		_, err = PostTransactionWithMetadata(ctx, client, uuid.New(), NewDate(2026, time.January, day), nil)
+		require.NoError(t, err)
+	}
+
+	statementDates := func(sort []CustomIndexFilterValue) []any {
+		resp, err := CustomIndexEntries(ctx, client, "byStatementDate",
+			[]CustomIndexFilterValue{{Alias: "accountId", Value: &FilterValue{Eq: Ptr(account1ID.String())}}},
+			sort, 100)
+		require.NoError(t, err)
+		var dates []any
+		for _, n := range resp.Entries.Nodes {
+			dates = append(dates, (*n.Metadata)["statementDate"])
+		}
+		return dates
+	}
+
+	require.Equal(t, []any{"2026-01-05", "2026-01-10", "2026-01-15"}, statementDates(nil))
+	require.Equal(t, []any{"2026-01-10", "2026-01-15"}, statementDates([]CustomIndexFilterValue{
+		{Alias: "statementDate", Value: &FilterValue{Gte: Ptr("2026-01-08")}},
+	}))
+}
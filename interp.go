@@ -0,0 +1,122 @@
+package eff
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Interp builds an InterpolatedExpression, the "text {{cel}}" templates
+// used for tran code fields such as descriptions, from literal text,
+// parameter references and CEL expressions. Build validates the result.
+//
+//	desc, err := NewInterp("from", "to").
+//		Lit("transfer from ").Param("from").Lit(" to ").Param("to").
+//		Build()
+type Interp struct {
+	params []string
+	parts  []string
+	errs   []error
+}
+
+// NewInterp starts an expression that may reference the tran code params
+// named in params.
+func NewInterp(params ...string) *Interp {
+	return &Interp{params: params}
+}
+
+var (
+	identRE    = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	paramRefRE = regexp.MustCompile(`\bparams\.([A-Za-z_][A-Za-z0-9_]*)`)
+)
+
+// Lit appends literal text. Text containing "{{" or "}}" is rejected, as
+// the template syntax has no escape for it.
+func (b *Interp) Lit(text string) *Interp {
+	if strings.Contains(text, "{{") || strings.Contains(text, "}}") {
+		b.errs = append(b.errs, fmt.Errorf("literal %q contains template delimiters", text))
+	}
+	b.parts = append(b.parts, text)
+	return b
+}
+
+// Param appends the value of params.<name>.
+func (b *Interp) Param(name string) *Interp {
+	if !identRE.MatchString(name) {
+		b.errs = append(b.errs, fmt.Errorf("invalid param name %q", name))
+	} else if !slices.Contains(b.params, name) {
+		b.errs = append(b.errs, fmt.Errorf("unknown param %q", name))
+	}
+	b.parts = append(b.parts, "{{params."+name+"}}")
+	return b
+}
+
+// Expr appends the value of a CEL expression, e.g. "string(params.amount)".
+// Every params.<name> it references must be declared, and its brackets and
+// quotes must balance.
+func (b *Interp) Expr(cel string) *Interp {
+	if err := checkCEL(cel); err != nil {
+		b.errs = append(b.errs, fmt.Errorf("expression %q: %w", cel, err))
+	}
+	for _, m := range paramRefRE.FindAllStringSubmatch(cel, -1) {
+		if !slices.Contains(b.params, m[1]) {
+			b.errs = append(b.errs, fmt.Errorf("expression %q: unknown param %q", cel, m[1]))
+		}
+	}
+	b.parts = append(b.parts, "{{"+cel+"}}")
+	return b
+}
+
+// Build returns the expression, or every problem found while building it.
+func (b *Interp) Build() (InterpolatedExpression, error) {
+	if len(b.errs) > 0 {
+		return "", fmt.Errorf("building interpolated expression: %w", errors.Join(b.errs...))
+	}
+	return strings.Join(b.parts, ""), nil
+}
+
+// checkCEL checks that cel is non-empty, balances (), [] and {} outside
+// string literals, closes its string literals and contains no "}}".
+func checkCEL(cel string) error {
+	if strings.TrimSpace(cel) == "" {
+		return errors.New("empty")
+	}
+	if strings.Contains(cel, "}}") {
+		return errors.New(`contains "}}"`)
+	}
+	var stack []rune
+	var quote rune
+	escaped := false
+	for _, r := range cel {
+		switch {
+		case quote != 0:
+			switch {
+			case escaped:
+				escaped = false
+			case r == '\\':
+				escaped = true
+			case r == quote:
+				quote = 0
+			}
+		case r == '\'' || r == '"':
+			quote = r
+		case r == '(' || r == '[' || r == '{':
+			stack = append(stack, r)
+		case r == ')' || r == ']' || r == '}':
+			open := map[rune]rune{')': '(', ']': '[', '}': '{'}[r]
+			if len(stack) == 0 || stack[len(stack)-1] != open {
+				return fmt.Errorf("unbalanced %q", r)
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+	if quote != 0 {
+		return errors.New("unterminated string literal")
+	}
+	if len(stack) > 0 {
+		return fmt.Errorf("unclosed %q", stack[len(stack)-1])
+	}
+	return nil
+}
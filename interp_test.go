@@ -0,0 +1,50 @@
+package eff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInterp(t *testing.T) {
+	got, err := NewInterp("from", "to", "amount").
+		Lit("transfer ").Expr("string(params.amount)").
+		Lit(" from ").Param("from").
+		Lit(" to ").Param("to").
+		Lit(" on ").Expr("string(date(time.Now()))").
+		Build()
+	require.NoError(t, err)
+	require.Equal(t,
+		"transfer {{string(params.amount)}} from {{params.from}} to {{params.to}} on {{string(date(time.Now()))}}",
+		got)
+}
+
+func TestInterpRejects(t *testing.T) {
+	for name, b := range map[string]*Interp{
+		"unknown param":        NewInterp("from").Param("to"),
+		"invalid param name":   NewInterp("a b").Param("a b"),
+		"unbalanced paren":     NewInterp("amount").Expr("string(params.amount"),
+		"mismatched bracket":   NewInterp().Expr("[1, 2)"),
+		"unterminated string":  NewInterp().Expr("'abc"),
+		"closing delimiter":    NewInterp().Expr("{'a': 1}}"),
+		"delimiter in literal": NewInterp().Lit("{{uuid.New()}}"),
+		"empty expression":     NewInterp().Expr(" "),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := b.Build()
+			require.Error(t, err)
+		})
+	}
+
+	_, err := NewInterp("amount").Expr("has(params.memo) ? 'memo' : ''").Build()
+	require.ErrorContains(t, err, `unknown param "memo"`)
+
+	// Brackets and quotes inside string literals don't count.
+	_, err = NewInterp().Expr(`"(" + ')' + "\"[" + 'memo: }'`).Build()
+	require.NoError(t, err)
+
+	// Every problem is reported.
+	_, err = NewInterp().Param("a").Param("b").Build()
+	require.ErrorContains(t, err, `unknown param "a"`)
+	require.ErrorContains(t, err, `unknown param "b"`)
+}
@@ -0,0 +1,46 @@
+package eff
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// ErrNotFound is returned when a record looked up by ID does not exist.
+var ErrNotFound = errors.New("not found")
+
+// Journal is a journal's configuration as returned by GetJournal.
+type Journal = FetchJournalJournal
+
+// GetJournal reads back the journal with the given ID, including its
+// config flags. It returns ErrNotFound if there is no such journal.
+func GetJournal(ctx context.Context, client graphql.Client, id uuid.UUID) (*Journal, error) {
+	resp, err := FetchJournal(ctx, client, id)
+	if isNotFound(err) || (err == nil && resp.Journal == nil) {
+		return nil, fmt.Errorf("getting journal %s: %w", id, ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting journal %s: %w", id, err)
+	}
+	return resp.Journal, nil
+}
+
+// isNotFound reports whether err carries a GraphQL "not found" error, which
+// Twisp returns for some lookups instead of a null result.
+func isNotFound(err error) bool {
+	var gqlErrs gqlerror.List
+	if !errors.As(err, &gqlErrs) {
+		return false
+	}
+	for _, e := range gqlErrs {
+		if strings.Contains(strings.ToLower(e.Message), "not found") {
+			return true
+		}
+	}
+	return false
+}
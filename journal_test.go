@@ -0,0 +1,51 @@
+package eff
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func TestGetJournalNotFound(t *testing.T) {
+	null := clientFunc(func(_ context.Context, _ *graphql.Request, resp *graphql.Response) error {
+		return json.Unmarshal([]byte(`{"journal": null}`), resp.Data)
+	})
+	_, err := GetJournal(context.Background(), null, uuid.New())
+	require.ErrorIs(t, err, ErrNotFound)
+
+	gqlErr := clientFunc(func(context.Context, *graphql.Request, *graphql.Response) error {
+		return gqlerror.List{{Message: "Journal not found"}}
+	})
+	_, err = GetJournal(context.Background(), gqlErr, uuid.New())
+	require.ErrorIs(t, err, ErrNotFound)
+
+	other := clientFunc(func(context.Context, *graphql.Request, *graphql.Response) error {
+		return gqlerror.List{{Message: "permission denied"}}
+	})
+	_, err = GetJournal(context.Background(), other, uuid.New())
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrNotFound)
+}
+
+func TestGetJournal(t *testing.T) {
+	ctx, client := newLiveClient(t)
+
+	_, err := Setup(ctx, client, journalID, tranCodeID, account1ID, account2ID)
+	require.NoError(t, err)
+
+	journal, err := GetJournal(ctx, client, journalID)
+	require.NoError(t, err)
+	require.Equal(t, journalID, journal.JournalId)
+	require.Equal(t, "Sample", journal.Name)
+	require.Equal(t, "SAMPLE", *journal.Code)
+	require.Equal(t, StatusActive, journal.Status)
+	require.True(t, journal.Config.EnableEffectiveBalances)
+
+	_, err = GetJournal(ctx, client, uuid.New())
+	require.ErrorIs(t, err, ErrNotFound)
+}
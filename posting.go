@@ -0,0 +1,100 @@
+package eff
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+)
+
+// PostTransactionWithMetadata posts the same 1.00 Ernie/Bert transaction as
+// PostTransaction, attaching metadata to both entries so it shows up on
+// ActivityQuery nodes. The "effective" and "statementDate" keys that the
+// activity index relies on are always set from effective; values supplied
+// for them in metadata are ignored. metadata itself is not modified. It
+// posts with the SIMPLE_META tran code, which SetupSimpleMeta creates.
+func PostTransactionWithMetadata(ctx context.Context, client graphql.Client, txID uuid.UUID, effective Date, metadata map[string]any) (*PostSimpleWithMetadataResponse, error) {
+	return PostSimpleWithMetadata(ctx, client, txID, effective, activityMetadata(effective, metadata))
+}
+
+// activityMetadata returns a copy of metadata with its "effective" and
+// "statementDate" keys set from effective.
+func activityMetadata(effective Date, metadata map[string]any) map[string]any {
+	merged := make(map[string]any, len(metadata)+2)
+	maps.Copy(merged, metadata)
+	day := effective.Format("2006-01-02")
+	merged["effective"] = day
+	merged["statementDate"] = day
+	return merged
+}
+
+// WithMetadataValidation makes the client check the metadata of every
+// transaction it posts with ValidateActivityMetadata, failing the post
+// before it is sent if the metadata is malformed. It covers the post
+// helpers and direct calls such as PostSimpleWithMetadata alike.
+func WithMetadataValidation() ClientOption {
+	return func(c *clientConfig) { c.validateMetadata = true }
+}
+
+func withMetadataValidation(client graphql.Client, enabled bool) graphql.Client {
+	if !enabled {
+		return client
+	}
+	return metadataValidationClient{client}
+}
+
+// metadataValidationClient validates the metadata variable of Post
+// operations. Other operations, such as UpdateAccountMetadata, carry
+// account metadata and pass through unchecked.
+type metadataValidationClient struct {
+	graphql.Client
+}
+
+func (c metadataValidationClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	if vars, ok := req.Variables.(interface{ GetMetadata() map[string]any }); ok && strings.HasPrefix(req.OpName, "Post") {
+		if err := ValidateActivityMetadata(vars.GetMetadata()); err != nil {
+			return fmt.Errorf("%s: %w", req.OpName, err)
+		}
+	}
+	return c.Client.MakeRequest(ctx, req, resp)
+}
+
+// ErrBackdated is returned by PostTransactionStrict when the effective date
+// precedes the allowed minimum.
+var ErrBackdated = errors.New("effective date precedes minimum")
+
+// PostTransactionStrict is PostTransaction with a backdating guard: it fails
+// with ErrBackdated, without sending anything, if effective is before
+// minEffective. Posting on minEffective itself is allowed.
+func PostTransactionStrict(ctx context.Context, client graphql.Client, txID uuid.UUID, effective, minEffective Date) (*PostTransactionResponse, error) {
+	if effective.Before(minEffective.Time) {
+		return nil, fmt.Errorf("posting transaction %s effective %s before %s: %w",
+			txID, effective.Format("2006-01-02"), minEffective.Format("2006-01-02"), ErrBackdated)
+	}
+	return PostTransaction(ctx, client, txID, effective)
+}
+
+// Transfer moves amount from one account to another in journalID as a
+// balanced two-leg transaction: from is debited and to is credited. It uses
+// the SIMPLE tran code created by Setup and returns the new transaction ID.
+// amount must be a valid, strictly positive decimal; anything else fails
+// without sending a request. Unless ctx carries one, the posting's
+// idempotency key is derived from the new transaction ID.
+func Transfer(ctx context.Context, client graphql.Client, journalID, from, to uuid.UUID, amount Decimal, effective Date) (uuid.UUID, error) {
+	if _, _, ok := amount.unscaled(); !ok {
+		return uuid.Nil, fmt.Errorf("transfer amount %q: invalid decimal", amount)
+	}
+	if amount.Cmp("0") <= 0 {
+		return uuid.Nil, fmt.Errorf("transfer amount %s: must be positive", amount)
+	}
+	txID := NewID()
+	ctx = idempotentAs(ctx, "transfer:"+txID.String())
+	if _, err := PostSimpleTransfer(ctx, client, txID, journalID, from, to, amount, effective); err != nil {
+		return uuid.Nil, fmt.Errorf("posting transfer %s: %w", txID, err)
+	}
+	return txID, nil
+}
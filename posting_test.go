@@ -0,0 +1,124 @@
+package eff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostTransactionWithMetadata(t *testing.T) {
+	ctx, client := newLiveClient(t)
+
+	_, err := CreateActivityIndex(ctx, client)
+	require.NoError(t, err)
+	_, err = Setup(ctx, client, journalID, tranCodeID, account1ID, account2ID)
+	require.NoError(t, err)
+	_, err = SetupSimpleMeta(ctx, client, simpleMetaTranCodeID)
+	require.NoError(t, err)
+
+	metadata := map[string]any{"ref": "INV-42", "effective": "1999-12-31"}
+	_, err = PostTransactionWithMetadata(ctx, client, uuid.New(), NewDate(2026, time.January, 10), metadata)
+	require.NoError(t, err)
+	require.Equal(t, "1999-12-31", metadata["effective"], "caller's map must not be modified")
+
+	resp, err := ActivityQuery(ctx, client, Ptr(journalID.String()), Ptr(account1ID.String()), Ptr("2026-01"))
+	require.NoError(t, err)
+	require.Len(t, resp.Entries.Nodes, 1)
+	require.Equal(t, map[string]any{
+		"ref":           "INV-42",
+		"effective":     "2026-01-10",
+		"statementDate": "2026-01-10",
+	}, *resp.Entries.Nodes[0].Metadata)
+}
+
+func TestWithMetadataValidation(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		fmt.Fprint(w, `{"data": {"postTransaction": {"transactionId": "`+uuid.NewString()+`", "created": "2026-01-10T00:00:00Z"}}}`)
+	}))
+	t.Cleanup(srv.Close)
+	tc := &TwispContainer{GraphQLEndpoint: srv.URL}
+	client := tc.NewGraphQLClient(tenantHeader(), WithMetadataValidation())
+	ctx := context.Background()
+	effective := NewDate(2026, time.January, 10)
+
+	_, err := PostSimpleWithMetadata(ctx, client, uuid.New(), effective, map[string]any{"effective": "2026-01-10"})
+	require.ErrorContains(t, err, "PostSimpleWithMetadata: validating activity metadata: statementDate is missing")
+	require.Zero(t, requests.Load(), "malformed metadata must not be posted")
+
+	_, err = PostTransactionWithMetadata(ctx, client, uuid.New(), effective, map[string]any{"ref": effective})
+	require.ErrorContains(t, err, "ref is eff.Date, not a JSON value")
+	require.Zero(t, requests.Load())
+
+	_, err = PostTransactionWithMetadata(ctx, client, uuid.New(), effective, map[string]any{"ref": "INV-42"})
+	require.NoError(t, err)
+	_, err = UpdateAccountMetadata(ctx, client, account1ID, map[string]any{"product": "savings"})
+	require.NoError(t, err, "account metadata isn't activity metadata")
+	_, err = PostSimpleWithMetadata(ctx, tc.NewGraphQLClient(tenantHeader()), uuid.New(), effective, nil)
+	require.NoError(t, err, "validation is opt-in")
+	require.EqualValues(t, 3, requests.Load())
+}
+
+func TestPostTransactionStrict(t *testing.T) {
+	var calls int
+	stub := clientFunc(func(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+		calls++
+		return json.Unmarshal([]byte(`{"postTransaction": {"transactionId": "`+uuid.Nil.String()+`"}}`), resp.Data)
+	})
+	minEffective := NewDate(2026, time.January, 1)
+
+	// Backdating within the allowed range, including onto the minimum itself.
+	_, err := PostTransactionStrict(context.Background(), stub, uuid.New(), NewDate(2026, time.January, 24), minEffective)
+	require.NoError(t, err)
+	_, err = PostTransactionStrict(context.Background(), stub, uuid.New(), minEffective, minEffective)
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+
+	_, err = PostTransactionStrict(context.Background(), stub, uuid.New(), NewDate(2025, time.December, 31), minEffective)
+	require.ErrorIs(t, err, ErrBackdated)
+	require.Equal(t, 2, calls, "a rejected backdate must not reach the server")
+}
+
+func TestTransferRejectsAmount(t *testing.T) {
+	var calls int
+	stub := clientFunc(func(context.Context, *graphql.Request, *graphql.Response) error {
+		calls++
+		return nil
+	})
+	for _, amount := range []Decimal{"0", "0.00", "-5.00", "five"} {
+		_, err := Transfer(context.Background(), stub, journalID, account2ID, account1ID, amount, NewDate(2026, time.January, 10))
+		require.Error(t, err, amount)
+	}
+	require.Zero(t, calls, "a rejected amount must not reach the server")
+}
+
+func TestTransfer(t *testing.T) {
+	ctx, client := newLiveClient(t)
+
+	_, err := Setup(ctx, client, journalID, tranCodeID, account1ID, account2ID)
+	require.NoError(t, err)
+
+	txID, err := Transfer(ctx, client, journalID, account2ID, account1ID, "5.00", NewDate(2026, time.January, 10))
+	require.NoError(t, err)
+	require.NotEqual(t, uuid.Nil, txID)
+
+	// Both Setup accounts are credit-normal: the credited side goes up and
+	// the debited side goes down.
+	balances, err := BatchBalances(ctx, client, []BalanceReq{
+		{AccountID: account1ID, JournalID: journalID},
+		{AccountID: account2ID, JournalID: journalID},
+	})
+	require.NoError(t, err)
+	require.True(t, balances[0].Equal("5.00"), "to: %s", balances[0])
+	require.True(t, balances[1].Equal("-5.00"), "from: %s", balances[1])
+}
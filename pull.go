@@ -0,0 +1,130 @@
+package eff
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/containerd/errdefs"
+	"github.com/containerd/platforms"
+	"github.com/distribution/reference"
+	"github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/pkg/jsonmessage"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/testcontainers/testcontainers-go"
+)
+
+// ErrImagePull matches any *ImagePullError.
+var ErrImagePull = errors.New("image pull failed")
+
+// ImagePullError reports that StartTwisp couldn't pull its image, as
+// opposed to the container failing once it runs, so CI can say the
+// registry is unreachable rather than that Twisp is broken.
+type ImagePullError struct {
+	// Image is the reference pulled, e.g. DefaultImage.
+	Image string
+	// Registry is the registry host Image is pulled from, e.g.
+	// "public.ecr.aws", or "" if Image doesn't parse as a reference.
+	Registry string
+	Err      error
+}
+
+func (e *ImagePullError) Error() string {
+	if e.Registry == "" {
+		return fmt.Sprintf("pulling image %s: %v", e.Image, e.Err)
+	}
+	return fmt.Sprintf("pulling image %s from %s: %v", e.Image, e.Registry, e.Err)
+}
+
+func (e *ImagePullError) Unwrap() error { return e.Err }
+
+func (e *ImagePullError) Is(target error) bool { return target == ErrImagePull }
+
+// pullImage pulls the image req runs unless provider's daemon already has
+// it for req's platform. testcontainers retries a failed pull with backoff
+// until ctx ends, and returns the bare daemon error; pullImage tries once
+// and fails with an *ImagePullError, so an unreachable registry fails
+// StartTwisp in seconds. The image is resolved as provider.CreateContainer
+// resolves it, through req's image substitutors and then the configured
+// Docker Hub prefix, so the container never pulls a second time.
+func pullImage(ctx context.Context, provider *testcontainers.DockerProvider, req testcontainers.ContainerRequest) error {
+	ref, err := imageRef(req, provider.Config().Config.HubImageNamePrefix)
+	if err != nil {
+		return err
+	}
+	var platform *ocispec.Platform
+	if req.ImagePlatform != "" {
+		p, err := platforms.Parse(req.ImagePlatform)
+		if err != nil {
+			return fmt.Errorf("invalid platform %s: %w", req.ImagePlatform, err)
+		}
+		platform = &p
+	}
+
+	cli := provider.Client()
+	img, err := cli.ImageInspect(ctx, ref)
+	switch {
+	case err == nil:
+		if platform == nil || (img.Architecture == platform.Architecture && img.Os == platform.OS) {
+			return nil
+		}
+	case !errdefs.IsNotFound(err):
+		return fmt.Errorf("inspecting image %s: %w", ref, err)
+	}
+
+	opts := image.PullOptions{Platform: req.ImagePlatform}
+	// Public images have no credentials, so a lookup failure isn't fatal.
+	if _, auth, err := testcontainers.DockerImageAuth(ctx, ref); err == nil {
+		if encoded, err := json.Marshal(auth); err == nil {
+			opts.RegistryAuth = base64.URLEncoding.EncodeToString(encoded)
+		}
+	}
+	pull, err := cli.ImagePull(ctx, ref, opts)
+	if err == nil {
+		// A pull that fails part way reports it in the progress stream.
+		err = jsonmessage.DisplayJSONMessagesStream(pull, io.Discard, 0, false, nil)
+		pull.Close()
+	}
+	if err != nil {
+		return &ImagePullError{Image: ref, Registry: registryOf(ref), Err: err}
+	}
+	return nil
+}
+
+// imageRef returns the image testcontainers runs for req: req.Image
+// through req's image substitutors, then with hubPrefix prepended if the
+// result is a Docker Hub image named without a registry.
+func imageRef(req testcontainers.ContainerRequest, hubPrefix string) (string, error) {
+	ref := req.Image
+	for _, s := range req.ImageSubstitutors {
+		sub, err := s.Substitute(ref)
+		if err != nil {
+			return "", fmt.Errorf("substituting image %s with %s: %w", ref, s.Description(), err)
+		}
+		ref = sub
+	}
+	if hubPrefix == "" {
+		return ref, nil
+	}
+	// Like docker, a first path component with a dot or port, or
+	// "localhost", names a registry.
+	if first, _, found := strings.Cut(ref, "/"); found && (strings.ContainsAny(first, ".:") || first == "localhost") {
+		return ref, nil
+	}
+	return path.Join(hubPrefix, ref), nil
+}
+
+// registryOf returns the registry host of image reference ref, with
+// Docker Hub for references without one.
+func registryOf(ref string) string {
+	named, err := reference.ParseNormalizedNamed(ref)
+	if err != nil {
+		return ""
+	}
+	return reference.Domain(named)
+}
@@ -0,0 +1,70 @@
+package eff
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+)
+
+func TestImagePullError(t *testing.T) {
+	cause := errors.New("dial tcp: lookup public.ecr.aws: no such host")
+	err := error(&ImagePullError{Image: DefaultImage, Registry: registryOf(DefaultImage), Err: cause})
+	require.ErrorIs(t, err, ErrImagePull)
+	require.ErrorIs(t, err, cause)
+	require.EqualError(t, err, "pulling image public.ecr.aws/twisp/local:latest from public.ecr.aws: dial tcp: lookup public.ecr.aws: no such host")
+
+	for ref, want := range map[string]string{
+		DefaultImage:                    "public.ecr.aws",
+		"localhost:5000/twisp:dev":      "localhost:5000",
+		"twisp/local":                   "docker.io",
+		"Not A Valid//Reference:latest": "",
+	} {
+		require.Equal(t, want, registryOf(ref), ref)
+	}
+}
+
+// mirrorSubstitutor moves every image onto a mirror registry.
+type mirrorSubstitutor struct{}
+
+func (mirrorSubstitutor) Description() string { return "mirror" }
+
+func (mirrorSubstitutor) Substitute(image string) (string, error) {
+	return "mirror.example:5000/" + image, nil
+}
+
+func TestImageRef(t *testing.T) {
+	for _, tt := range []struct {
+		image, prefix string
+		subs          []testcontainers.ImageSubstitutor
+		want          string
+	}{
+		{image: DefaultImage, want: DefaultImage},
+		{image: DefaultImage, prefix: "hub.corp", want: DefaultImage},
+		{image: "twisp/local:dev", prefix: "hub.corp", want: "hub.corp/twisp/local:dev"},
+		{image: "localhost/twisp:dev", prefix: "hub.corp", want: "localhost/twisp:dev"},
+		{image: "twisp/local:dev", prefix: "hub.corp", subs: []testcontainers.ImageSubstitutor{mirrorSubstitutor{}},
+			want: "mirror.example:5000/twisp/local:dev"},
+	} {
+		got, err := imageRef(testcontainers.ContainerRequest{Image: tt.image, ImageSubstitutors: tt.subs}, tt.prefix)
+		require.NoError(t, err)
+		require.Equal(t, tt.want, got, "%s with prefix %q", tt.image, tt.prefix)
+	}
+}
+
+func TestStartTwispImagePull(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	// .invalid never resolves, so the registry is unreachable.
+	_, err := StartTwisp(ctx, WithImage("registry.invalid/twisp/local:latest"))
+	var pullErr *ImagePullError
+	require.ErrorAs(t, err, &pullErr)
+	require.ErrorIs(t, err, ErrImagePull)
+	require.Equal(t, "registry.invalid/twisp/local:latest", pullErr.Image)
+	require.Equal(t, "registry.invalid", pullErr.Registry)
+	require.NoError(t, ctx.Err(), "the pull must fail without waiting out the context")
+}
@@ -0,0 +1,113 @@
+package eff
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// RawQuery executes doc, a GraphQL document the generated operations don't
+// cover, and decodes its "data" object into out. Fields of out typed as
+// Date, Decimal, Timestamp or UUID decode exactly as they do for generated
+// operations, and untyped fields keep full numeric precision; see
+// DecodeJSON. vars must match the variables doc declares.
+//
+// Under WithPartialData, data that arrives alongside GraphQL errors is still
+// decoded into out, and the errors are returned after it.
+func RawQuery(ctx context.Context, client graphql.Client, doc string, vars map[string]any, out any) error {
+	parsed, err := parser.ParseQuery(&ast.Source{Input: doc})
+	if err != nil {
+		return fmt.Errorf("parsing raw query: %w", err)
+	}
+	if len(parsed.Operations) != 1 {
+		return fmt.Errorf("parsing raw query: want 1 operation, got %d", len(parsed.Operations))
+	}
+	op := parsed.Operations[0].Name
+	if op == "" {
+		op = "RawQuery"
+	}
+
+	var data json.RawMessage
+	err = client.MakeRequest(ctx,
+		&graphql.Request{OpName: op, Query: doc, Variables: vars},
+		&graphql.Response{Data: &data},
+	)
+	if err != nil && !acceptPartial(ctx, err) {
+		return fmt.Errorf("raw query %s: %w", op, err)
+	}
+	if len(data) > 0 {
+		if err := DecodeJSON(data, out); err != nil {
+			return fmt.Errorf("decoding raw query %s: %w", op, err)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("raw query %s: %w", op, err)
+	}
+	return nil
+}
+
+// DecodeJSON unmarshals data into out without routing any number through
+// float64. Decimal fields already decode JSON numbers exactly; DecodeJSON
+// extends that to untyped values, so a number landing in an any, a
+// map[string]any or a []any is stored as a Decimal rather than a float64
+// that may have lost digits. Data after the first JSON value is an error.
+func DecodeJSON(data []byte, out any) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(out); err != nil {
+		return err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return errors.New("unexpected data after JSON value")
+	}
+	decimalizeNumbers(reflect.ValueOf(out))
+	return nil
+}
+
+// decimalizeNumbers replaces every json.Number held in an interface value
+// reachable from v with the equivalent Decimal.
+func decimalizeNumbers(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if !v.IsNil() {
+			decimalizeNumbers(v.Elem())
+		}
+	case reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+		if n, ok := v.Interface().(json.Number); ok {
+			if v.CanSet() {
+				v.Set(reflect.ValueOf(Decimal(n).Normalize()))
+			}
+			return
+		}
+		decimalizeNumbers(v.Elem())
+	case reflect.Map:
+		for _, k := range v.MapKeys() {
+			// Map elements aren't addressable: fix up a copy and store it back.
+			elem := reflect.New(v.Type().Elem()).Elem()
+			elem.Set(v.MapIndex(k))
+			decimalizeNumbers(elem)
+			v.SetMapIndex(k, elem)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := range v.Len() {
+			decimalizeNumbers(v.Index(i))
+		}
+	case reflect.Struct:
+		for i := range v.NumField() {
+			if v.Type().Field(i).IsExported() {
+				decimalizeNumbers(v.Field(i))
+			}
+		}
+	}
+}
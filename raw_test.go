@@ -0,0 +1,114 @@
+package eff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// rawBalance decodes the raw balance query used below.
+type rawBalance struct {
+	Balance struct {
+		AccountID uuid.UUID `json:"accountId"`
+		Modified  Timestamp `json:"modified"`
+		Available struct {
+			NormalBalance struct {
+				Units Decimal `json:"units"`
+			} `json:"normalBalance"`
+		} `json:"available"`
+	} `json:"balance"`
+}
+
+const rawBalanceQuery = `query RawBalance($accountId: UUID!, $journalId: UUID!) {
+  balance(accountId: $accountId, journalId: $journalId) {
+    accountId
+    modified
+    available(layer: SETTLED) { normalBalance { units } }
+  }
+}`
+
+func TestRawQueryDecoding(t *testing.T) {
+	var opName string
+	stub := clientFunc(func(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+		opName = req.OpName
+		return json.Unmarshal([]byte(`{"balance": {
+			"accountId": "`+account1ID.String()+`",
+			"modified": "2026-01-15T10:00:00.123456Z",
+			"available": {"normalBalance": {"units": 12345678901234567890.12}}
+		}}`), resp.Data)
+	})
+
+	var out rawBalance
+	err := RawQuery(context.Background(), stub, rawBalanceQuery,
+		map[string]any{"accountId": account1ID, "journalId": journalID}, &out)
+	require.NoError(t, err)
+	require.Equal(t, "RawBalance", opName)
+	require.Equal(t, account1ID, out.Balance.AccountID)
+	require.Equal(t, time.Date(2026, time.January, 15, 10, 0, 0, 123456000, time.UTC), out.Balance.Modified.Time)
+	require.Equal(t, Decimal("12345678901234567890.12"), out.Balance.Available.NormalBalance.Units,
+		"numbers decode without a float round-trip")
+
+	require.Error(t, RawQuery(context.Background(), stub, "query {", nil, &out))
+	require.Error(t, RawQuery(context.Background(), stub, "query A { x } query B { y }", nil, &out))
+}
+
+func TestRawQuery(t *testing.T) {
+	ctx, client := newLiveClient(t)
+
+	_, err := Setup(ctx, client, journalID, tranCodeID, account1ID, account2ID)
+	require.NoError(t, err)
+	_, err = PostTransaction(ctx, client, uuid.New(), NewDate(2026, time.January, 15))
+	require.NoError(t, err)
+
+	var out rawBalance
+	err = RawQuery(ctx, client, rawBalanceQuery,
+		map[string]any{"accountId": account1ID, "journalId": journalID}, &out)
+	require.NoError(t, err)
+	require.Equal(t, account1ID, out.Balance.AccountID)
+	require.False(t, out.Balance.Modified.IsZero())
+	require.Equal(t, Decimal("1.00"), out.Balance.Available.NormalBalance.Units)
+}
+
+func TestDecodeJSON(t *testing.T) {
+	const big = "12345678901234567890.123456789"
+	data := []byte(`{
+		"units": ` + big + `,
+		"metadata": {"fee": ` + big + `, "lines": [1, 2.50, {"rate": 1e-3}], "ref": "INV-42"},
+		"extra": 0.1
+	}`)
+
+	var out struct {
+		Units    Decimal        `json:"units"`
+		Metadata map[string]any `json:"metadata"`
+		Extra    any            `json:"extra"`
+	}
+	require.NoError(t, DecodeJSON(data, &out))
+	require.Equal(t, Decimal(big), out.Units)
+	require.Equal(t, Decimal(big), out.Metadata["fee"])
+	require.Equal(t, []any{Decimal("1"), Decimal("2.50"), map[string]any{"rate": Decimal("0.001")}}, out.Metadata["lines"])
+	require.Equal(t, "INV-42", out.Metadata["ref"])
+	require.Equal(t, Decimal("0.1"), out.Extra)
+
+	// Plain encoding/json rounds the same number through float64.
+	var lossy map[string]any
+	require.NoError(t, json.Unmarshal(data, &lossy))
+	require.NotEqual(t, big, fmt.Sprint(lossy["metadata"].(map[string]any)["fee"]))
+
+	require.Error(t, DecodeJSON([]byte(`{} {}`), &out))
+	require.Error(t, DecodeJSON([]byte(`{"units": "abc"}`), &out))
+}
+
+func TestRawQueryUntypedNumbers(t *testing.T) {
+	stub := clientFunc(func(_ context.Context, _ *graphql.Request, resp *graphql.Response) error {
+		return json.Unmarshal([]byte(`{"entry": {"metadata": {"amount": 0.30000000000000000001}}}`), resp.Data)
+	})
+	var out map[string]any
+	require.NoError(t, RawQuery(context.Background(), stub, `query { entry { metadata } }`, nil, &out))
+	require.Equal(t, map[string]any{"entry": map[string]any{"metadata": map[string]any{"amount": Decimal("0.30000000000000000001")}}}, out)
+}
@@ -0,0 +1,125 @@
+package eff
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// interaction is one recorded request/response pair as stored on disk.
+type interaction struct {
+	OpName    string          `json:"opName"`
+	Variables json.RawMessage `json:"variables,omitempty"`
+	Data      json.RawMessage `json:"data,omitempty"`
+	Errors    gqlerror.List   `json:"errors,omitempty"`
+}
+
+// RecordingClient wraps base and writes every completed request/response
+// pair to dir, keyed by operation name and variables hash, for later use
+// with ReplayClient. Transport failures are passed through unrecorded.
+func RecordingClient(base graphql.Client, dir string) graphql.Client {
+	return &recordingClient{base: base, dir: dir}
+}
+
+type recordingClient struct {
+	base graphql.Client
+	dir  string
+}
+
+func (c *recordingClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	err := c.base.MakeRequest(ctx, req, resp)
+	var gqlErrs gqlerror.List
+	if err != nil && !errors.As(err, &gqlErrs) {
+		return err
+	}
+
+	vars, path, keyErr := interactionKey(c.dir, req)
+	if keyErr != nil {
+		return fmt.Errorf("recording %s: %w", req.OpName, keyErr)
+	}
+	data, marshalErr := json.Marshal(resp.Data)
+	if marshalErr != nil {
+		return fmt.Errorf("recording %s: %w", req.OpName, marshalErr)
+	}
+	b, marshalErr := json.MarshalIndent(interaction{
+		OpName:    req.OpName,
+		Variables: vars,
+		Data:      data,
+		Errors:    resp.Errors,
+	}, "", "  ")
+	if marshalErr != nil {
+		return fmt.Errorf("recording %s: %w", req.OpName, marshalErr)
+	}
+	if writeErr := os.MkdirAll(c.dir, 0o755); writeErr != nil {
+		return fmt.Errorf("recording %s: %w", req.OpName, writeErr)
+	}
+	if writeErr := os.WriteFile(path, b, 0o644); writeErr != nil {
+		return fmt.Errorf("recording %s: %w", req.OpName, writeErr)
+	}
+	return err
+}
+
+// ReplayClient serves responses previously written by RecordingClient from
+// dir. Requests with no matching recording fail with an error naming the
+// operation and the file that was looked for.
+func ReplayClient(dir string) graphql.Client {
+	return &replayClient{dir: dir}
+}
+
+type replayClient struct {
+	dir string
+}
+
+func (c *replayClient) MakeRequest(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+	_, path, err := interactionKey(c.dir, req)
+	if err != nil {
+		return fmt.Errorf("replaying %s: %w", req.OpName, err)
+	}
+	b, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("replaying %s: no recorded interaction at %s", req.OpName, path)
+	}
+	if err != nil {
+		return fmt.Errorf("replaying %s: %w", req.OpName, err)
+	}
+
+	var rec interaction
+	if err := json.Unmarshal(b, &rec); err != nil {
+		return fmt.Errorf("replaying %s: decoding %s: %w", req.OpName, path, err)
+	}
+	if len(rec.Data) > 0 {
+		if err := json.Unmarshal(rec.Data, resp.Data); err != nil {
+			return fmt.Errorf("replaying %s: decoding data: %w", req.OpName, err)
+		}
+	}
+	resp.Errors = rec.Errors
+	if len(resp.Errors) > 0 {
+		return resp.Errors
+	}
+	return nil
+}
+
+// interactionKey returns the marshaled variables of req and the file path
+// its interaction is stored under: <dir>/<OpName>-<hash of variables>.json.
+func interactionKey(dir string, req *graphql.Request) (json.RawMessage, string, error) {
+	var vars json.RawMessage
+	if req.Variables != nil {
+		b, err := json.Marshal(req.Variables)
+		if err != nil {
+			return nil, "", fmt.Errorf("marshaling variables: %w", err)
+		}
+		vars = b
+	}
+	sum := sha256.Sum256(vars)
+	name := fmt.Sprintf("%s-%s.json", req.OpName, hex.EncodeToString(sum[:8]))
+	return vars, filepath.Join(dir, name), nil
+}
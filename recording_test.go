@@ -0,0 +1,47 @@
+package eff
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	var calls int
+	stub := clientFunc(func(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+		calls++
+		return json.Unmarshal([]byte(`{
+			"entries": {"nodes": [
+				{"metadata": {"effective": "2026-01-15", "statementDate": "2026-01-15"}, "amount": {"units": "1.00"}}
+			]}
+		}`), resp.Data)
+	})
+
+	recorded, err := ActivityQuery(ctx, RecordingClient(stub, dir),
+		Ptr(journalID.String()), Ptr(account1ID.String()), Ptr("2026-01"))
+	require.NoError(t, err)
+	require.Equal(t, 1, calls)
+
+	replayed, err := ActivityQuery(ctx, ReplayClient(dir),
+		Ptr(journalID.String()), Ptr(account1ID.String()), Ptr("2026-01"))
+	require.NoError(t, err)
+	require.Equal(t, 1, calls, "replay must not reach the stub")
+	require.Equal(t, recorded, replayed)
+	require.Equal(t, Decimal("1.00"), replayed.Entries.Nodes[0].Amount.Units)
+
+	_, err = ActivityQuery(ctx, ReplayClient(dir),
+		Ptr(journalID.String()), Ptr(account1ID.String()), Ptr("2026-02"))
+	require.ErrorContains(t, err, "replaying ActivityQuery: no recorded interaction")
+}
+
+type clientFunc func(context.Context, *graphql.Request, *graphql.Response) error
+
+func (f clientFunc) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	return f(ctx, req, resp)
+}
@@ -3,6 +3,7 @@ package eff
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,6 +12,15 @@ import (
 // UUID wraps uuid.UUID for GraphQL scalar marshaling.
 type UUID = uuid.UUID
 
+// NewID returns a time-ordered UUID v7. Prefer it over uuid.New (v4) for
+// IDs created during a test, e.g. transaction IDs: successive IDs sort in
+// creation order, which keeps index writes local and makes IDs in logs easy
+// to correlate with time. Keep well-known fixture IDs fixed. Under
+// WithIDSource it returns the source's next ID instead.
+func NewID() UUID {
+	return nextID(func() UUID { return uuid.Must(uuid.NewV7()) })
+}
+
 // Date represents a Twisp Date scalar (YYYY-MM-DD).
 type Date struct{ time.Time }
 
@@ -36,6 +46,8 @@ func NewDate(year int, month time.Month, day int) Date {
 }
 
 // Decimal represents a Twisp Decimal scalar as a string to preserve precision.
+// Prefer ParseDecimal for values built at run time: a conversion such as
+// Decimal("abc") compiles and only fails when the value is used.
 type Decimal string
 
 func (d Decimal) String() string { return string(d) }
@@ -52,10 +64,40 @@ func (d *Decimal) UnmarshalJSON(b []byte) error {
 		if err2 := json.Unmarshal(b, &n); err2 != nil {
 			return fmt.Errorf("invalid Decimal: %w", err)
 		}
-		*d = Decimal(n.String())
-		return nil
+		s = n.String()
 	}
-	*d = Decimal(s)
+	return d.set(s)
+}
+
+// MarshalText encodes d as its bare numeric string, e.g. for url.Values.
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d), nil
+}
+
+// UnmarshalText accepts the same values as UnmarshalJSON's string form.
+func (d *Decimal) UnmarshalText(b []byte) error {
+	return d.set(string(b))
+}
+
+// ParseDecimal validates s and returns it as a Decimal, accepting exactly
+// what UnmarshalText does: a plain decimal such as "-12.50", or scientific
+// notation, which is expanded, so "1.5e2" gives "150". Surrounding
+// whitespace is trimmed. Anything else is an error.
+func ParseDecimal(s string) (Decimal, error) {
+	var d Decimal
+	if err := d.set(strings.TrimSpace(s)); err != nil {
+		return "", err
+	}
+	return d, nil
+}
+
+// set normalizes s and stores it in d if it is a valid decimal.
+func (d *Decimal) set(s string) error {
+	v := Decimal(s).Normalize()
+	if _, _, ok := v.unscaled(); !ok {
+		return fmt.Errorf("invalid Decimal %q", s)
+	}
+	*d = v
 	return nil
 }
 
@@ -91,3 +133,13 @@ type ExpressionMap = map[string]string
 type ExpressionNestedMap = map[string]interface{}
 type JSON = map[string]interface{}
 type Value = interface{}
+
+// ParseDirection parses an entry direction case-insensitively, so "debit"
+// and " Debit " both give DebitOrCreditDebit. Anything else is an error.
+func ParseDirection(s string) (DebitOrCredit, error) {
+	switch d := DebitOrCredit(strings.ToUpper(strings.TrimSpace(s))); d {
+	case DebitOrCreditDebit, DebitOrCreditCredit:
+		return d, nil
+	}
+	return "", fmt.Errorf("invalid direction %q: want DEBIT or CREDIT", s)
+}
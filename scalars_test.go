@@ -0,0 +1,58 @@
+package eff
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIDMonotonic(t *testing.T) {
+	prev := NewID()
+	require.Equal(t, 7, int(prev.Version()))
+	for range 10000 {
+		id := NewID()
+		require.Negative(t, bytes.Compare(prev[:], id[:]), "%s should sort before %s", prev, id)
+		prev = id
+	}
+}
+
+func TestParseDirection(t *testing.T) {
+	for in, want := range map[string]DebitOrCredit{
+		"DEBIT":    DebitOrCreditDebit,
+		"debit":    DebitOrCreditDebit,
+		" Credit ": DebitOrCreditCredit,
+		"CREDIT":   DebitOrCreditCredit,
+	} {
+		got, err := ParseDirection(in)
+		require.NoError(t, err, in)
+		require.Equal(t, want, got, in)
+	}
+
+	for _, in := range []string{"", "DR", "debits", "SIMPLE_CR", "credit card"} {
+		_, err := ParseDirection(in)
+		require.Error(t, err, in)
+	}
+}
+
+func TestParseDecimal(t *testing.T) {
+	for in, want := range map[string]Decimal{
+		"12.50":   "12.50",
+		"-0.001":  "-0.001",
+		"100":     "100",
+		"1.5e2":   "150",
+		"2E-3":    "0.002",
+		" 7.50\n": "7.50",
+	} {
+		got, err := ParseDecimal(in)
+		require.NoError(t, err, in)
+		require.Equal(t, want, got, in)
+	}
+
+	for _, in := range []string{"", "abc", "12abc", "1.2.3", "$5.00", "1,000.00", "NaN", "1e", "--1"} {
+		_, err := ParseDecimal(in)
+		require.Error(t, err, in)
+	}
+	_, err := ParseDecimal("12abc")
+	require.EqualError(t, err, `invalid Decimal "12abc"`)
+}
@@ -0,0 +1,123 @@
+package eff
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/stretchr/testify/require"
+)
+
+// A Scenario doubles as a small declarative DSL: declare postings and
+// expected balances in order, then Run them.
+//
+//	s.At(jan31).Post("1.00").From("cash").To("checking")
+//	s.Expect("checking").Balance(jan31).Equals("3.00")
+//	s.Run(ctx, client, t)
+//
+// Each expectation is checked against the ledger as it stood right after
+// the most recent posting declared before it: its cutoff is derived from
+// that posting's created timestamp, so later postings (including backdated
+// ones) never affect it.
+
+// Posting is a transfer declared with Scenario.At. It is posted through the
+// scenario's "transfer" tran code.
+type Posting struct {
+	effective Date
+	amount    Decimal
+	from, to  string
+	err       error
+}
+
+// At declares a posting effective on d.
+func (s *Scenario) At(d Date) *Posting {
+	p := &Posting{effective: d}
+	s.steps = append(s.steps, p)
+	return p
+}
+
+// Post sets the posting amount.
+func (p *Posting) Post(amount Decimal) *Posting { p.amount = amount; return p }
+
+// From names the debited account.
+func (p *Posting) From(account string) *Posting { return p.Leg(account, DebitOrCreditDebit) }
+
+// To names the credited account.
+func (p *Posting) To(account string) *Posting { return p.Leg(account, DebitOrCreditCredit) }
+
+// Leg names the account on the given side of the posting, so a direction
+// read with ParseDirection can be used directly. Any other direction fails
+// the posting when the scenario runs.
+func (p *Posting) Leg(account string, direction DebitOrCredit) *Posting {
+	switch direction {
+	case DebitOrCreditDebit:
+		p.from = account
+	case DebitOrCreditCredit:
+		p.to = account
+	default:
+		p.err = fmt.Errorf("%s leg: invalid direction %q", account, direction)
+	}
+	return p
+}
+
+// Expectation is a balance assertion declared with Scenario.Expect.
+type Expectation struct {
+	account string
+	asOf    Date
+	want    Decimal
+}
+
+// Expect declares an assertion on the named account's settled balance.
+func (s *Scenario) Expect(account string) *Expectation {
+	e := &Expectation{account: account}
+	s.steps = append(s.steps, e)
+	return e
+}
+
+// Balance sets the effective date the balance is cumulative through.
+func (e *Expectation) Balance(d Date) *Expectation { e.asOf = d; return e }
+
+// Equals sets the expected balance, compared numerically.
+func (e *Expectation) Equals(want Decimal) { e.want = want }
+
+// Run executes the declared steps in order and fails tb on the first
+// posting error or unmet expectation. Executed steps are cleared, so more
+// steps may be declared and Run again.
+func (s *Scenario) Run(ctx context.Context, client graphql.Client, tb testing.TB) {
+	tb.Helper()
+	steps := s.steps
+	s.steps = nil
+
+	for i, step := range steps {
+		switch step := step.(type) {
+		case *Posting:
+			require.NoError(tb, step.err, "step %d: posting", i)
+			txID := NewID()
+			resp, err := PostTransfer(ctx, client, txID, s.TranCode("transfer"),
+				s.Account(step.from).ID, s.Account(step.to).ID, step.amount, step.effective)
+			require.NoError(tb, err, "step %d: posting\n%s", i, DescribeTransaction(Transaction{
+				ID:        txID,
+				Effective: step.effective,
+				TranCode:  s.TranCode("transfer"),
+				Legs: []*TransactionLeg{
+					{Account: s.Account(step.from).Code, Direction: DebitOrCreditDebit, Amount: step.amount},
+					{Account: s.Account(step.to).Code, Direction: DebitOrCreditCredit, Amount: step.amount},
+				},
+			}))
+			s.cutoff = NextCutoffAfter(resp.PostTransaction.Created)
+		case *Expectation:
+			desc := fmt.Sprintf("step %d: %s balance through %s", i, step.account, step.asOf.Format("2006-01-02"))
+			got := Decimal("0")
+			if !s.cutoff.IsZero() {
+				var err error
+				got, err = balanceAtCutoff(ctx, client, s.Account(step.account).ID, s.JournalID, step.asOf, s.cutoff)
+				require.NoError(tb, err, desc)
+			}
+			require.Zero(tb, got.Cmp(step.want), "%s: want %s, got %s", desc, step.want, got)
+		}
+	}
+}
+
+// scenarioStep is a *Posting or an *Expectation.
+type scenarioStep any
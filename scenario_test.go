@@ -0,0 +1,128 @@
+package eff
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScenarioDSL(t *testing.T) {
+	ctx, client := newLiveClient(t)
+
+	s, err := RetailBankingJournal(ctx, client)
+	require.NoError(t, err)
+
+	dec31 := NewDate(2025, time.December, 31)
+	jan31 := NewDate(2026, time.January, 31)
+	feb28 := NewDate(2026, time.February, 28)
+
+	s.Expect("checking").Balance(dec31).Equals("0.00")
+	s.At(NewDate(2026, time.January, 1)).Post("1.00").From("cash").To("checking")
+	s.At(NewDate(2026, time.January, 15)).Post("1.00").From("cash").To("checking")
+	s.At(jan31).Post("1.00").From("cash").To("checking")
+	// January closes here: this expectation is pinned to the Jan 31 posting.
+	s.Expect("checking").Balance(jan31).Equals("3.00")
+
+	s.At(NewDate(2026, time.February, 15)).Post("1.00").From("cash").To("checking")
+	// Backdated adjustment, effective in January after it closed.
+	s.At(NewDate(2026, time.January, 24)).Post("5.00").From("cash").To("checking")
+	s.Expect("checking").Balance(jan31).Equals("8.00")
+	s.Expect("checking").Balance(feb28).Equals("9.00")
+	s.Expect("cash").Balance(feb28).Equals("9.00")
+
+	s.Run(ctx, client, t)
+}
+
+// failRecorder captures the failure reported to it and stops the calling
+// goroutine, as testing.T does.
+type failRecorder struct {
+	testing.TB
+	failure string
+}
+
+func (r *failRecorder) Helper() {}
+
+func (r *failRecorder) Errorf(format string, args ...any) {
+	r.failure = fmt.Sprintf(format, args...)
+}
+
+func (r *failRecorder) FailNow() { runtime.Goexit() }
+
+func TestScenarioPostingFailure(t *testing.T) {
+	s := &Scenario{
+		JournalID: uuid.New(),
+		accounts: map[string]ScenarioAccount{
+			"cash":     {ID: uuid.New(), Code: "CASH.1A2B"},
+			"checking": {ID: uuid.New(), Code: "CHECKING.1A2B"},
+		},
+		tranCodes: map[string]tranCodeRef{"transfer": {id: uuid.New(), code: "TRANSFER.1A2B"}},
+	}
+	var txID uuid.UUID
+	stub := clientFunc(func(_ context.Context, req *graphql.Request, _ *graphql.Response) error {
+		txID = req.Variables.(*__PostTransferInput).TransactionId
+		return errors.New("account locked")
+	})
+	s.At(NewDate(2026, time.January, 31)).Post("1.00").From("cash").To("checking")
+
+	rec := &failRecorder{TB: t}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.Run(context.Background(), stub, rec)
+	}()
+	<-done
+	require.Contains(t, rec.failure, "account locked")
+	// testify indents the message's lines.
+	for _, line := range []string{
+		"step 0: posting\n",
+		"2026-01-31 TRANSFER.1A2B " + txID.String() + "\n",
+		"  CASH.1A2B      DR  1.00\n",
+		"  CHECKING.1A2B  CR  1.00\n",
+	} {
+		require.Contains(t, rec.failure, line)
+	}
+}
+
+func TestScenarioLeg(t *testing.T) {
+	s := &Scenario{
+		JournalID: uuid.New(),
+		accounts: map[string]ScenarioAccount{
+			"cash":     {ID: uuid.New(), Code: "CASH.1A2B"},
+			"checking": {ID: uuid.New(), Code: "CHECKING.1A2B"},
+		},
+		tranCodes: map[string]tranCodeRef{"transfer": {id: uuid.New(), code: "TRANSFER.1A2B"}},
+	}
+	var got *__PostTransferInput
+	stub := clientFunc(func(_ context.Context, req *graphql.Request, _ *graphql.Response) error {
+		got = req.Variables.(*__PostTransferInput)
+		return nil
+	})
+
+	credit, err := ParseDirection("credit")
+	require.NoError(t, err)
+	debit, err := ParseDirection("Debit")
+	require.NoError(t, err)
+	s.At(NewDate(2026, time.January, 31)).Post("1.00").Leg("checking", credit).Leg("cash", debit)
+	s.Run(context.Background(), stub, t)
+	require.Equal(t, s.Account("cash").ID, got.From)
+	require.Equal(t, s.Account("checking").ID, got.To)
+
+	got = nil
+	s.At(NewDate(2026, time.January, 31)).Post("1.00").From("cash").Leg("checking", "SIMPLE_CR")
+	rec := &failRecorder{TB: t}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.Run(context.Background(), stub, rec)
+	}()
+	<-done
+	require.Contains(t, rec.failure, `checking leg: invalid direction "SIMPLE_CR"`)
+	require.Nil(t, got, "nothing should be posted")
+}
@@ -0,0 +1,63 @@
+package eff
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// sharedStartTimeout bounds SharedContainer's wait for the container.
+const sharedStartTimeout = 5 * time.Minute
+
+var shared atomic.Pointer[TwispContainer]
+
+// SharedContainer starts one Twisp container for a whole test binary, so
+// its tests share a single startup. Call it from TestMain, run the suite,
+// then call the returned function to tear the container down; tests reach
+// the container through Shared:
+//
+//	func TestMain(m *testing.M) {
+//		_, stop := eff.SharedContainer(m)
+//		code := m.Run()
+//		stop()
+//		os.Exit(code)
+//	}
+//
+// Every test then talks to the same Twisp, so give each its own tenant (a
+// fresh x-twisp-account-id header) to keep their data apart. If the
+// container can't start no test could run, so SharedContainer reports why
+// and exits with status 1. As with Cleanup, WithKeepAlive leaves the
+// container running after stop.
+func SharedContainer(m *testing.M, opts ...TwispOption) (*TwispContainer, func()) {
+	ctx, cancel := context.WithTimeout(context.Background(), sharedStartTimeout)
+	defer cancel()
+	tc, err := StartTwisp(ctx, opts...)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "eff: starting shared Twisp container: %v\n", err)
+		os.Exit(1)
+	}
+	shared.Store(tc)
+	return tc, func() {
+		shared.CompareAndSwap(tc, nil)
+		if tc.KeepAlive {
+			return
+		}
+		if err := tc.Terminate(context.Background()); err != nil {
+			fmt.Fprintf(os.Stderr, "eff: terminating shared Twisp container: %v\n", err)
+		}
+	}
+}
+
+// Shared returns the container started by SharedContainer, failing tb if
+// TestMain didn't start one.
+func Shared(tb testing.TB) *TwispContainer {
+	tb.Helper()
+	tc := shared.Load()
+	if tc == nil {
+		tb.Fatal("no shared Twisp container: call SharedContainer from TestMain")
+	}
+	return tc
+}
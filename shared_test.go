@@ -0,0 +1,21 @@
+package eff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestShared(t *testing.T) {
+	// Live tests may have started the package's shared container already.
+	prev := shared.Swap(nil)
+	t.Cleanup(func() { shared.Store(prev) })
+
+	rec := &fatalRecorder{TB: t}
+	require.Nil(t, Shared(rec))
+	require.Equal(t, "no shared Twisp container: call SharedContainer from TestMain", rec.fatal)
+
+	tc := &TwispContainer{GraphQLEndpoint: "http://localhost:8080/financial/v1/graphql"}
+	shared.Store(tc)
+	require.Same(t, tc, Shared(t))
+}
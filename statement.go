@@ -0,0 +1,219 @@
+package eff
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+)
+
+// DateRange is an inclusive range of dates, e.g. a statement period.
+type DateRange struct {
+	Start Date
+	End   Date
+}
+
+func (r DateRange) String() string {
+	return r.Start.Format("2006-01-02") + ".." + r.End.Format("2006-01-02")
+}
+
+// MonthPeriod parses a "YYYY-MM" month, the period key used by
+// ActivityQuery, into the range from its first to its last day.
+func MonthPeriod(month string) (DateRange, error) {
+	t, err := time.Parse("2006-01", month)
+	if err != nil {
+		return DateRange{}, fmt.Errorf("parsing month %q: %w", month, err)
+	}
+	start := NewDate(t.Year(), t.Month(), 1)
+	return DateRange{Start: start, End: start.LastOfMonth()}, nil
+}
+
+// FirstOfMonth returns the first day of d's month.
+func (d Date) FirstOfMonth() Date {
+	return NewDate(d.Year(), d.Month(), 1)
+}
+
+// LastOfMonth returns the last day of d's month, which for February
+// depends on whether d's year is a leap year.
+func (d Date) LastOfMonth() Date {
+	// Day 0 of the next month is the last day of this one.
+	return NewDate(d.Year(), d.Month()+1, 0)
+}
+
+// IsEndOfMonth reports whether d is the last day of its month.
+func (d Date) IsEndOfMonth() bool {
+	return d.Day() == d.LastOfMonth().Day()
+}
+
+// Month returns the "YYYY-MM" period key of the month r starts in.
+func (r DateRange) Month() string {
+	return r.Start.Format("2006-01")
+}
+
+// Entry is one entry's effect on the normal balance of its account, for
+// ComputeClose.
+type Entry struct {
+	Amount   Decimal
+	Currency CurrencyCode
+	// Direction is the side the entry posts to and Normal is its
+	// account's normal balance type: an entry on the normal side raises
+	// the normal balance, one on the other side lowers it.
+	Direction, Normal DebitOrCredit
+}
+
+// ComputeClose recomputes a statement's closing normal balance from its
+// opening balance and the period's entries, to cross-check the close
+// StatementBalance reports. The sum is exact, at the largest scale among
+// open and the amounts. Every entry must be in the same currency.
+func ComputeClose(open Decimal, entries []Entry) (Decimal, error) {
+	if _, _, ok := open.unscaled(); !ok {
+		return "", fmt.Errorf("computing close: invalid opening balance %q", open)
+	}
+	var acc DecimalAcc
+	acc.Add(open)
+	for i, e := range entries {
+		if _, _, ok := e.Amount.unscaled(); !ok {
+			return "", fmt.Errorf("computing close: entry %d: invalid amount %q", i, e.Amount)
+		}
+		if e.Currency != entries[0].Currency {
+			return "", fmt.Errorf("computing close: entry %d is in %s, not %s", i, e.Currency, entries[0].Currency)
+		}
+		switch {
+		case !slices.Contains(AllDebitOrCredit, e.Direction) || !slices.Contains(AllDebitOrCredit, e.Normal):
+			return "", fmt.Errorf("computing close: entry %d: invalid direction %q on a %q account", i, e.Direction, e.Normal)
+		case e.Direction == e.Normal:
+			acc.Add(e.Amount)
+		default:
+			acc.Add(Decimal("0").Sub(e.Amount))
+		}
+	}
+	return acc.Decimal(acc.scale), nil
+}
+
+// StatementForPeriod runs StatementBalance for period: the opening balance
+// is as of the day before period.Start, bounded by priorClose, and the
+// closing balance is as of period.End, bounded by thisClose.
+func StatementForPeriod(ctx context.Context, client graphql.Client, accountID, journalID uuid.UUID, period DateRange, priorClose, thisClose Timestamp) (*StatementBalanceResponse, error) {
+	openDate := Date{period.Start.AddDate(0, 0, -1)}
+	resp, err := StatementBalance(ctx, client, accountID, journalID, openDate, period.End,
+		priorClose.UTC().Format(time.RFC3339Nano), thisClose.UTC().Format(time.RFC3339Nano))
+	if err != nil {
+		return nil, fmt.Errorf("statement for %s: %w", period, err)
+	}
+	return resp, nil
+}
+
+// ErrCarryForward is returned by StatementWithCarryForward when a period
+// opens at a different balance than the previous period closed at.
+var ErrCarryForward = errors.New("opening balance does not carry forward the prior close")
+
+// StatementWithCarryForward runs StatementForPeriod for each of periods in
+// order and checks the carry-forward invariant: every period's opening
+// balance equals the previous period's closing balance.
+//
+// closes holds each period's close cutoff, as returned by CloseStatement,
+// in the same order as periods; a zero Timestamp marks a period that is
+// still open and uses OpenCutoff. Period N opens as of closes[N-1], the
+// cutoff period N-1 closed at, so its opening is compared against period
+// N-1's closing balance at that same cutoff: transactions backdated into
+// period N-1 after it closed don't move either side. The first period has
+// no earlier close and opens as of its own cutoff. A mismatch means the
+// periods aren't contiguous and something was posted in the gap.
+//
+// It returns the statements up to and including the first period that
+// breaks the chain, with an error wrapping ErrCarryForward.
+func StatementWithCarryForward(ctx context.Context, client graphql.Client, accountID, journalID uuid.UUID, periods []DateRange, closes []Timestamp) ([]*StatementBalanceResponse, error) {
+	if len(periods) == 0 {
+		return nil, errors.New("statement chain: no periods")
+	}
+	if len(closes) != len(periods) {
+		return nil, fmt.Errorf("statement chain: %d close cutoffs for %d periods", len(closes), len(periods))
+	}
+	cutoffs := make([]Timestamp, len(closes))
+	for i, c := range closes {
+		cutoffs[i] = c
+		if c.IsZero() {
+			cutoffs[i] = OpenCutoff()
+		}
+	}
+	chain := make([]*StatementBalanceResponse, 0, len(periods))
+	var priorClose Decimal
+	for i, period := range periods {
+		openCutoff := cutoffs[i]
+		if i > 0 {
+			openCutoff = cutoffs[i-1]
+		}
+		resp, err := StatementForPeriod(ctx, client, accountID, journalID, period, openCutoff, cutoffs[i])
+		if err != nil {
+			return chain, err
+		}
+		chain = append(chain, resp)
+		open := Decimal("0")
+		if resp.Open != nil {
+			open = resp.Open.Available.NormalBalance.Units
+		}
+		if i > 0 && !open.Equal(priorClose) {
+			return chain, fmt.Errorf("statement for %s opens at %s, %s closed at %s: %w",
+				period, open, periods[i-1], priorClose, ErrCarryForward)
+		}
+		priorClose = "0"
+		if resp.Closed != nil {
+			priorClose = resp.Closed.Available.NormalBalance.Units
+		}
+	}
+	return chain, nil
+}
+
+// CloseStatement returns a close cutoff for StatementBalance, taken from a
+// no-op update of the journal. period only labels errors; Twisp records
+// nothing about it.
+//
+// Twisp has no native period close, so the update writes back the
+// description the journal already has. The
+// server-assigned modified timestamp of that update is later than every
+// transaction committed before the call, which makes it the authoritative
+// "modified < cutoff" bound for the period. The journal's fields are left
+// as they were, though a description changed concurrently between the read
+// and the write is overwritten with the value read. If the update doesn't
+// advance the journal's modified timestamp, CloseStatement fails rather
+// than return a stale cutoff.
+func CloseStatement(ctx context.Context, client graphql.Client, journalID uuid.UUID, period DateRange) (Timestamp, error) {
+	j, err := GetJournal(ctx, client, journalID)
+	if err != nil {
+		return Timestamp{}, fmt.Errorf("closing statement %s: %w", period, err)
+	}
+	resp, err := TouchJournal(ctx, client, journalID, j.Description)
+	if err != nil {
+		return Timestamp{}, fmt.Errorf("closing statement %s: %w", period, err)
+	}
+	modified := resp.UpdateJournal.Modified
+	if !modified.After(j.Modified.Time) {
+		return Timestamp{}, fmt.Errorf("closing statement %s: journal modified %s did not advance past %s",
+			period, modified.Format(time.RFC3339Nano), j.Modified.Format(time.RFC3339Nano))
+	}
+	return modified, nil
+}
+
+// NextCutoffAfter returns the first millisecond boundary strictly after ts.
+// Used as a "modified < cutoff" bound it includes everything up to and
+// including ts, even when ts carries sub-millisecond precision.
+func NextCutoffAfter(ts Timestamp) Timestamp {
+	return Timestamp{ts.Truncate(time.Millisecond).Add(time.Millisecond)}
+}
+
+// OpenCutoff returns a cutoff an hour past the package clock's now. As the
+// "this period" bound of a period that hasn't been closed it includes every
+// transaction committed so far.
+func OpenCutoff() Timestamp {
+	return Timestamp{now().Add(time.Hour)}
+}
+
+// CutoffFromTransaction returns the cutoff that includes the posted
+// transaction and everything committed before it.
+func CutoffFromTransaction(resp PostTransactionResponse) Timestamp {
+	return NextCutoffAfter(resp.PostTransaction.Created)
+}
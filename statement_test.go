@@ -0,0 +1,359 @@
+package eff
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloseStatement(t *testing.T) {
+	ctx, client := newLiveClient(t)
+
+	_, err := Setup(ctx, client, journalID, tranCodeID, account1ID, account2ID)
+	require.NoError(t, err)
+
+	for _, effective := range []Date{
+		NewDate(2026, time.January, 1),
+		NewDate(2026, time.January, 15),
+		NewDate(2026, time.January, 31),
+	} {
+		_, err := PostTransaction(ctx, client, uuid.New(), effective)
+		require.NoError(t, err)
+	}
+
+	before, err := GetJournal(ctx, client, journalID)
+	require.NoError(t, err)
+	january := DateRange{Start: NewDate(2026, time.January, 1), End: NewDate(2026, time.January, 31)}
+	cutoff, err := CloseStatement(ctx, client, journalID, january)
+	require.NoError(t, err)
+	after, err := GetJournal(ctx, client, journalID)
+	require.NoError(t, err)
+	require.Equal(t, before.Description, after.Description, "closing must not change the journal")
+
+	// Backdated into January after the close; must not move the statement.
+	_, err = PostTransactionWithStatementDate(ctx, client, uuid.New(),
+		NewDate(2026, time.January, 24), NewDate(2026, time.February, 15))
+	require.NoError(t, err)
+
+	cutoffStr := cutoff.Format(time.RFC3339Nano)
+	resp, err := StatementBalance(
+		ctx, client,
+		account1ID, journalID,
+		NewDate(2025, time.December, 31), january.End,
+		cutoffStr, cutoffStr,
+	)
+	require.NoError(t, err)
+	require.Equal(t, Decimal("0.00"), resp.Open.Available.NormalBalance.GetUnits())
+	require.Equal(t, Decimal("3.00"), resp.Closed.Available.NormalBalance.GetUnits())
+}
+
+func TestCloseStatementNoOpWrite(t *testing.T) {
+	modified := time.Date(2026, time.February, 1, 9, 0, 0, 0, time.UTC)
+	var touched *string
+	touchedAt := modified.Add(time.Second)
+	stub := clientFunc(func(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+		switch req.OpName {
+		case "FetchJournal":
+			resp.Data.(*FetchJournalResponse).Journal = &FetchJournalJournal{
+				Description: "Customer deposits",
+				Modified:    Timestamp{modified},
+			}
+		case "TouchJournal":
+			touched = Ptr(req.Variables.(*__TouchJournalInput).Description)
+			resp.Data.(*TouchJournalResponse).UpdateJournal = TouchJournalUpdateJournal{Modified: Timestamp{touchedAt}}
+		default:
+			t.Fatalf("unexpected operation %s", req.OpName)
+		}
+		return nil
+	})
+	january := DateRange{Start: NewDate(2026, time.January, 1), End: NewDate(2026, time.January, 31)}
+
+	cutoff, err := CloseStatement(context.Background(), stub, journalID, january)
+	require.NoError(t, err)
+	require.Equal(t, Ptr("Customer deposits"), touched, "the description must be written back unchanged")
+	require.Equal(t, Timestamp{touchedAt}, cutoff)
+
+	// A write that doesn't advance modified can't bound the period.
+	touchedAt = modified
+	_, err = CloseStatement(context.Background(), stub, journalID, january)
+	require.ErrorContains(t, err, "did not advance past")
+}
+
+func TestCutoffFromTransaction(t *testing.T) {
+	created, err := time.Parse(time.RFC3339Nano, "2026-01-31T18:04:05.123456789Z")
+	require.NoError(t, err)
+
+	resp := PostTransactionResponse{
+		PostTransaction: PostTransactionPostTransaction{Created: Timestamp{created}},
+	}
+	cutoff := CutoffFromTransaction(resp)
+	require.Equal(t, "2026-01-31T18:04:05.124Z", cutoff.Format(time.RFC3339Nano))
+	require.True(t, cutoff.After(created))
+
+	// Already on a millisecond boundary: still strictly after.
+	onBoundary := Timestamp{created.Truncate(time.Millisecond)}
+	require.Equal(t, "2026-01-31T18:04:05.124Z", NextCutoffAfter(onBoundary).Format(time.RFC3339Nano))
+}
+
+func TestMonthPeriod(t *testing.T) {
+	for _, tt := range []struct {
+		month      string
+		start, end Date
+	}{
+		{"2024-02", NewDate(2024, time.February, 1), NewDate(2024, time.February, 29)},
+		{"2026-02", NewDate(2026, time.February, 1), NewDate(2026, time.February, 28)},
+		{"2000-02", NewDate(2000, time.February, 1), NewDate(2000, time.February, 29)},
+		{"1900-02", NewDate(1900, time.February, 1), NewDate(1900, time.February, 28)},
+		{"2026-01", NewDate(2026, time.January, 1), NewDate(2026, time.January, 31)},
+		{"2026-04", NewDate(2026, time.April, 1), NewDate(2026, time.April, 30)},
+		{"2026-12", NewDate(2026, time.December, 1), NewDate(2026, time.December, 31)},
+	} {
+		t.Run(tt.month, func(t *testing.T) {
+			period, err := MonthPeriod(tt.month)
+			require.NoError(t, err)
+			require.Equal(t, DateRange{Start: tt.start, End: tt.end}, period)
+			require.Equal(t, tt.month, period.Month())
+		})
+	}
+
+	for _, bad := range []string{"", "2026-13", "2026-1", "2026-01-01", "Jan 2026"} {
+		_, err := MonthPeriod(bad)
+		require.Error(t, err, bad)
+	}
+}
+
+func TestMonthBoundaries(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		d           Date
+		first, last Date
+	}{
+		{"28-day February", NewDate(2026, time.February, 14), NewDate(2026, time.February, 1), NewDate(2026, time.February, 28)},
+		{"leap February", NewDate(2024, time.February, 14), NewDate(2024, time.February, 1), NewDate(2024, time.February, 29)},
+		{"century February", NewDate(1900, time.February, 14), NewDate(1900, time.February, 1), NewDate(1900, time.February, 28)},
+		{"30-day month", NewDate(2026, time.April, 30), NewDate(2026, time.April, 1), NewDate(2026, time.April, 30)},
+		{"31-day month", NewDate(2026, time.December, 1), NewDate(2026, time.December, 1), NewDate(2026, time.December, 31)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.first, tt.d.FirstOfMonth())
+			require.Equal(t, tt.last, tt.d.LastOfMonth())
+			require.True(t, tt.last.IsEndOfMonth())
+			require.False(t, tt.first.IsEndOfMonth())
+			require.False(t, Date{tt.last.AddDate(0, 0, -1)}.IsEndOfMonth())
+			require.Equal(t, tt.d == tt.last, tt.d.IsEndOfMonth())
+		})
+	}
+}
+
+func TestComputeClose(t *testing.T) {
+	credit := func(amount Decimal) Entry {
+		return Entry{Amount: amount, Currency: "USD", Direction: DebitOrCreditCredit, Normal: DebitOrCreditCredit}
+	}
+	debit := func(amount Decimal) Entry {
+		return Entry{Amount: amount, Currency: "USD", Direction: DebitOrCreditDebit, Normal: DebitOrCreditCredit}
+	}
+	for _, tt := range []struct {
+		name    string
+		open    Decimal
+		entries []Entry
+		want    Decimal
+	}{
+		{"no activity", "12.50", nil, "12.50"},
+		{"january", "0.00", []Entry{credit("1.00"), credit("1.00"), credit("1.00")}, "3.00"},
+		{"against normal side", "3.00", []Entry{debit("5.25")}, "-2.25"},
+		{"debit normal", "1.00", []Entry{{Amount: "0.005", Currency: "USD", Direction: DebitOrCreditDebit, Normal: DebitOrCreditDebit}}, "1.005"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ComputeClose(tt.open, tt.entries)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+
+	eur := credit("1.00")
+	eur.Currency = "EUR"
+	_, err := ComputeClose("0.00", []Entry{credit("1.00"), eur})
+	require.EqualError(t, err, "computing close: entry 1 is in EUR, not USD")
+	_, err = ComputeClose("0.00", []Entry{credit("1.0x")})
+	require.EqualError(t, err, `computing close: entry 0: invalid amount "1.0x"`)
+	_, err = ComputeClose("", nil)
+	require.Error(t, err)
+	_, err = ComputeClose("0.00", []Entry{{Amount: "1.00", Currency: "USD", Direction: DebitOrCreditCredit}})
+	require.Error(t, err)
+}
+
+func TestComputeCloseMatchesStatement(t *testing.T) {
+	ctx, client := newLiveClient(t)
+
+	_, err := Setup(ctx, client, journalID, tranCodeID, account1ID, account2ID)
+	require.NoError(t, err)
+	for _, effective := range []Date{
+		NewDate(2026, time.January, 1),
+		NewDate(2026, time.January, 15),
+		NewDate(2026, time.January, 31),
+	} {
+		_, err := PostTransaction(ctx, client, uuid.New(), effective)
+		require.NoError(t, err)
+	}
+
+	january, err := MonthPeriod("2026-01")
+	require.NoError(t, err)
+	cutoff, err := CloseStatement(ctx, client, journalID, january)
+	require.NoError(t, err)
+	resp, err := StatementForPeriod(ctx, client, account1ID, journalID, january, cutoff, cutoff)
+	require.NoError(t, err)
+
+	entries, err := DumpAccountEntries(ctx, client, account1ID, journalID.String(), listPageSize)
+	require.NoError(t, err)
+	var activity []Entry
+	for _, e := range entries.Account.Entries.Nodes {
+		if e.Layer != LayerSettled || e.Transaction.Effective.Month() != time.January {
+			continue
+		}
+		activity = append(activity, Entry{
+			Amount:    e.Amount.Units,
+			Currency:  e.Amount.Currency,
+			Direction: e.Direction,
+			Normal:    DebitOrCreditCredit,
+		})
+	}
+
+	open := resp.Open.Available.NormalBalance.Units
+	require.Equal(t, Decimal("0.00"), open)
+	got, err := ComputeClose(open, activity)
+	require.NoError(t, err)
+	require.Equal(t, Decimal("3.00"), got)
+	require.True(t, got.Equal(resp.Closed.Available.NormalBalance.Units), "computed %s, Twisp reported %s", got, resp.Closed.Available.NormalBalance.Units)
+}
+
+func TestStatementForPeriod(t *testing.T) {
+	var vars *__StatementBalanceInput
+	stub := clientFunc(func(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+		vars = req.Variables.(*__StatementBalanceInput)
+		return nil
+	})
+
+	feb, err := MonthPeriod("2026-02")
+	require.NoError(t, err)
+	priorClose := Timestamp{time.Date(2026, time.February, 1, 9, 30, 0, 0, time.UTC)}
+	thisClose := Timestamp{time.Date(2026, time.March, 1, 9, 30, 0, 0, time.UTC)}
+
+	_, err = StatementForPeriod(context.Background(), stub, account1ID, journalID, feb, priorClose, thisClose)
+	require.NoError(t, err)
+	require.Equal(t, NewDate(2026, time.January, 31), vars.OpenDate)
+	require.Equal(t, NewDate(2026, time.February, 28), vars.CloseDate)
+	require.Equal(t, "2026-02-01T09:30:00Z", vars.PriorPeriodCloseStamp)
+	require.Equal(t, "2026-03-01T09:30:00Z", vars.ThisPeriodCloseStamp)
+}
+
+func TestStatementWithCarryForwardGap(t *testing.T) {
+	// A ledger with 3.00 posted in January and 1.00 in February.
+	balanceAt := func(d Date) string {
+		switch {
+		case d.Before(NewDate(2026, time.January, 1).Time):
+			return "0.00"
+		case d.Before(NewDate(2026, time.February, 1).Time):
+			return "3.00"
+		}
+		return "4.00"
+	}
+	stub := clientFunc(func(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+		v := req.Variables.(*__StatementBalanceInput)
+		return json.Unmarshal([]byte(`{
+			"open": {"available": {"normalBalance": {"units": "`+balanceAt(v.OpenDate)+`"}}},
+			"closed": {"available": {"normalBalance": {"units": "`+balanceAt(v.CloseDate)+`"}}}
+		}`), resp.Data)
+	})
+	jan := Must(MonthPeriod("2026-01"))
+	feb := Must(MonthPeriod("2026-02"))
+	mar := Must(MonthPeriod("2026-03"))
+
+	chain, err := StatementWithCarryForward(context.Background(), stub, account1ID, journalID, []DateRange{jan, feb, mar}, make([]Timestamp, 3))
+	require.NoError(t, err)
+	require.Len(t, chain, 3)
+
+	// Skipping February loses its 1.00.
+	chain, err = StatementWithCarryForward(context.Background(), stub, account1ID, journalID, []DateRange{jan, mar}, make([]Timestamp, 2))
+	require.ErrorIs(t, err, ErrCarryForward)
+	require.ErrorContains(t, err, "opens at 4.00")
+	require.Len(t, chain, 2)
+
+	_, err = StatementWithCarryForward(context.Background(), stub, account1ID, journalID, nil, nil)
+	require.Error(t, err)
+	_, err = StatementWithCarryForward(context.Background(), stub, account1ID, journalID, []DateRange{jan, feb}, make([]Timestamp, 1))
+	require.EqualError(t, err, "statement chain: 1 close cutoffs for 2 periods")
+}
+
+func TestStatementWithCarryForwardCutoffs(t *testing.T) {
+	janClose := Timestamp{time.Date(2026, time.February, 1, 9, 0, 0, 0, time.UTC)}
+	febClose := Timestamp{time.Date(2026, time.March, 1, 9, 0, 0, 0, time.UTC)}
+	// 3.00 posted in January before it closed, then 0.50 backdated into
+	// January and 1.00 posted in February after January closed.
+	balanceAt := func(d Date, cutoff string) string {
+		switch {
+		case d.Before(NewDate(2026, time.January, 1).Time):
+			return "0.00"
+		case cutoff <= janClose.UTC().Format(time.RFC3339Nano):
+			return "3.00"
+		case d.Before(NewDate(2026, time.February, 1).Time):
+			return "3.50"
+		}
+		return "4.50"
+	}
+	var stamps [][2]string
+	stub := clientFunc(func(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+		v := req.Variables.(*__StatementBalanceInput)
+		stamps = append(stamps, [2]string{v.PriorPeriodCloseStamp, v.ThisPeriodCloseStamp})
+		return json.Unmarshal([]byte(`{
+			"open": {"available": {"normalBalance": {"units": "`+balanceAt(v.OpenDate, v.PriorPeriodCloseStamp)+`"}}},
+			"closed": {"available": {"normalBalance": {"units": "`+balanceAt(v.CloseDate, v.ThisPeriodCloseStamp)+`"}}}
+		}`), resp.Data)
+	})
+	jan := Must(MonthPeriod("2026-01"))
+	feb := Must(MonthPeriod("2026-02"))
+
+	chain, err := StatementWithCarryForward(context.Background(), stub, account1ID, journalID, []DateRange{jan, feb}, []Timestamp{janClose, febClose})
+	require.NoError(t, err)
+	require.Equal(t, [][2]string{
+		{"2026-02-01T09:00:00Z", "2026-02-01T09:00:00Z"},
+		{"2026-02-01T09:00:00Z", "2026-03-01T09:00:00Z"},
+	}, stamps, "February opens at January's close cutoff")
+	require.Equal(t, Decimal("3.00"), chain[0].Closed.Available.NormalBalance.Units)
+	require.Equal(t, Decimal("3.00"), chain[1].Open.Available.NormalBalance.Units)
+	require.Equal(t, Decimal("4.50"), chain[1].Closed.Available.NormalBalance.Units)
+}
+
+func TestStatementWithCarryForward(t *testing.T) {
+	ctx, client := newLiveClient(t)
+
+	_, err := Setup(ctx, client, journalID, tranCodeID, account1ID, account2ID)
+	require.NoError(t, err)
+	post := func(effective Date) {
+		t.Helper()
+		_, err := PostTransaction(ctx, client, uuid.New(), effective)
+		require.NoError(t, err)
+	}
+	post(NewDate(2026, time.January, 1))
+	post(NewDate(2026, time.January, 15))
+	post(NewDate(2026, time.January, 31))
+
+	jan := Must(MonthPeriod("2026-01"))
+	feb := Must(MonthPeriod("2026-02"))
+	janClose, err := CloseStatement(ctx, client, journalID, jan)
+	require.NoError(t, err)
+	// Backdated into the closed January, then February's own posting.
+	post(NewDate(2026, time.January, 20))
+	post(NewDate(2026, time.February, 15))
+
+	chain, err := StatementWithCarryForward(ctx, client, account1ID, journalID, []DateRange{jan, feb}, []Timestamp{janClose, {}})
+	require.NoError(t, err)
+	require.Len(t, chain, 2)
+	require.Equal(t, Decimal("3.00"), chain[0].Closed.Available.NormalBalance.Units)
+	require.Equal(t, Decimal("3.00"), chain[1].Open.Available.NormalBalance.Units)
+	require.Equal(t, Decimal("5.00"), chain[1].Closed.Available.NormalBalance.Units)
+}
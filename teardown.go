@@ -0,0 +1,66 @@
+package eff
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+)
+
+// Twisp never hard-deletes ledger records: deleting a journal, account or
+// tran code moves it to LOCKED, after which nothing can be posted against it.
+// The helpers below are idempotent; a record that is already locked or
+// doesn't exist is treated as deleted.
+
+// DeleteJournal locks the journal so no further transactions can post to it.
+func DeleteJournal(ctx context.Context, client graphql.Client, journalID uuid.UUID) error {
+	_, err := LockJournal(ctx, client, journalID)
+	if err == nil {
+		return nil
+	}
+	resp, lookupErr := JournalLockStatus(ctx, client, journalID)
+	if lookupErr == nil && (resp.Journal == nil || resp.Journal.Status == StatusLocked) {
+		return nil
+	}
+	return fmt.Errorf("deleting journal %s: %w", journalID, err)
+}
+
+// TeardownSeed removes the journal, accounts and tran codes created by a
+// seed. It attempts every record and reports all failures together.
+func TeardownSeed(ctx context.Context, client graphql.Client, seed Seed) error {
+	var errs []error
+	for _, id := range seed.TranCodeIDs {
+		errs = append(errs, deleteTranCode(ctx, client, id))
+	}
+	for _, id := range seed.AccountIDs {
+		errs = append(errs, deleteAccount(ctx, client, id))
+	}
+	errs = append(errs, DeleteJournal(ctx, client, seed.JournalID))
+	return errors.Join(errs...)
+}
+
+func deleteAccount(ctx context.Context, client graphql.Client, accountID uuid.UUID) error {
+	_, err := LockAccount(ctx, client, accountID)
+	if err == nil {
+		return nil
+	}
+	resp, lookupErr := AccountLockStatus(ctx, client, accountID)
+	if lookupErr == nil && (resp.Account == nil || resp.Account.Status == AccountStatusLocked) {
+		return nil
+	}
+	return fmt.Errorf("deleting account %s: %w", accountID, err)
+}
+
+func deleteTranCode(ctx context.Context, client graphql.Client, tranCodeID uuid.UUID) error {
+	_, err := LockTranCode(ctx, client, tranCodeID)
+	if err == nil {
+		return nil
+	}
+	resp, lookupErr := TranCodeLockStatus(ctx, client, tranCodeID)
+	if lookupErr == nil && (resp.TranCode == nil || resp.TranCode.Status == StatusLocked) {
+		return nil
+	}
+	return fmt.Errorf("deleting tran code %s: %w", tranCodeID, err)
+}
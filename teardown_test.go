@@ -0,0 +1,33 @@
+package eff
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeardownSeed(t *testing.T) {
+	ctx, client := newLiveClient(t)
+
+	s, err := RetailBankingJournal(ctx, client)
+	require.NoError(t, err)
+
+	require.NoError(t, TeardownSeed(ctx, client, s.Seed()))
+
+	resp, err := JournalLockStatus(ctx, client, s.JournalID)
+	require.NoError(t, err)
+	if resp.Journal != nil {
+		require.Equal(t, StatusLocked, resp.Journal.Status)
+	}
+
+	// Locked journals reject postings.
+	_, err = PostTransfer(ctx, client, uuid.New(), s.TranCode("transfer"),
+		s.Account("cash").ID, s.Account("checking").ID, Decimal("1.00"), NewDate(2026, time.January, 2))
+	require.Error(t, err)
+
+	// Deleting again is a no-op.
+	require.NoError(t, TeardownSeed(ctx, client, s.Seed()))
+	require.NoError(t, DeleteJournal(ctx, client, s.JournalID))
+}
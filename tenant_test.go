@@ -0,0 +1,108 @@
+package eff
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenantClient(t *testing.T) {
+	var mu sync.Mutex
+	var tenants []string
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		tenants = append(tenants, r.Header.Get(TenantHeader)+"/"+r.Header.Get("x-suite"))
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"data": {"entries": {"nodes": []}}}`)
+	}))
+	ln := &countingListener{Listener: srv.Listener}
+	srv.Listener = ln
+	srv.Start()
+	t.Cleanup(srv.Close)
+
+	tc := &TwispContainer{GraphQLEndpoint: srv.URL}
+	tenantClient := tc.NewTenantClient(http.Header{"x-suite": []string{"eff"}})
+	ctx := context.Background()
+
+	for _, tenant := range []string{"tenant-a", "tenant-b", "tenant-a"} {
+		_, err := ActivityQuery(ctx, tenantClient.As(tenant), nil, nil, nil)
+		require.NoError(t, err)
+	}
+	require.Equal(t, []string{"tenant-a/eff", "tenant-b/eff", "tenant-a/eff"}, tenants)
+	require.EqualValues(t, 1, ln.accepted.Load(), "tenants share one connection pool")
+}
+
+func TestTenantClientSingleFlight(t *testing.T) {
+	var mu sync.Mutex
+	seen := map[string]int{}
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		seen[r.Header.Get(TenantHeader)]++
+		mu.Unlock()
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"data": {"entries": {"nodes": []}}}`)
+	}))
+	t.Cleanup(srv.Close)
+	var releaseOnce sync.Once
+	unblock := func() { releaseOnce.Do(func() { close(release) }) }
+	defer unblock()
+
+	tc := &TwispContainer{GraphQLEndpoint: srv.URL}
+	tenantClient := tc.NewTenantClient(nil, WithSingleFlight())
+
+	var wg sync.WaitGroup
+	for _, tenant := range []string{"tenant-a", "tenant-b"} {
+		wg.Go(func() {
+			_, err := ActivityQuery(context.Background(), tenantClient.As(tenant), nil, nil, nil)
+			assert.NoError(t, err)
+		})
+	}
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(seen) == 2
+	}, 5*time.Second, 10*time.Millisecond, "identical queries from different tenants must not be coalesced")
+	unblock()
+	wg.Wait()
+	require.Equal(t, map[string]int{"tenant-a": 1, "tenant-b": 1}, seen)
+}
+
+func TestRequiredHeaders(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		fmt.Fprint(w, `{"data": {"entries": {"nodes": []}}}`)
+	}))
+	t.Cleanup(srv.Close)
+	tc := &TwispContainer{GraphQLEndpoint: srv.URL}
+	query := func(client graphql.Client) error {
+		_, err := ActivityQuery(context.Background(), client, nil, nil, nil)
+		return err
+	}
+
+	err := query(tc.NewGraphQLClient(nil))
+	require.ErrorIs(t, err, ErrMissingHeader)
+	require.ErrorContains(t, err, "missing required header x-twisp-account-id: pass it in NewGraphQLClient's headers, or use a TenantClient")
+	require.Zero(t, requests.Load(), "the request must not be sent")
+
+	err = query(tc.NewGraphQLClient(http.Header{"x-twisp-account-id": {"tenant"}}, WithRequiredHeaders(TenantHeader, "x-suite")))
+	require.ErrorIs(t, err, ErrMissingHeader)
+	require.ErrorContains(t, err, "missing required header x-suite")
+	require.Zero(t, requests.Load())
+
+	require.NoError(t, query(tc.NewTenantClient(nil).As("tenant")))
+	require.NoError(t, query(tc.NewGraphQLClient(nil, WithRequiredHeaders())))
+	require.EqualValues(t, 2, requests.Load())
+}
@@ -0,0 +1,51 @@
+package eff
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// Timing is the latency of one operation run by TimedWith.
+type Timing struct {
+	Name     string
+	Duration time.Duration
+	// Err is the operation's error, so hooks can tell failures apart.
+	Err error
+}
+
+// Timed runs fn and returns its result along with how long it took, for
+// benchmarking individual operations:
+//
+//	resp, d, err := eff.Timed(ctx, func(ctx context.Context) (*eff.PostTransactionResponse, error) {
+//		return eff.PostTransaction(ctx, client, eff.NewID(), effective)
+//	})
+//
+// The duration is measured on the monotonic wall clock, not the package
+// Clock, so it stays meaningful under WithClock. It is returned even when
+// fn fails.
+func Timed[T any](ctx context.Context, fn func(ctx context.Context) (T, error)) (T, time.Duration, error) {
+	start := time.Now()
+	v, err := fn(ctx)
+	return v, time.Since(start), err
+}
+
+// TimedWith is Timed that also reports the run to hook as a Timing named
+// name, e.g. LogTiming(t) to log every operation a test measures.
+func TimedWith[T any](ctx context.Context, name string, hook func(Timing), fn func(ctx context.Context) (T, error)) (T, time.Duration, error) {
+	v, d, err := Timed(ctx, fn)
+	hook(Timing{Name: name, Duration: d, Err: err})
+	return v, d, err
+}
+
+// LogTiming returns a TimedWith hook that logs each operation's latency to
+// tb.
+func LogTiming(tb testing.TB) func(Timing) {
+	return func(t Timing) {
+		if t.Err != nil {
+			tb.Logf("%s: took %s, failed: %v", t.Name, t.Duration, t.Err)
+			return
+		}
+		tb.Logf("%s: took %s", t.Name, t.Duration)
+	}
+}
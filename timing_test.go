@@ -0,0 +1,70 @@
+package eff
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// logRecorder captures what is logged to it.
+type logRecorder struct {
+	testing.TB
+	logs []string
+}
+
+func (r *logRecorder) Logf(format string, args ...any) {
+	r.logs = append(r.logs, fmt.Sprintf(format, args...))
+}
+
+func TestTimed(t *testing.T) {
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "passed")
+
+	got, d, err := Timed(ctx, func(ctx context.Context) (string, error) {
+		time.Sleep(time.Millisecond)
+		return ctx.Value(ctxKey{}).(string), nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, "passed", got)
+	require.GreaterOrEqual(t, d, time.Millisecond)
+
+	// A fixed package clock must not stop the measurement.
+	t.Cleanup(WithClock(FixedClock(time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC))))
+	errBoom := errors.New("boom")
+	n, d, err := Timed(ctx, func(context.Context) (int, error) {
+		time.Sleep(time.Millisecond)
+		return 7, errBoom
+	})
+	require.ErrorIs(t, err, errBoom)
+	require.Equal(t, 7, n)
+	require.Positive(t, d)
+}
+
+func TestTimedWith(t *testing.T) {
+	var timings []Timing
+	hook := func(tm Timing) { timings = append(timings, tm) }
+	errBoom := errors.New("boom")
+
+	got, d, err := TimedWith(context.Background(), "ok", hook, func(context.Context) (int, error) { return 1, nil })
+	require.NoError(t, err)
+	require.Equal(t, 1, got)
+	_, _, err = TimedWith(context.Background(), "fails", hook, func(context.Context) (int, error) { return 0, errBoom })
+	require.ErrorIs(t, err, errBoom)
+
+	require.Len(t, timings, 2)
+	require.Equal(t, Timing{Name: "ok", Duration: d}, timings[0])
+	require.Equal(t, "fails", timings[1].Name)
+	require.ErrorIs(t, timings[1].Err, errBoom)
+
+	rec := &logRecorder{TB: t}
+	LogTiming(rec)(Timing{Name: "PostTransaction", Duration: 3 * time.Millisecond})
+	LogTiming(rec)(Timing{Name: "PostTransaction", Duration: 3 * time.Millisecond, Err: errBoom})
+	require.Equal(t, []string{
+		"PostTransaction: took 3ms",
+		"PostTransaction: took 3ms, failed: boom",
+	}, rec.logs)
+}
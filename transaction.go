@@ -0,0 +1,145 @@
+package eff
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+)
+
+// TransactionEntry is one ledger entry written by a transaction.
+type TransactionEntry = FetchTransactionEntriesTransactionEntriesEntryConnectionNodesEntry
+
+// EntryPage is one page of a transaction's entries; pass PageInfo.EndCursor
+// as after to fetch the next one.
+type EntryPage = FetchTransactionEntriesTransactionEntriesEntryConnection
+
+// TransactionEntries fetches one page of the entries written by txID,
+// starting after the cursor after (nil for the first page). first is the
+// page size; nil uses listPageSize. It returns ErrNotFound if there is no
+// such transaction.
+func TransactionEntries(ctx context.Context, client graphql.Client, txID uuid.UUID, after *string, first *int) (*EntryPage, error) {
+	size := listPageSize
+	if first != nil {
+		size = *first
+	}
+	resp, err := FetchTransactionEntries(ctx, client, txID, size, after)
+	if isNotFound(err) || (err == nil && resp.Transaction == nil) {
+		return nil, fmt.Errorf("listing entries of transaction %s: %w", txID, ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("listing entries of transaction %s: %w", txID, err)
+	}
+	return &resp.Transaction.Entries, nil
+}
+
+// AllTransactionEntries pages through every entry written by txID with
+// TransactionEntries and returns them in the order Twisp lists them.
+func AllTransactionEntries(ctx context.Context, client graphql.Client, txID uuid.UUID) ([]*TransactionEntry, error) {
+	var entries []*TransactionEntry
+	var after *string
+	for {
+		page, err := TransactionEntries(ctx, client, txID, after, nil)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, page.Nodes...)
+		// An empty page can't advance the cursor, whatever hasNextPage says.
+		if !page.PageInfo.HasNextPage || page.PageInfo.EndCursor == nil || len(page.Nodes) == 0 {
+			return entries, nil
+		}
+		after = page.PageInfo.EndCursor
+	}
+}
+
+// Transaction is a transaction as DescribeTransaction renders it, either
+// read back from the ledger or about to be posted.
+type Transaction struct {
+	ID        uuid.UUID
+	Effective Date
+	// TranCode is the code of the transaction's tran code, e.g. "SIMPLE".
+	TranCode string
+	Legs     []*TransactionLeg
+}
+
+// TransactionLeg is one entry of a Transaction.
+type TransactionLeg struct {
+	// Account is the account's code, or its ID when the code isn't known.
+	Account   string
+	Direction DebitOrCredit
+	Amount    Decimal
+	Currency  string
+	// Layer is shown after the amount if set, e.g. "PENDING".
+	Layer string
+}
+
+// DescribeTransaction renders tx compactly for debugging output: a line
+// with its effective date, tran code and ID, then one indented line per leg
+// as account, DR or CR, and amount, with the columns aligned:
+//
+//	2026-01-10 SIMPLE 0198c0de-0000-7000-8000-000000000001
+//	  ERNIE.CHECKING  CR  1.00 USD
+//	  BERT.CHECKING   DR  1.00 USD
+//
+// Unset header fields are left out, nil legs are skipped, and a
+// transaction without legs gets a "(no entries)" line. The result has no
+// trailing newline.
+func DescribeTransaction(tx Transaction) string {
+	var b strings.Builder
+	b.WriteString(tx.header())
+	tw := tabwriter.NewWriter(&b, 0, 4, 2, ' ', 0)
+	legs := 0
+	for _, leg := range tx.Legs {
+		if leg == nil {
+			continue
+		}
+		legs++
+		cells := []string{leg.Account, directionCode(leg.Direction), strings.TrimSpace(string(leg.Amount) + " " + leg.Currency)}
+		if leg.Layer != "" {
+			cells = append(cells, leg.Layer)
+		}
+		fmt.Fprintf(tw, "\n  %s", strings.Join(cells, "\t"))
+	}
+	tw.Flush()
+	if legs == 0 {
+		b.WriteString("\n  (no entries)")
+	}
+	return b.String()
+}
+
+// header is DescribeTransaction's first line: tx's effective date, tran
+// code and ID, leaving out the unset ones.
+func (tx Transaction) header() string {
+	var fields []string
+	if !tx.Effective.IsZero() {
+		fields = append(fields, tx.Effective.Format(time.DateOnly))
+	}
+	if tx.TranCode != "" {
+		fields = append(fields, tx.TranCode)
+	}
+	if tx.ID != uuid.Nil {
+		fields = append(fields, tx.ID.String())
+	}
+	if len(fields) == 0 {
+		return "transaction"
+	}
+	return strings.Join(fields, " ")
+}
+
+// directionCode abbreviates d to DR or CR.
+func directionCode(d DebitOrCredit) string {
+	switch d {
+	case DebitOrCreditDebit:
+		return "DR"
+	case DebitOrCreditCredit:
+		return "CR"
+	case "":
+		return "??"
+	default:
+		return string(d)
+	}
+}
@@ -0,0 +1,128 @@
+package eff
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllTransactionEntriesPaging(t *testing.T) {
+	pages := map[string]string{
+		"": `{"transaction": {"entries": {"nodes": [
+				{"sequence": 1, "direction": "CREDIT"},
+				{"sequence": 2, "direction": "DEBIT"}
+			], "pageInfo": {"hasNextPage": true, "endCursor": "c1"}}}}`,
+		"c1": `{"transaction": {"entries": {"nodes": [
+				{"sequence": 3, "direction": "DEBIT"}
+			], "pageInfo": {"hasNextPage": false, "endCursor": "c2"}}}}`,
+		// A single page whose cursor points nowhere.
+		"one": `{"transaction": {"entries": {"nodes": [
+				{"sequence": 1, "direction": "CREDIT"}
+			], "pageInfo": {"hasNextPage": false}}}}`,
+	}
+	start := ""
+	var calls int
+	stub := clientFunc(func(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+		calls++
+		cursor := start
+		if after := req.Variables.(*__FetchTransactionEntriesInput).After; after != nil {
+			cursor = *after
+		}
+		return json.Unmarshal([]byte(pages[cursor]), resp.Data)
+	})
+
+	entries, err := AllTransactionEntries(context.Background(), stub, uuid.New())
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	for i, e := range entries {
+		require.Equal(t, i+1, e.Sequence)
+	}
+	require.Equal(t, 2, calls)
+
+	start, calls = "one", 0
+	entries, err = AllTransactionEntries(context.Background(), stub, uuid.New())
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, 1, calls)
+
+	null := clientFunc(func(_ context.Context, _ *graphql.Request, resp *graphql.Response) error {
+		return json.Unmarshal([]byte(`{"transaction": null}`), resp.Data)
+	})
+	_, err = TransactionEntries(context.Background(), null, uuid.New(), nil, nil)
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestTransactionEntries(t *testing.T) {
+	ctx, client := newLiveClient(t)
+
+	_, err := Setup(ctx, client, journalID, tranCodeID, account1ID, account2ID)
+	require.NoError(t, err)
+	txID, err := Transfer(ctx, client, journalID, account2ID, account1ID, "5.00", NewDate(2026, time.January, 10))
+	require.NoError(t, err)
+
+	// One entry per page, so the legs arrive on separate pages.
+	var entries []*TransactionEntry
+	var after *string
+	for pages := 1; ; pages++ {
+		require.LessOrEqual(t, pages, 3, "paging did not terminate")
+		page, err := TransactionEntries(ctx, client, txID, after, Ptr(1))
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(page.Nodes), 1)
+		entries = append(entries, page.Nodes...)
+		if !page.PageInfo.HasNextPage || len(page.Nodes) == 0 {
+			break
+		}
+		after = page.PageInfo.EndCursor
+	}
+	require.Len(t, entries, 2)
+
+	all, err := AllTransactionEntries(ctx, client, txID)
+	require.NoError(t, err)
+	require.ElementsMatch(t, all, entries)
+
+	legs := map[uuid.UUID]DebitOrCredit{}
+	for _, e := range all {
+		require.True(t, e.Amount.Units.Equal("5.00"), "units %s", e.Amount.Units)
+		legs[e.AccountId] = e.Direction
+	}
+	require.Equal(t, map[uuid.UUID]DebitOrCredit{
+		account1ID: DebitOrCreditCredit,
+		account2ID: DebitOrCreditDebit,
+	}, legs)
+
+	_, err = TransactionEntries(ctx, client, uuid.New(), nil, nil)
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestDescribeTransaction(t *testing.T) {
+	tx := Transaction{
+		ID:        uuid.MustParse("0198c0de-0000-7000-8000-000000000001"),
+		Effective: NewDate(2026, time.January, 10),
+		TranCode:  "SIMPLE",
+		Legs: []*TransactionLeg{
+			{Account: "ERNIE.CHECKING", Direction: DebitOrCreditCredit, Amount: "1.00", Currency: "USD"},
+			nil,
+			{Account: "BERT.CHECKING", Direction: DebitOrCreditDebit, Amount: "1.00", Currency: "USD"},
+		},
+	}
+	require.Equal(t, ""+
+		"2026-01-10 SIMPLE 0198c0de-0000-7000-8000-000000000001\n"+
+		"  ERNIE.CHECKING  CR  1.00 USD\n"+
+		"  BERT.CHECKING   DR  1.00 USD", DescribeTransaction(tx))
+
+	tx.Legs[0].Layer, tx.Legs[2].Layer = "PENDING", "PENDING"
+	tx.Legs[2].Amount, tx.Legs[2].Currency = "1000.00", ""
+	require.Equal(t, ""+
+		"2026-01-10 SIMPLE 0198c0de-0000-7000-8000-000000000001\n"+
+		"  ERNIE.CHECKING  CR  1.00 USD  PENDING\n"+
+		"  BERT.CHECKING   DR  1000.00   PENDING", DescribeTransaction(tx))
+
+	require.Equal(t, "transaction\n  (no entries)", DescribeTransaction(Transaction{}))
+	require.Equal(t, "SIMPLE\n  (no entries)", DescribeTransaction(Transaction{TranCode: "SIMPLE", Legs: []*TransactionLeg{nil}}))
+	require.Equal(t, "transaction\n  ACME  ??  5", DescribeTransaction(Transaction{Legs: []*TransactionLeg{{Account: "ACME", Amount: "5"}}}))
+}
@@ -0,0 +1,142 @@
+package eff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+)
+
+// listPageSize is the page size used when a helper walks a whole connection.
+const listPageSize = 100
+
+// AccountBalance is one line of a trial balance: an account's net settled
+// balance shown in either the debit or the credit column.
+type AccountBalance struct {
+	Code   string
+	Debit  Decimal
+	Credit Decimal
+}
+
+// TrialBalanceReport lists every account with a balance in a journal.
+type TrialBalanceReport struct {
+	JournalID uuid.UUID
+	AsOf      Date
+	Accounts  []AccountBalance
+}
+
+// Totals sums the debit and credit columns.
+func (r TrialBalanceReport) Totals() (debit, credit Decimal) {
+	debit, credit = "0", "0"
+	for _, a := range r.Accounts {
+		debit = debit.Add(a.Debit)
+		credit = credit.Add(a.Credit)
+	}
+	return debit, credit
+}
+
+// Balanced reports whether total debits equal total credits.
+func (r TrialBalanceReport) Balanced() bool {
+	debit, credit := r.Totals()
+	return debit.Cmp(credit) == 0
+}
+
+// TrialBalance reports the settled balance of every account in the journal,
+// cumulative through asOf, paging through all accounts. Accounts with no
+// entries in the journal are omitted. Accounts are listed by code, which
+// Twisp keeps unique, so the order is total and pages neither repeat nor
+// skip an account.
+func TrialBalance(ctx context.Context, client graphql.Client, journalID uuid.UUID, asOf Date) (TrialBalanceReport, error) {
+	report := TrialBalanceReport{JournalID: journalID, AsOf: asOf}
+	var after *string
+	for {
+		resp, err := ListAccounts(ctx, client, journalID, asOf, listPageSize, after)
+		if err != nil {
+			return TrialBalanceReport{}, fmt.Errorf("listing accounts: %w", err)
+		}
+		for _, n := range resp.Accounts.Nodes {
+			if n.Balance == nil {
+				continue
+			}
+			net := n.Balance.Settled.DrBalance.Units.Sub(n.Balance.Settled.CrBalance.Units)
+			line := AccountBalance{Code: n.Code, Debit: "0", Credit: "0"}
+			if net.Cmp("0") >= 0 {
+				line.Debit = net
+			} else {
+				line.Credit = Decimal("0").Sub(net)
+			}
+			report.Accounts = append(report.Accounts, line)
+		}
+		page := resp.Accounts.PageInfo
+		if !page.HasNextPage || page.EndCursor == nil {
+			return report, nil
+		}
+		after = page.EndCursor
+	}
+}
+
+// BalancesByMetadata sums the settled normal balances of the accounts with
+// a balance in journalID, cumulative through asOf, grouped by the value of
+// their metadata key, e.g. "product" to total each product line. String
+// values group as themselves and other values by their JSON encoding, so
+// {"tier": 2} groups under "2". Accounts without the key, or without
+// metadata, group under "". Normal balances are summed as they are, so
+// group accounts of one normal balance type.
+func BalancesByMetadata(ctx context.Context, client graphql.Client, journalID uuid.UUID, key string, asOf Date) (map[string]Decimal, error) {
+	sums := map[string]*DecimalAcc{}
+	var after *string
+	for {
+		resp, err := ListAccountsByMetadata(ctx, client, journalID, asOf, listPageSize, after)
+		if err != nil {
+			return nil, fmt.Errorf("listing accounts: %w", err)
+		}
+		for _, n := range resp.Accounts.Nodes {
+			if n.Balance == nil {
+				continue
+			}
+			units := n.Balance.Settled.NormalBalance.Units
+			if _, _, ok := units.unscaled(); !ok {
+				return nil, fmt.Errorf("account %s: invalid balance %q", n.AccountId, units)
+			}
+			group, err := metadataGroup(n.Metadata, key)
+			if err != nil {
+				return nil, fmt.Errorf("account %s: %w", n.AccountId, err)
+			}
+			if sums[group] == nil {
+				sums[group] = new(DecimalAcc)
+			}
+			sums[group].Add(units)
+		}
+		page := resp.Accounts.PageInfo
+		if !page.HasNextPage || page.EndCursor == nil {
+			break
+		}
+		after = page.EndCursor
+	}
+	out := make(map[string]Decimal, len(sums))
+	for group, acc := range sums {
+		out[group] = acc.Decimal(acc.scale)
+	}
+	return out, nil
+}
+
+// metadataGroup returns the group name for the value of key in meta.
+func metadataGroup(meta *map[string]any, key string) (string, error) {
+	if meta == nil {
+		return "", nil
+	}
+	switch v := (*meta)[key].(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", fmt.Errorf("encoding metadata %s: %w", key, err)
+		}
+		return string(b), nil
+	}
+}
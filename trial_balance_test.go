@@ -0,0 +1,104 @@
+package eff
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrialBalance(t *testing.T) {
+	ctx, client := newLiveClient(t)
+
+	_, err := Setup(ctx, client, journalID, tranCodeID, account1ID, account2ID)
+	require.NoError(t, err)
+	for _, effective := range []Date{
+		NewDate(2026, time.January, 1),
+		NewDate(2026, time.January, 15),
+		NewDate(2026, time.February, 15),
+	} {
+		_, err := PostTransaction(ctx, client, uuid.New(), effective)
+		require.NoError(t, err)
+	}
+
+	report, err := TrialBalance(ctx, client, journalID, NewDate(2026, time.January, 31))
+	require.NoError(t, err)
+	require.True(t, report.Balanced())
+	require.ElementsMatch(t, []AccountBalance{
+		{Code: "ERNIE.CHECKING", Debit: "0", Credit: "2.00"},
+		{Code: "BERT.CHECKING", Debit: "2.00", Credit: "0"},
+	}, report.Accounts)
+}
+
+func TestTrialBalanceReportBalanced(t *testing.T) {
+	report := TrialBalanceReport{Accounts: []AccountBalance{
+		{Code: "A", Debit: "5.00", Credit: "0"},
+		{Code: "B", Debit: "0", Credit: "3.00"},
+		{Code: "C", Debit: "0", Credit: "2.0"},
+	}}
+	require.True(t, report.Balanced())
+
+	report.Accounts = append(report.Accounts, AccountBalance{Code: "D", Debit: "0.01", Credit: "0"})
+	require.False(t, report.Balanced())
+	debit, credit := report.Totals()
+	require.Equal(t, Decimal("5.01"), debit)
+	require.Equal(t, Decimal("5.00"), credit)
+}
+
+func TestBalancesByMetadataPaging(t *testing.T) {
+	pages := []string{
+		`{"accounts": {"nodes": [
+			{"accountId": "` + uuid.NewString() + `", "metadata": {"product": "savings"}, "balance": {"settled": {"normalBalance": {"units": "10.00"}}}},
+			{"accountId": "` + uuid.NewString() + `", "metadata": {"product": "savings"}, "balance": {"settled": {"normalBalance": {"units": "2.5"}}}},
+			{"accountId": "` + uuid.NewString() + `", "metadata": {"product": "savings"}, "balance": null}
+		], "pageInfo": {"hasNextPage": true, "endCursor": "p1"}}}`,
+		`{"accounts": {"nodes": [
+			{"accountId": "` + uuid.NewString() + `", "metadata": {"tier": 2}, "balance": {"settled": {"normalBalance": {"units": "-1.00"}}}},
+			{"accountId": "` + uuid.NewString() + `", "metadata": null, "balance": {"settled": {"normalBalance": {"units": "4.00"}}}},
+			{"accountId": "` + uuid.NewString() + `", "metadata": {"product": 7}, "balance": {"settled": {"normalBalance": {"units": "1.00"}}}}
+		], "pageInfo": {"hasNextPage": false}}}`,
+	}
+	var afters []*string
+	stub := clientFunc(func(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+		v := req.Variables.(*__ListAccountsByMetadataInput)
+		afters = append(afters, v.After)
+		return json.Unmarshal([]byte(pages[len(afters)-1]), resp.Data)
+	})
+
+	got, err := BalancesByMetadata(context.Background(), stub, journalID, "product", NewDate(2026, time.January, 31))
+	require.NoError(t, err)
+	require.Equal(t, map[string]Decimal{"savings": "12.50", "": "3.00", "7": "1.00"}, got)
+	require.Equal(t, []*string{nil, Ptr("p1")}, afters)
+}
+
+func TestBalancesByMetadata(t *testing.T) {
+	ctx, client := newLiveClient(t)
+
+	_, err := Setup(ctx, client, journalID, tranCodeID, account1ID, account2ID)
+	require.NoError(t, err)
+	_, err = UpdateAccountMetadata(ctx, client, account1ID, map[string]any{"product": "savings"})
+	require.NoError(t, err)
+	_, err = UpdateAccountMetadata(ctx, client, account2ID, map[string]any{"product": "checking"})
+	require.NoError(t, err)
+	for _, effective := range []Date{
+		NewDate(2026, time.January, 1),
+		NewDate(2026, time.January, 15),
+		NewDate(2026, time.February, 15),
+	} {
+		_, err := PostTransaction(ctx, client, uuid.New(), effective)
+		require.NoError(t, err)
+	}
+
+	// Ernie is credited and Bert debited, both credit normal.
+	byProduct, err := BalancesByMetadata(ctx, client, journalID, "product", NewDate(2026, time.January, 31))
+	require.NoError(t, err)
+	require.Equal(t, map[string]Decimal{"savings": "2.00", "checking": "-2.00"}, byProduct)
+
+	byTier, err := BalancesByMetadata(ctx, client, journalID, "tier", NewDate(2026, time.February, 28))
+	require.NoError(t, err)
+	require.Equal(t, map[string]Decimal{"": "0.00"}, byTier)
+}
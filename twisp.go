@@ -1,20 +1,38 @@
 package eff
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"maps"
+	"math/rand/v2"
 	"net"
 	"net/http"
 	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 	"testing"
 	"time"
 
 	"github.com/Khan/genqlient/graphql"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+	"github.com/docker/go-connections/nat"
+	"github.com/google/uuid"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/wait"
+	"golang.org/x/sync/singleflight"
 )
 
 // TwispContainer wraps a testcontainers container running the Twisp local image.
@@ -22,6 +40,9 @@ type TwispContainer struct {
 	testcontainers.Container
 	GraphQLEndpoint string
 	KeepAlive       bool
+	// InitTenant is the tenant the WithInitScript scripts ran as, or ""
+	// if there were none. Send it as TenantHeader to see their records.
+	InitTenant string
 }
 
 // Cleanup terminates the container unless KeepAlive is set.
@@ -35,14 +56,58 @@ func (tc *TwispContainer) Cleanup(ctx context.Context, tb testing.TB) {
 	}
 }
 
+// EndpointFor returns the base URL, e.g. "http://localhost:32781", at which
+// the host reaches containerPort, one of the ports exposed by default or
+// with WithExposedPorts. A port without a protocol is taken as TCP. It fails
+// for a container from TWISP_ENDPOINT, whose other ports aren't known.
+func (tc *TwispContainer) EndpointFor(ctx context.Context, containerPort string) (string, error) {
+	if tc.Container == nil {
+		return "", fmt.Errorf("endpoint for %s: no container (TWISP_ENDPOINT is set)", containerPort)
+	}
+	if !strings.Contains(containerPort, "/") {
+		containerPort += "/tcp"
+	}
+	host, err := tc.Host(ctx)
+	if err != nil {
+		return "", fmt.Errorf("getting container host: %w", err)
+	}
+	mapped, err := tc.MappedPort(ctx, nat.Port(containerPort))
+	if err != nil {
+		return "", fmt.Errorf("getting mapped port %s: %w", containerPort, err)
+	}
+	return fmt.Sprintf("http://%s:%s", host, mapped.Port()), nil
+}
+
 // TwispOption configures StartTwisp.
 type TwispOption func(*twispConfig)
 
 type twispConfig struct {
-	tb        testing.TB
-	keepAlive bool
+	tb           testing.TB
+	keepAlive    bool
+	waitStrategy wait.Strategy
+	labels       map[string]string
+	healthPort   string
+	healthPath   string
+	progress     func(StartupEvent)
+	image        string
+	hostPorts    map[string]string
+	exposed      []string
+	reuse        string
+	dockerHost   string
+	initScripts  []func(context.Context, graphql.Client) error
 }
 
+// DefaultImage is the Twisp image StartTwisp runs unless overridden with
+// WithImage or WithImageFromEnv.
+const DefaultImage = "public.ecr.aws/twisp/local:latest"
+
+// LabelPackage is set on every container started by StartTwisp, and
+// LabelTestBinary records the name of the test binary that started it.
+const (
+	LabelPackage    = "com.github.parsnips.eff"
+	LabelTestBinary = "com.github.parsnips.eff.binary"
+)
+
 // WithTestLogger forwards container logs to the test output.
 func WithTestLogger(tb testing.TB) TwispOption {
 	return func(c *twispConfig) { c.tb = tb }
@@ -53,6 +118,266 @@ func WithKeepAlive() TwispOption {
 	return func(c *twispConfig) { c.keepAlive = true }
 }
 
+// WithWaitStrategy replaces the default readiness check entirely.
+func WithWaitStrategy(strategy wait.Strategy) TwispOption {
+	return func(c *twispConfig) { c.waitStrategy = strategy }
+}
+
+// WithHealthCheck moves the default readiness probe from GET /healthcheck
+// on 8080/tcp to path on port. A port without a protocol is taken as TCP,
+// and is exposed if it isn't already. It has no effect together with
+// WithWaitStrategy, which replaces the default probe entirely.
+func WithHealthCheck(port, path string) TwispOption {
+	return func(c *twispConfig) {
+		if !strings.Contains(port, "/") {
+			port += "/tcp"
+		}
+		c.healthPort, c.healthPath = port, path
+	}
+}
+
+// WithFixedHostPort publishes containerPort on hostPort instead of a random
+// host port, e.g. to point a debugger or CI tool at a known address. A port
+// without a protocol is taken as TCP, and is exposed if it isn't already.
+// Pinning 8080/tcp also fixes GraphQLEndpoint.
+//
+// A fixed port can only be bound once per host: parallel tests, or packages
+// run concurrently by go test, that pin the same port fail to start all but
+// one container. Reserve it for single debugging runs or CI jobs that own
+// the host.
+func WithFixedHostPort(containerPort, hostPort string) TwispOption {
+	return func(c *twispConfig) {
+		if !strings.Contains(containerPort, "/") {
+			containerPort += "/tcp"
+		}
+		if c.hostPorts == nil {
+			c.hostPorts = make(map[string]string)
+		}
+		c.hostPorts[containerPort] = hostPort
+	}
+}
+
+// WithExposedPorts exposes ports in addition to 3000, 8080 and 8081, e.g. a
+// metrics or debug endpoint of a custom image; find them on the host with
+// EndpointFor. A port without a protocol is taken as TCP.
+func WithExposedPorts(ports ...string) TwispOption {
+	return func(c *twispConfig) {
+		for _, p := range ports {
+			if !strings.Contains(p, "/") {
+				p += "/tcp"
+			}
+			c.exposed = append(c.exposed, p)
+		}
+	}
+}
+
+// WithReuse gives the container a name and reuses it if a container by that
+// name already exists, e.g. one shared by every package of a CI run, instead
+// of starting a new one. Concurrent StartTwisp calls in this process with
+// the same name are serialized so they share a single container; calls with
+// different names, or without WithReuse, don't wait on each other. Cleanup
+// still terminates the shared container unless WithKeepAlive is also given,
+// but a StartTwisp that fails after attaching to it leaves it running, since
+// other tests may be using it.
+func WithReuse(name string) TwispOption {
+	return func(c *twispConfig) { c.reuse = name }
+}
+
+// WithInitScript runs script once StartTwisp's container is ready, e.g. to
+// create the journal and accounts every test in a suite shares. Scripts
+// run in the order given, through a client for a fresh tenant that
+// StartTwisp records as InitTenant; if one fails, StartTwisp returns the
+// error and terminates the container, unless it was reused (see
+// WithReuse). With TWISP_ENDPOINT set they run against that endpoint
+// instead.
+func WithInitScript(script func(ctx context.Context, client graphql.Client) error) TwispOption {
+	return func(c *twispConfig) { c.initScripts = append(c.initScripts, script) }
+}
+
+// runInitScripts runs cfg's init scripts against tc as a new tenant.
+func runInitScripts(ctx context.Context, tc *TwispContainer, cfg twispConfig) error {
+	if len(cfg.initScripts) == 0 {
+		return nil
+	}
+	tc.InitTenant = uuid.NewString()
+	client := tc.NewGraphQLClient(http.Header{TenantHeader: []string{tc.InitTenant}})
+	for i, script := range cfg.initScripts {
+		if err := script(ctx, client); err != nil {
+			return fmt.Errorf("running init script %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// reuseLocks holds a *sync.Mutex per WithReuse name. testcontainers looks
+// a reused container up by name before creating it, so two unserialized
+// starts can both miss and create one each.
+var reuseLocks sync.Map
+
+func reuseLock(name string) *sync.Mutex {
+	mu, _ := reuseLocks.LoadOrStore(name, new(sync.Mutex))
+	return mu.(*sync.Mutex)
+}
+
+// WithDockerHost runs the container on the Docker daemon at host, e.g.
+// "tcp://docker:2375" for a docker-in-docker CI service or
+// "unix:///run/user/1000/docker.sock" for rootless Docker. Without it the
+// daemon comes from DOCKER_HOST, then testcontainers' own discovery
+// (~/.testcontainers.properties, the default socket).
+//
+// The Docker clients this package opens itself are built with host
+// directly. testcontainers has no provider option for the daemon: it
+// discovers it once per process, from DOCKER_HOST first, and caches the
+// result. So DOCKER_HOST is set to host only while the first StartTwisp
+// makes testcontainers discover the daemon, and restored right after.
+// Every StartTwisp call in the process must agree on the host:
+// a call asking for a different one than the one already in use fails. A
+// tc.host entry in ~/.testcontainers.properties still takes precedence.
+func WithDockerHost(host string) TwispOption {
+	return func(c *twispConfig) { c.dockerHost = host }
+}
+
+// dockerHost records the daemon this process uses once a StartTwisp or
+// PruneOrphaned call has settled on it (empty for testcontainers' own
+// discovery), and whether testcontainers has discovered it yet.
+var dockerHost struct {
+	sync.Mutex
+	resolved   bool
+	host       string
+	discovered bool
+}
+
+// useDockerHost settles on a WithDockerHost setting (empty for none) before
+// talking to Docker, failing if the process already uses another daemon.
+// It doesn't touch the environment.
+func useDockerHost(host string) error {
+	dockerHost.Lock()
+	defer dockerHost.Unlock()
+	if !dockerHost.resolved {
+		dockerHost.resolved, dockerHost.host = true, host
+		return nil
+	}
+	if host != "" && host != dockerHost.host {
+		current := strconv.Quote(dockerHost.host)
+		if dockerHost.host == "" {
+			current = "the default daemon"
+		}
+		return fmt.Errorf("docker host %q: this process already uses %s (testcontainers resolves the daemon once per process)", host, current)
+	}
+	return nil
+}
+
+// dockerClient opens a Docker client on the daemon useDockerHost settled on.
+func dockerClient(ctx context.Context) (*testcontainers.DockerClient, error) {
+	dockerHost.Lock()
+	host := dockerHost.host
+	dockerHost.Unlock()
+	var opts []client.Opt
+	if host != "" {
+		opts = append(opts, client.WithHost(host), client.WithAPIVersionNegotiation())
+	}
+	return testcontainers.NewDockerClientWithOpts(ctx, opts...)
+}
+
+// discoverDockerHost makes testcontainers discover the daemon useDockerHost
+// settled on, before anything else in the process talks to Docker through
+// it. With a configured host, DOCKER_HOST is set to it only while
+// testcontainers discovers and caches the daemon, then restored. It fails
+// if testcontainers settled on a different daemon, e.g. from a tc.host
+// property.
+func discoverDockerHost(ctx context.Context) error {
+	dockerHost.Lock()
+	defer dockerHost.Unlock()
+	if dockerHost.discovered {
+		return nil
+	}
+	host := dockerHost.host
+	if host != "" {
+		prev, had := os.LookupEnv("DOCKER_HOST")
+		if err := os.Setenv("DOCKER_HOST", host); err != nil {
+			return fmt.Errorf("setting DOCKER_HOST: %w", err)
+		}
+		defer func() {
+			if had {
+				os.Setenv("DOCKER_HOST", prev)
+			} else {
+				os.Unsetenv("DOCKER_HOST")
+			}
+		}()
+	}
+	cli, err := testcontainers.NewDockerClientWithOpts(ctx)
+	if err != nil {
+		return fmt.Errorf("connecting to docker: %w", err)
+	}
+	defer cli.Close()
+	if host != "" && cli.DaemonHost() != host {
+		return fmt.Errorf("docker host %q: testcontainers resolved %q instead", host, cli.DaemonHost())
+	}
+	dockerHost.discovered = true
+	return nil
+}
+
+// WithImage runs image instead of DefaultImage, e.g. to pin a Twisp
+// release.
+func WithImage(image string) TwispOption {
+	return func(c *twispConfig) { c.image = image }
+}
+
+// WithImageFromEnv runs the image named by the environment variable key,
+// so CI can test several Twisp tags without code changes. An unset or
+// empty variable leaves the image unchanged. Like other options, a later
+// WithImage overrides it.
+func WithImageFromEnv(key string) TwispOption {
+	return func(c *twispConfig) {
+		if image := os.Getenv(key); image != "" {
+			c.image = image
+		}
+	}
+}
+
+// WithLabels adds container labels on top of the defaults (LabelPackage and
+// LabelTestBinary), e.g. a CI job ID for PruneOrphaned to select on later.
+func WithLabels(labels map[string]string) TwispOption {
+	return func(c *twispConfig) {
+		if c.labels == nil {
+			c.labels = make(map[string]string, len(labels))
+		}
+		maps.Copy(c.labels, labels)
+	}
+}
+
+// StartupStage names a step of container startup reported by
+// WithStartupProgress.
+type StartupStage string
+
+const (
+	// StartupPullStart is sent before the image is pulled, or found to be
+	// present locally.
+	StartupPullStart StartupStage = "pull-start"
+	// StartupPullComplete is sent once the image is available and the
+	// container is about to be created.
+	StartupPullComplete StartupStage = "pull-complete"
+	// StartupContainerStarted is sent when the container is running but not
+	// yet ready.
+	StartupContainerStarted StartupStage = "container-started"
+	// StartupHealthy is sent when the wait strategy has passed.
+	StartupHealthy StartupStage = "healthy"
+)
+
+// StartupEvent is a single progress report from StartTwisp.
+type StartupEvent struct {
+	Stage StartupStage
+	Time  time.Time
+}
+
+// WithStartupProgress calls fn as startup moves through each StartupStage,
+// in order, so a slow start can be attributed to the image pull or to the
+// readiness wait. fn is called synchronously from StartTwisp. No events are
+// sent when TWISP_ENDPOINT is set.
+func WithStartupProgress(fn func(StartupEvent)) TwispOption {
+	return func(c *twispConfig) { c.progress = fn }
+}
+
 // StartTwisp launches the Twisp local container and waits for the healthcheck.
 // If the TWISP_ENDPOINT environment variable is set (e.g. "http://localhost:8080"),
 // the container is skipped and the tests run against that endpoint instead.
@@ -64,32 +389,35 @@ func StartTwisp(ctx context.Context, opts ...TwispOption) (*TwispContainer, erro
 
 	if endpoint := os.Getenv("TWISP_ENDPOINT"); endpoint != "" {
 		graphqlEndpoint := strings.TrimRight(endpoint, "/") + "/financial/v1/graphql"
-		return &TwispContainer{
+		tc := &TwispContainer{
 			GraphQLEndpoint: graphqlEndpoint,
 			KeepAlive:       true,
-		}, nil
+		}
+		if err := runInitScripts(ctx, tc, cfg); err != nil {
+			return nil, err
+		}
+		return tc, nil
 	}
 
-	var logConsumers []testcontainers.LogConsumer
-	if cfg.tb != nil {
-		logConsumers = append(logConsumers, &testLogConsumer{tb: cfg.tb})
+	if err := useDockerHost(cfg.dockerHost); err != nil {
+		return nil, err
+	}
+	if err := discoverDockerHost(ctx); err != nil {
+		return nil, err
 	}
 
-	req := testcontainers.ContainerRequest{
-		Image:        "public.ecr.aws/twisp/local:latest",
-		ExposedPorts: []string{"3000/tcp", "8080/tcp", "8081/tcp"},
-		WaitingFor: wait.ForHTTP("/healthcheck").
-			WithPort("8080/tcp").
-			WithStartupTimeout(120 * time.Second),
-		LogConsumerCfg: &testcontainers.LogConsumerConfig{
-			Consumers: logConsumers,
-		},
+	if cfg.reuse != "" {
+		mu := reuseLock(cfg.reuse)
+		mu.Lock()
+		defer mu.Unlock()
 	}
 
-	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
-		ContainerRequest: req,
-		Started:          true,
-	})
+	req := containerRequest(cfg)
+
+	if cfg.progress != nil {
+		cfg.progress(StartupEvent{Stage: StartupPullStart, Time: now()})
+	}
+	container, err := startContainer(ctx, req, cfg.reuse != "")
 	if err != nil {
 		return nil, fmt.Errorf("starting twisp container: %w", err)
 	}
@@ -99,35 +427,507 @@ func StartTwisp(ctx context.Context, opts ...TwispOption) (*TwispContainer, erro
 		return nil, fmt.Errorf("getting container host: %w", err)
 	}
 
-	port, err := container.MappedPort(ctx, "8080/tcp")
-	if err != nil {
-		return nil, fmt.Errorf("getting mapped port: %w", err)
+	port, ok := cfg.hostPorts["8080/tcp"]
+	if !ok {
+		mapped, err := container.MappedPort(ctx, "8080/tcp")
+		if err != nil {
+			return nil, fmt.Errorf("getting mapped port: %w", err)
+		}
+		port = mapped.Port()
 	}
 
-	endpoint := fmt.Sprintf("http://%s:%s/financial/v1/graphql", host, port.Port())
+	endpoint := fmt.Sprintf("http://%s:%s/financial/v1/graphql", host, port)
 
-	return &TwispContainer{
+	tc := &TwispContainer{
 		Container:       container,
 		GraphQLEndpoint: endpoint,
 		KeepAlive:       cfg.keepAlive,
-	}, nil
+	}
+
+	// A reused container may be serving other tests, so a failed start
+	// leaves it running.
+	abandon := func(err error) error {
+		if cfg.reuse != "" {
+			return err
+		}
+		return errors.Join(err, container.Terminate(ctx))
+	}
+
+	// The healthcheck can pass before the GraphQL schema is loaded.
+	if cfg.waitStrategy == nil {
+		schemaCtx, cancel := context.WithTimeout(ctx, schemaTimeout)
+		defer cancel()
+		probe := tc.NewGraphQLClient(http.Header{TenantHeader: []string{uuid.NewString()}}, WithNoRetry())
+		if err := WaitForSchema(schemaCtx, probe); err != nil {
+			return nil, abandon(err)
+		}
+	}
+	if err := runInitScripts(ctx, tc, cfg); err != nil {
+		return nil, abandon(err)
+	}
+	return tc, nil
 }
 
-// NewGraphQLClient creates a genqlient GraphQL client pointing at this container.
-// Any provided headers are sent with every request. Transient connection errors
-// are retried automatically.
-func (tc *TwispContainer) NewGraphQLClient(headers http.Header) graphql.Client {
-	httpClient := &http.Client{
-		Transport: &retryTransport{
-			base: &headerTransport{
-				base:    http.DefaultTransport,
-				headers: headers,
+// startContainer creates and starts req's container, or with reuse set
+// attaches to the one named req.Name, as testcontainers.GenericContainer
+// would. It does so through a single provider, which first pulls the image
+// if it is missing (see pullImage); a running reused container needs no
+// image check at all.
+func startContainer(ctx context.Context, req testcontainers.ContainerRequest, reuse bool) (testcontainers.Container, error) {
+	generic, err := testcontainers.ProviderDefault.GetProvider()
+	if err != nil {
+		return nil, err
+	}
+	defer generic.Close()
+	provider, ok := generic.(*testcontainers.DockerProvider)
+	if !ok {
+		return nil, fmt.Errorf("unsupported container provider %T", generic)
+	}
+
+	running := false
+	if reuse {
+		list, err := provider.Client().ContainerList(ctx, container.ListOptions{Filters: filters.NewArgs(
+			filters.Arg("name", "^/"+req.Name+"$"),
+			filters.Arg("status", "running"),
+		)})
+		if err != nil {
+			return nil, fmt.Errorf("looking up container %s: %w", req.Name, err)
+		}
+		running = len(list) > 0
+	}
+	if !running {
+		if err := pullImage(ctx, provider, req); err != nil {
+			return nil, err
+		}
+	}
+
+	var c testcontainers.Container
+	if reuse {
+		c, err = provider.ReuseOrCreateContainer(ctx, req)
+	} else {
+		c, err = provider.CreateContainer(ctx, req)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create container: %w", err)
+	}
+	if !c.IsRunning() {
+		if err := c.Start(ctx); err != nil {
+			return nil, errors.Join(fmt.Errorf("start container: %w", err), c.Terminate(ctx))
+		}
+	}
+	return c, nil
+}
+
+// schemaTimeout bounds StartTwisp's wait for the GraphQL schema once the
+// container reports healthy.
+const schemaTimeout = 60 * time.Second
+
+// schemaPollInterval is how often WaitForSchema retries.
+var schemaPollInterval = 250 * time.Millisecond
+
+// WaitForSchema runs a trivial introspection query through client until it
+// succeeds, so the first schema-dependent operation doesn't race schema
+// loading. It returns the last error once ctx is done. StartTwisp already
+// does this unless WithWaitStrategy replaces the default readiness check.
+func WaitForSchema(ctx context.Context, client graphql.Client) error {
+	for {
+		_, err := SchemaReady(ctx, client)
+		if err == nil {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for GraphQL schema: %w", errors.Join(ctx.Err(), err))
+		case <-time.After(schemaPollInterval):
+		}
+	}
+}
+
+// PruneOrphaned force-removes every container, running or not, that carries
+// all of labels. A nil or empty map selects every container started by this
+// package. It returns the number of containers removed.
+func PruneOrphaned(ctx context.Context, labels map[string]string) (int, error) {
+	if err := useDockerHost(""); err != nil {
+		return 0, err
+	}
+	if err := discoverDockerHost(ctx); err != nil {
+		return 0, err
+	}
+	cli, err := dockerClient(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("connecting to docker: %w", err)
+	}
+	defer cli.Close()
+
+	list, err := cli.ContainerList(ctx, container.ListOptions{All: true, Filters: labelFilters(labels)})
+	if err != nil {
+		return 0, fmt.Errorf("listing containers: %w", err)
+	}
+	var removed int
+	for _, c := range list {
+		if err := cli.ContainerRemove(ctx, c.ID, container.RemoveOptions{Force: true, RemoveVolumes: true}); err != nil {
+			return removed, fmt.Errorf("removing container %s: %w", c.ID, err)
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// labelFilters builds a docker filter matching containers with all labels.
+func labelFilters(labels map[string]string) filters.Args {
+	args := filters.NewArgs()
+	if len(labels) == 0 {
+		args.Add("label", LabelPackage)
+	}
+	for k, v := range labels {
+		args.Add("label", k+"="+v)
+	}
+	return args
+}
+
+// ClientOption configures NewGraphQLClient.
+type ClientOption func(*clientConfig)
+
+type clientConfig struct {
+	retryBudget  time.Duration
+	noJitter     bool
+	noRetry      bool
+	singleFlight bool
+	transport    *transportConfig
+	opTimeout    time.Duration
+	interceptors []Interceptor
+
+	persistedQueries bool
+	maxQueryCost     int
+	costHooks        []func(QueryCost)
+	requiredHeaders  *[]string
+	retryHooks       []func(attempt int, err error, delay time.Duration)
+	validateMetadata bool
+}
+
+// transportConfig sizes the connection pool of the base http.Transport.
+type transportConfig struct {
+	maxIdleConns   int
+	maxIdlePerHost int
+	idleTimeout    time.Duration
+}
+
+// WithRetryBudget caps the total time the client spends sleeping between
+// retries, summed across all of its requests. Once the budget is spent,
+// failing requests return their last error instead of retrying.
+func WithRetryBudget(d time.Duration) ClientOption {
+	return func(c *clientConfig) { c.retryBudget = d }
+}
+
+// WithRetryJitter toggles full jitter on the retry backoff. Jitter is on by
+// default so parallel clients don't retry a recovering container in lockstep.
+func WithRetryJitter(enabled bool) ClientOption {
+	return func(c *clientConfig) { c.noJitter = !enabled }
+}
+
+// containerRequest builds the testcontainers request for cfg.
+func containerRequest(cfg twispConfig) testcontainers.ContainerRequest {
+	var logConsumers []testcontainers.LogConsumer
+	if cfg.tb != nil {
+		logConsumers = append(logConsumers, &testLogConsumer{tb: cfg.tb})
+	}
+
+	healthPort, healthPath := "8080/tcp", "/healthcheck"
+	if cfg.healthPort != "" {
+		healthPort, healthPath = cfg.healthPort, cfg.healthPath
+	}
+
+	waitStrategy := cfg.waitStrategy
+	if waitStrategy == nil {
+		waitStrategy = defaultWaitStrategy(healthPort, healthPath)
+	}
+
+	exposed := []string{"3000/tcp", "8080/tcp", "8081/tcp"}
+	if !slices.Contains(exposed, healthPort) {
+		exposed = append(exposed, healthPort)
+	}
+	for _, p := range slices.Concat(cfg.exposed, slices.Sorted(maps.Keys(cfg.hostPorts))) {
+		if !slices.Contains(exposed, p) {
+			exposed = append(exposed, p)
+		}
+	}
+
+	labels := map[string]string{
+		LabelPackage:    "true",
+		LabelTestBinary: filepath.Base(os.Args[0]),
+	}
+	maps.Copy(labels, cfg.labels)
+
+	var hooks []testcontainers.ContainerLifecycleHooks
+	if cfg.progress != nil {
+		hooks = append(hooks, startupHooks(cfg.progress))
+	}
+
+	image := cfg.image
+	if image == "" {
+		image = DefaultImage
+	}
+
+	return testcontainers.ContainerRequest{
+		Name:         cfg.reuse,
+		Image:        image,
+		ExposedPorts: exposed,
+		Labels:       labels,
+		WaitingFor:   waitStrategy,
+		LogConsumerCfg: &testcontainers.LogConsumerConfig{
+			Consumers: logConsumers,
+		},
+		LifecycleHooks:     hooks,
+		HostConfigModifier: portBindings(cfg.hostPorts),
+	}
+}
+
+// portBindings returns a HostConfigModifier that binds each container port
+// in hostPorts to its host port, or nil to keep testcontainers' defaults.
+// testcontainers merges these bindings for exposed ports and maps the rest
+// to random host ports.
+func portBindings(hostPorts map[string]string) func(*container.HostConfig) {
+	if len(hostPorts) == 0 {
+		return nil
+	}
+	return func(hc *container.HostConfig) {
+		if hc.PortBindings == nil {
+			hc.PortBindings = nat.PortMap{}
+		}
+		for cp, hp := range hostPorts {
+			hc.PortBindings[nat.Port(cp)] = []nat.PortBinding{{HostPort: hp}}
+		}
+	}
+}
+
+// startupHooks maps testcontainers lifecycle hooks onto the stages after
+// StartupPullStart. testcontainers pulls the image before running the
+// PreCreates hooks, so reaching them means the pull is done.
+func startupHooks(fn func(StartupEvent)) testcontainers.ContainerLifecycleHooks {
+	emit := func(stage StartupStage) {
+		fn(StartupEvent{Stage: stage, Time: now()})
+	}
+	return testcontainers.ContainerLifecycleHooks{
+		PreCreates: []testcontainers.ContainerRequestHook{
+			func(context.Context, testcontainers.ContainerRequest) error {
+				emit(StartupPullComplete)
+				return nil
 			},
+		},
+		PostStarts: []testcontainers.ContainerHook{
+			func(context.Context, testcontainers.Container) error {
+				emit(StartupContainerStarted)
+				return nil
+			},
+		},
+		PostReadies: []testcontainers.ContainerHook{
+			func(context.Context, testcontainers.Container) error {
+				emit(StartupHealthy)
+				return nil
+			},
+		},
+	}
+}
+
+// defaultWaitStrategy waits for both the healthcheck and Twisp's "ready"
+// log line; on cold starts the healthcheck alone can pass too early.
+func defaultWaitStrategy(port, path string) wait.Strategy {
+	return wait.ForAll(
+		wait.ForHTTP(path).WithPort(nat.Port(port)),
+		wait.ForLog("ready"),
+	).WithDeadline(120 * time.Second)
+}
+
+// WithRetryHook calls fn before each retry sleep with the number of the
+// attempt that just failed (1 for the first), its error and the delay
+// before the next attempt, e.g. to count retries or tune WithRetryBudget.
+// Requests that fail for good aren't reported, nor are retries the
+// budget refuses. fn may be called concurrently.
+func WithRetryHook(fn func(attempt int, err error, delay time.Duration)) ClientOption {
+	return func(c *clientConfig) { c.retryHooks = append(c.retryHooks, fn) }
+}
+
+// WithNoRetry sends every request exactly once, so negative-path tests see
+// connection errors immediately instead of after the backoff schedule.
+func WithNoRetry() ClientOption {
+	return func(c *clientConfig) { c.noRetry = true }
+}
+
+// WithTransportConfig gives the client its own connection pool sized by
+// maxIdleConns (total), maxIdlePerHost and idleTimeout, instead of the
+// package's shared default pool.
+func WithTransportConfig(maxIdleConns, maxIdlePerHost int, idleTimeout time.Duration) ClientOption {
+	return func(c *clientConfig) {
+		c.transport = &transportConfig{
+			maxIdleConns:   maxIdleConns,
+			maxIdlePerHost: maxIdlePerHost,
+			idleTimeout:    idleTimeout,
+		}
+	}
+}
+
+// defaultTransport is shared by every client without WithTransportConfig.
+// Every request goes to the one Twisp host, so unlike http.DefaultTransport
+// (2 idle connections per host) it keeps enough idle connections for
+// parallel tests to reuse rather than redial.
+var defaultTransport = newTransport(transportConfig{
+	maxIdleConns:   100,
+	maxIdlePerHost: 100,
+	idleTimeout:    90 * time.Second,
+})
+
+func newTransport(cfg transportConfig) *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.MaxIdleConns = cfg.maxIdleConns
+	t.MaxIdleConnsPerHost = cfg.maxIdlePerHost
+	t.IdleConnTimeout = cfg.idleTimeout
+	return t
+}
+
+// WithDefaultOpTimeout bounds every operation made through the client to d,
+// so a stalled request fails on its own instead of hanging until the test
+// times out. A caller's context with an earlier deadline still wins.
+func WithDefaultOpTimeout(d time.Duration) ClientOption {
+	return func(c *clientConfig) { c.opTimeout = d }
+}
+
+// withOpTimeout wraps client so each request runs under a context bounded
+// by d. A zero d returns client unchanged.
+func withOpTimeout(client graphql.Client, d time.Duration) graphql.Client {
+	if d <= 0 {
+		return client
+	}
+	return &opTimeoutClient{Client: client, timeout: d}
+}
+
+type opTimeoutClient struct {
+	graphql.Client
+	timeout time.Duration
+}
+
+func (c *opTimeoutClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	// WithTimeout keeps the parent's deadline when it is earlier.
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	return c.Client.MakeRequest(ctx, req, resp)
+}
+
+// WithSingleFlight coalesces concurrent identical queries (same document and
+// variables) from this client into one round trip whose response is shared
+// by every caller. Mutations are never coalesced.
+func WithSingleFlight() ClientOption {
+	return func(c *clientConfig) { c.singleFlight = true }
+}
+
+// NewGraphQLClient creates a genqlient GraphQL client pointing at this container.
+// Any provided headers are sent with every request; they must include
+// TenantHeader, or requests fail with ErrMissingHeader (see
+// WithRequiredHeaders). Transient connection errors are retried automatically.
+func (tc *TwispContainer) NewGraphQLClient(headers http.Header, opts ...ClientOption) graphql.Client {
+	var cfg clientConfig
+	for _, o := range opts {
+		o(&cfg)
+	}
+
+	client := withStatusErrors(graphql.NewClient(tc.GraphQLEndpoint, newHTTPClient(headers, cfg)))
+	return withOpTimeout(withMetadataValidation(client, cfg.validateMetadata), cfg.opTimeout)
+}
+
+// testEndpoint is where NewTestClient sends requests; the .invalid TLD
+// guarantees nothing real answers if rt forwards them.
+const testEndpoint = "http://twisp.invalid/financial/v1/graphql"
+
+// NewTestClient returns a client that hands every request straight to rt,
+// without the retry, header and other layers NewGraphQLClient adds, for
+// hermetic unit tests of operation wrappers. Requests are addressed to a
+// placeholder endpoint; rt answers them, typically with canned JSON.
+// Non-200 responses still fail with a *TwispError.
+func NewTestClient(rt http.RoundTripper) graphql.Client {
+	return withStatusErrors(graphql.NewClient(testEndpoint, &http.Client{Transport: rt}))
+}
+
+// Interceptor wraps the next http.RoundTripper in a client's transport
+// stack, e.g. to log, trace or measure requests. It is called once, when
+// the client is built.
+type Interceptor func(next http.RoundTripper) http.RoundTripper
+
+// WithInterceptor adds an interceptor to the client. Interceptors run in the
+// order they are added, outside the built-in stack: the first one added sees
+// each request first and its response last, and every interceptor sees one
+// request per operation, however many attempts retries take.
+func WithInterceptor(i Interceptor) ClientOption {
+	return func(c *clientConfig) { c.interceptors = append(c.interceptors, i) }
+}
+
+// newHTTPClient assembles the transport stack for NewGraphQLClient. From
+// the outside in: user interceptors, query cost, single-flight, idempotency
+// keys, persisted queries, retries, headers, the required-header check,
+// then the pooled base transport.
+func newHTTPClient(headers http.Header, cfg clientConfig) *http.Client {
+	var base http.RoundTripper = defaultTransport
+	if cfg.transport != nil {
+		base = newTransport(*cfg.transport)
+	}
+	chain := slices.Clone(cfg.interceptors)
+	if cfg.maxQueryCost > 0 || len(cfg.costHooks) > 0 {
+		chain = append(chain, func(next http.RoundTripper) http.RoundTripper {
+			return &queryCostTransport{base: next, max: cfg.maxQueryCost, hooks: cfg.costHooks}
+		})
+	}
+	if cfg.singleFlight {
+		chain = append(chain, func(next http.RoundTripper) http.RoundTripper {
+			return &singleFlightTransport{base: next}
+		})
+	}
+	chain = append(chain, func(next http.RoundTripper) http.RoundTripper {
+		return &idempotencyTransport{base: next}
+	})
+	if cfg.persistedQueries {
+		chain = append(chain, func(next http.RoundTripper) http.RoundTripper {
+			return &persistedQueryTransport{base: next}
+		})
+	}
+	chain = append(chain, retryInterceptor(cfg), func(next http.RoundTripper) http.RoundTripper {
+		return &headerTransport{base: next, headers: headers}
+	})
+	if names := cfg.requiredHeaderNames(); len(names) > 0 {
+		chain = append(chain, func(next http.RoundTripper) http.RoundTripper {
+			return &requireHeadersTransport{base: next, names: names}
+		})
+	}
+	return &http.Client{Transport: chainInterceptors(base, chain)}
+}
+
+// chainInterceptors wraps base in chain so that chain[0] is outermost.
+func chainInterceptors(base http.RoundTripper, chain []Interceptor) http.RoundTripper {
+	rt := base
+	for _, wrap := range slices.Backward(chain) {
+		rt = wrap(rt)
+	}
+	return rt
+}
+
+// retryInterceptor configures a retryTransport from cfg.
+func retryInterceptor(cfg clientConfig) Interceptor {
+	return func(next http.RoundTripper) http.RoundTripper {
+		rt := &retryTransport{
+			base:       next,
 			maxRetries: 5,
 			baseDelay:  200 * time.Millisecond,
-		},
+		}
+		if cfg.retryBudget > 0 {
+			rt.budget = &retryBudget{remaining: cfg.retryBudget}
+		}
+		if !cfg.noJitter {
+			rt.jitter = rand.Int64N
+		}
+		if cfg.noRetry {
+			rt.maxRetries = 1
+			rt.baseDelay = 0
+		}
+		rt.hooks = cfg.retryHooks
+		return rt
 	}
-	return graphql.NewClient(tc.GraphQLEndpoint, httpClient)
 }
 
 type headerTransport struct {
@@ -141,7 +941,39 @@ func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 			req.Header.Add(key, v)
 		}
 	}
-	return t.base.RoundTrip(req)
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		drainClose(resp)
+		return nil, err
+	}
+	// genqlient stops reading once it has decoded the JSON value, which can
+	// leave trailing bytes unread and cost the connection.
+	resp.Body = &drainOnClose{resp.Body}
+	return resp, nil
+}
+
+// maxDrain bounds how much of an unread body drainClose will discard to
+// keep a connection alive; past that, a new connection is cheaper.
+const maxDrain = 64 << 10
+
+// drainClose discards what is left of resp's body, up to maxDrain, and
+// closes it so the underlying connection can be reused. resp may be nil.
+func drainClose(resp *http.Response) {
+	if resp == nil || resp.Body == nil {
+		return
+	}
+	io.Copy(io.Discard, io.LimitReader(resp.Body, maxDrain))
+	resp.Body.Close()
+}
+
+// drainOnClose drains a response body before closing it.
+type drainOnClose struct {
+	io.ReadCloser
+}
+
+func (b *drainOnClose) Close() error {
+	io.Copy(io.Discard, io.LimitReader(b.ReadCloser, maxDrain))
+	return b.ReadCloser.Close()
 }
 
 // retryTransport retries requests on transient connection errors (ECONNREFUSED, ECONNRESET).
@@ -149,13 +981,23 @@ type retryTransport struct {
 	base       http.RoundTripper
 	maxRetries int
 	baseDelay  time.Duration
+	budget     *retryBudget // shared across a client; nil means unlimited
+	// jitter returns a random value in [0, n); nil disables jitter.
+	jitter func(n int64) int64
+	hooks  []func(attempt int, err error, delay time.Duration)
 }
 
 func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
 	var lastErr error
 	for attempt := range t.maxRetries {
-		// Clone the request body for retries.
-		cloned := req.Clone(req.Context())
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		// Clone the request body for retries. The clone shares req's context
+		// so a cancellation reaches the in-flight attempt.
+		cloned := req.Clone(ctx)
 		if req.Body != nil && req.GetBody != nil {
 			body, err := req.GetBody()
 			if err != nil {
@@ -168,23 +1010,176 @@ func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 		if err == nil {
 			return resp, nil
 		}
+		drainClose(resp)
 
+		// A cancelled context often surfaces as a dial error, which would
+		// otherwise look transient.
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
 		if !isTransient(err) {
 			return nil, err
 		}
 		lastErr = err
+		if attempt == t.maxRetries-1 {
+			break
+		}
 
-		delay := t.baseDelay * (1 << attempt)
+		delay := t.backoff(attempt)
+		if t.budget != nil {
+			var ok bool
+			if delay, ok = t.budget.take(delay); !ok {
+				return nil, lastErr
+			}
+		}
+		for _, hook := range t.hooks {
+			hook(attempt+1, err, delay)
+		}
+		timer := time.NewTimer(delay)
 		select {
-		case <-time.After(delay):
-		case <-req.Context().Done():
-			return nil, req.Context().Err()
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
 		}
 	}
 	return nil, lastErr
 }
 
+// backoff returns the delay before the retry following attempt. With jitter
+// enabled the delay is drawn uniformly from [0, baseDelay * 2^attempt].
+func (t *retryTransport) backoff(attempt int) time.Duration {
+	ceiling := t.baseDelay * (1 << attempt)
+	if t.jitter == nil || ceiling <= 0 {
+		return ceiling
+	}
+	return time.Duration(t.jitter(int64(ceiling) + 1))
+}
+
+// retryBudget tracks the sleep time a client may still spend on retries.
+type retryBudget struct {
+	mu        sync.Mutex
+	remaining time.Duration
+}
+
+// take reserves up to d from the budget and returns the granted duration.
+// It reports false once the budget is exhausted.
+func (b *retryBudget) take(d time.Duration) (time.Duration, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.remaining <= 0 {
+		return 0, false
+	}
+	d = min(d, b.remaining)
+	b.remaining -= d
+	return d, true
+}
+
+// singleFlightTransport shares one round trip between concurrent requests
+// with identical GraphQL query bodies.
+type singleFlightTransport struct {
+	base  http.RoundTripper
+	group singleflight.Group
+}
+
+// sharedResponse is a fully read response that each caller can rebuild.
+type sharedResponse struct {
+	status int
+	header http.Header
+	body   []byte
+}
+
+func (t *singleFlightTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body == nil || req.GetBody == nil {
+		return t.base.RoundTrip(req)
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	payload, err := io.ReadAll(body)
+	body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	var gqlReq struct {
+		Query string `json:"query"`
+	}
+	if err := json.Unmarshal(payload, &gqlReq); err != nil || operationType(gqlReq.Query) != "query" {
+		return t.base.RoundTrip(req)
+	}
+
+	sum := sha256.Sum256(payload)
+	// Identical queries from different tenants must not share a response.
+	key := req.URL.String() + "\x00" + req.Header.Get(TenantHeader) + "\x00" + string(sum[:])
+	v, err, _ := t.group.Do(key, func() (any, error) {
+		resp, err := t.base.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+		defer drainClose(resp)
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		return &sharedResponse{status: resp.StatusCode, header: resp.Header, body: b}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	shared := v.(*sharedResponse)
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", shared.status, http.StatusText(shared.status)),
+		StatusCode:    shared.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        shared.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(shared.body)),
+		ContentLength: int64(len(shared.body)),
+		Request:       req,
+	}, nil
+}
+
+// operationType returns "query", "mutation" or "subscription" for a GraphQL
+// document, judged by its first operation keyword. Shorthand documents
+// starting with "{" are queries.
+func operationType(doc string) string {
+	for line := range strings.Lines(doc) {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		for _, op := range []string{"mutation", "subscription"} {
+			if strings.HasPrefix(line, op) {
+				return op
+			}
+		}
+		return "query"
+	}
+	return "query"
+}
+
+// isTransient reports whether err is a connection-level failure worth
+// retrying. Certificate validation failures and DNS answers such as "no
+// such host" are permanent and are never retried.
 func isTransient(err error) bool {
+	var certErr *tls.CertificateVerificationError
+	var unknownAuthority x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	var invalidCert x509.CertificateInvalidError
+	if errors.As(err, &certErr) || errors.As(err, &unknownAuthority) ||
+		errors.As(err, &hostnameErr) || errors.As(err, &invalidCert) {
+		return false
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTemporary || dnsErr.IsTimeout
+	}
+	if isTLSHandshakeTimeout(err) {
+		return true
+	}
 	if errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET) {
 		return true
 	}
@@ -195,6 +1190,14 @@ func isTransient(err error) bool {
 	return false
 }
 
+// isTLSHandshakeTimeout matches net/http's unexported error for a server
+// that accepted the connection but didn't finish the TLS handshake in time.
+func isTLSHandshakeTimeout(err error) bool {
+	var timeout interface{ Timeout() bool }
+	return errors.As(err, &timeout) && timeout.Timeout() &&
+		strings.Contains(err.Error(), "TLS handshake timeout")
+}
+
 // testLogConsumer forwards container logs to testing.TB.
 type testLogConsumer struct {
 	tb testing.TB
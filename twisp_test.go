@@ -2,16 +2,36 @@ package eff
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
+	"path/filepath"
+	"runtime"
 	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
 	"testing"
 	"time"
 
+	"github.com/Khan/genqlient/graphql"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/go-connections/nat"
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
 )
 
 // Well-known IDs
@@ -20,6 +40,9 @@ var (
 	tranCodeID = uuid.MustParse("4e6acb34-7ecf-48d3-9892-df400be1998e")
 	account1ID = uuid.MustParse("1fd1dd3e-33fe-4ef5-9d58-676ef8d306b5") // Ernie
 	account2ID = uuid.MustParse("6c6affb0-5cf5-402b-8d84-01bfc1624a2c") // Bert
+
+	// simpleMetaTranCodeID is SIMPLE_META, created by SetupSimpleMeta.
+	simpleMetaTranCodeID = uuid.MustParse("c7d9c4c3-14bb-482b-bd25-3869387dbdd0")
 )
 
 func TestPointInTimeEffectiveAndStatementDates(t *testing.T) {
@@ -69,13 +92,13 @@ func TestPointInTimeEffectiveAndStatementDates(t *testing.T) {
 			require.Equal(t, txID, resp.PostTransaction.TransactionId)
 			// Set the closeStamp on the last january transaction
 			if i == 2 {
-				closeStamp = resp.GetPostTransaction().Created
+				closeStamp = CutoffFromTransaction(*resp)
 			}
 		})
 
 	}
 
-	janCloseStampStr := closeStamp.Time.Add(1 * time.Millisecond).Format(time.RFC3339Nano)
+	janCloseStampStr := closeStamp.Format(time.RFC3339Nano)
 
 	// An "adjustment" transaction
 	// effective in Jan _past_ the cutoff
@@ -115,7 +138,7 @@ func TestPointInTimeEffectiveAndStatementDates(t *testing.T) {
 			openDate, closeDate,
 			janCloseStampStr,
 			// Close for february in the future
-			time.Now().Add(1*time.Hour).UTC().Format(time.RFC3339Nano),
+			OpenCutoff().UTC().Format(time.RFC3339Nano),
 		)
 		require.NoError(t, err)
 
@@ -268,11 +291,11 @@ func TestParallelRuns(t *testing.T) {
 				require.Equal(tt, txID, postResp.PostTransaction.TransactionId)
 				// Set the closeStamp on the last january transaction
 				if i == 2 {
-					closeStamp = postResp.GetPostTransaction().Created
+					closeStamp = CutoffFromTransaction(*postResp)
 				}
 			}
 
-			janCloseStampStr := closeStamp.Time.Add(1 * time.Millisecond).Format(time.RFC3339Nano)
+			janCloseStampStr := closeStamp.Format(time.RFC3339Nano)
 
 			// An "adjustment" transaction
 			// effective in Jan _past_ the cutoff
@@ -308,7 +331,7 @@ func TestParallelRuns(t *testing.T) {
 				openDate, closeDate,
 				janCloseStampStr,
 				// Close for february in the future
-				time.Now().Add(1*time.Hour).UTC().Format(time.RFC3339Nano),
+				OpenCutoff().UTC().Format(time.RFC3339Nano),
 			)
 			require.NoError(tt, err)
 
@@ -349,6 +372,501 @@ func TestParallelRuns(t *testing.T) {
 	}
 }
 
+func TestRetryBudget(t *testing.T) {
+	var calls atomic.Int32
+	refused := &net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}
+	rt := &retryTransport{
+		base: roundTripFunc(func(*http.Request) (*http.Response, error) {
+			calls.Add(1)
+			return nil, refused
+		}),
+		maxRetries: 100,
+		baseDelay:  10 * time.Millisecond,
+		budget:     &retryBudget{remaining: 100 * time.Millisecond},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "http://twisp.invalid/graphql", nil)
+	start := time.Now()
+	_, err := rt.RoundTrip(req)
+	elapsed := time.Since(start)
+
+	require.ErrorIs(t, err, syscall.ECONNREFUSED)
+	require.GreaterOrEqual(t, elapsed, 100*time.Millisecond)
+	require.Less(t, elapsed, 500*time.Millisecond)
+	require.Less(t, calls.Load(), int32(100))
+
+	// The budget is spent, so the next request gives up after one attempt.
+	calls.Store(0)
+	_, err = rt.RoundTrip(req)
+	require.ErrorIs(t, err, syscall.ECONNREFUSED)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestWithRetryHook(t *testing.T) {
+	var calls int
+	refused := &net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}
+	base := roundTripFunc(func(*http.Request) (*http.Response, error) {
+		calls++
+		if calls <= 3 {
+			return nil, refused
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	})
+
+	type retry struct {
+		attempt int
+		err     error
+		delay   time.Duration
+	}
+	var retries []retry
+	var cfg clientConfig
+	WithRetryJitter(false)(&cfg)
+	WithRetryHook(func(attempt int, err error, delay time.Duration) {
+		retries = append(retries, retry{attempt, err, delay})
+	})(&cfg)
+	rt := retryInterceptor(cfg)(base).(*retryTransport)
+	rt.baseDelay = time.Millisecond
+
+	resp, err := rt.RoundTrip(httptest.NewRequest(http.MethodPost, "http://twisp.invalid/graphql", nil))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, []retry{
+		{1, refused, time.Millisecond},
+		{2, refused, 2 * time.Millisecond},
+		{3, refused, 4 * time.Millisecond},
+	}, retries)
+
+	// A request that exhausts its attempts isn't retried after the last.
+	calls, retries = -100, nil
+	_, err = rt.RoundTrip(httptest.NewRequest(http.MethodPost, "http://twisp.invalid/graphql", nil))
+	require.ErrorIs(t, err, syscall.ECONNREFUSED)
+	require.Len(t, retries, rt.maxRetries-1)
+	require.Equal(t, rt.maxRetries-1, retries[len(retries)-1].attempt)
+}
+
+func TestRetryJitter(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	rt := &retryTransport{
+		maxRetries: 5,
+		baseDelay:  200 * time.Millisecond,
+		jitter:     rng.Int64N,
+	}
+
+	seen := map[time.Duration]bool{}
+	for attempt := range rt.maxRetries {
+		ceiling := rt.baseDelay * (1 << attempt)
+		delay := rt.backoff(attempt)
+		require.GreaterOrEqual(t, delay, time.Duration(0))
+		require.LessOrEqual(t, delay, ceiling)
+		seen[delay] = true
+	}
+	require.Len(t, seen, rt.maxRetries, "jittered delays should vary across attempts")
+
+	rt.jitter = nil
+	require.Equal(t, 800*time.Millisecond, rt.backoff(2))
+}
+
+func TestRetryCancelInFlight(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	var calls atomic.Int32
+	rt := &retryTransport{
+		base: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			calls.Add(1)
+			<-req.Context().Done()
+			// Cancellation during dial looks like a transient dial error.
+			return nil, &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("operation was canceled")}
+		}),
+		maxRetries: 5,
+		baseDelay:  time.Second,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	req := httptest.NewRequest(http.MethodPost, "http://twisp.invalid/graphql", nil).WithContext(ctx)
+	start := time.Now()
+	_, err := rt.RoundTrip(req)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Less(t, time.Since(start), 500*time.Millisecond)
+	require.Equal(t, int32(1), calls.Load(), "no retry after cancellation")
+
+	// require.Eventually runs its condition on another goroutine, so poll by hand.
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.LessOrEqual(t, runtime.NumGoroutine(), before, "goroutines leaked")
+}
+
+func TestWithNoRetry(t *testing.T) {
+	rt := newHTTPClient(nil, clientConfig{}).Transport.(*idempotencyTransport).base.(*retryTransport)
+	require.Equal(t, 5, rt.maxRetries)
+
+	rt = newHTTPClient(nil, clientConfig{noRetry: true}).Transport.(*idempotencyTransport).base.(*retryTransport)
+	require.Equal(t, 1, rt.maxRetries)
+	require.Zero(t, rt.baseDelay)
+
+	// Grab a free port and close it so connections are refused.
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	require.NoError(t, ln.Close())
+
+	tc := &TwispContainer{GraphQLEndpoint: "http://" + ln.Addr().String() + "/financial/v1/graphql"}
+	client := tc.NewGraphQLClient(tenantHeader(), WithNoRetry())
+
+	start := time.Now()
+	_, err = ActivityQuery(context.Background(), client, nil, nil, nil)
+	require.ErrorIs(t, err, syscall.ECONNREFUSED)
+	require.Less(t, time.Since(start), 100*time.Millisecond)
+}
+
+// countingListener counts accepted connections.
+type countingListener struct {
+	net.Listener
+	accepted atomic.Int32
+}
+
+func (l *countingListener) Accept() (net.Conn, error) {
+	c, err := l.Listener.Accept()
+	if err == nil {
+		l.accepted.Add(1)
+	}
+	return c, err
+}
+
+func TestConnectionReuse(t *testing.T) {
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"data": {"entries": {"nodes": []}}}`)
+		// Trailing whitespace that the JSON decoder never reads.
+		fmt.Fprint(w, strings.Repeat(" ", 32<<10))
+	}))
+	ln := &countingListener{Listener: srv.Listener}
+	srv.Listener = ln
+	srv.Start()
+	t.Cleanup(srv.Close)
+
+	tc := &TwispContainer{GraphQLEndpoint: srv.URL}
+	client := tc.NewGraphQLClient(tenantHeader())
+	for range 50 {
+		_, err := ActivityQuery(context.Background(), client, nil, nil, nil)
+		require.NoError(t, err)
+	}
+	require.EqualValues(t, 1, ln.accepted.Load())
+}
+
+func TestWithTransportConfig(t *testing.T) {
+	base := func(c *http.Client) http.RoundTripper {
+		return c.Transport.(*idempotencyTransport).base.(*retryTransport).base.(*headerTransport).base.(*requireHeadersTransport).base
+	}
+	require.Same(t, defaultTransport, base(newHTTPClient(nil, clientConfig{})))
+
+	var cfg clientConfig
+	WithTransportConfig(10, 4, time.Minute)(&cfg)
+	tr := base(newHTTPClient(nil, cfg)).(*http.Transport)
+	require.Equal(t, 10, tr.MaxIdleConns)
+	require.Equal(t, 4, tr.MaxIdleConnsPerHost)
+	require.Equal(t, time.Minute, tr.IdleConnTimeout)
+
+	// Under parallel load a pool that keeps only two idle connections per
+	// host, like http.DefaultTransport, keeps dialing new ones.
+	dials := func(opts ...ClientOption) int32 {
+		srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(time.Millisecond)
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"data": {"entries": {"nodes": []}}}`)
+		}))
+		ln := &countingListener{Listener: srv.Listener}
+		srv.Listener = ln
+		srv.Start()
+		defer srv.Close()
+
+		client := (&TwispContainer{GraphQLEndpoint: srv.URL}).NewGraphQLClient(tenantHeader(), opts...)
+		// Bursts of parallel requests leave 16 connections idle between
+		// rounds, more than a narrow pool will keep.
+		for range 10 {
+			var wg sync.WaitGroup
+			for range 16 {
+				wg.Go(func() {
+					_, err := ActivityQuery(context.Background(), client, nil, nil, nil)
+					assert.NoError(t, err)
+				})
+			}
+			wg.Wait()
+		}
+		return ln.accepted.Load()
+	}
+	narrow := dials(WithTransportConfig(100, 2, 90*time.Second))
+	wide := dials(WithTransportConfig(100, 64, 90*time.Second))
+	t.Logf("connections dialed: %d with 2 idle per host, %d with 64", narrow, wide)
+	require.LessOrEqual(t, wide, int32(16))
+	require.Less(t, wide, narrow)
+}
+
+func TestWithDefaultOpTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The server notices a client hanging up only once the body is read.
+		_, _ = io.Copy(io.Discard, r.Body)
+		select {
+		case <-r.Context().Done():
+		case <-time.After(10 * time.Second):
+		}
+	}))
+	t.Cleanup(srv.Close)
+	tc := &TwispContainer{GraphQLEndpoint: srv.URL}
+
+	client := tc.NewGraphQLClient(tenantHeader(), WithDefaultOpTimeout(200*time.Millisecond))
+	start := time.Now()
+	_, err := SchemaReady(context.Background(), client)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), 2*time.Second)
+
+	// A caller's earlier deadline still applies.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	client = tc.NewGraphQLClient(tenantHeader(), WithDefaultOpTimeout(time.Minute))
+	start = time.Now()
+	_, err = SchemaReady(ctx, client)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), 2*time.Second)
+
+	tenant := tc.NewTenantClient(nil, WithDefaultOpTimeout(200*time.Millisecond)).As(uuid.NewString())
+	_, err = SchemaReady(context.Background(), tenant)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestIsTransient(t *testing.T) {
+	wrap := func(err error) error {
+		return &url.Error{Op: "Post", URL: "https://twisp.example.com/financial/v1/graphql", Err: err}
+	}
+	dial := func(err error) error { return wrap(&net.OpError{Op: "dial", Net: "tcp", Err: err}) }
+
+	for name, tc := range map[string]struct {
+		err  error
+		want bool
+	}{
+		"connection refused":  {dial(os.NewSyscallError("connect", syscall.ECONNREFUSED)), true},
+		"connection reset":    {wrap(&net.OpError{Op: "read", Err: syscall.ECONNRESET}), true},
+		"dns temporary":       {dial(&net.DNSError{Err: "server misbehaving", Name: "twisp.example.com", IsTemporary: true}), true},
+		"dns timeout":         {dial(&net.DNSError{Err: "i/o timeout", Name: "twisp.example.com", IsTimeout: true}), true},
+		"dns no such host":    {dial(&net.DNSError{Err: "no such host", Name: "twisp.example.com", IsNotFound: true}), false},
+		"unknown authority":   {wrap(&tls.CertificateVerificationError{Err: x509.UnknownAuthorityError{}}), false},
+		"hostname mismatch":   {wrap(x509.HostnameError{Certificate: &x509.Certificate{}, Host: "twisp.example.com"}), false},
+		"expired certificate": {wrap(x509.CertificateInvalidError{Reason: x509.Expired}), false},
+		"context deadline":    {wrap(context.DeadlineExceeded), false},
+		"plain error":         {errors.New("boom"), false},
+	} {
+		require.Equal(t, tc.want, isTransient(tc.err), name)
+	}
+
+	t.Run("TLSHandshakeTimeout", func(t *testing.T) {
+		// Accept connections but never speak TLS.
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		t.Cleanup(func() { ln.Close() })
+		go func() {
+			for {
+				conn, err := ln.Accept()
+				if err != nil {
+					return
+				}
+				t.Cleanup(func() { conn.Close() })
+			}
+		}()
+		client := &http.Client{Transport: &http.Transport{TLSHandshakeTimeout: 50 * time.Millisecond}}
+		_, err = client.Get("https://" + ln.Addr().String())
+		require.Error(t, err)
+		require.True(t, isTransient(err), "%v", err)
+	})
+
+	t.Run("UntrustedCertificate", func(t *testing.T) {
+		srv := httptest.NewTLSServer(http.NotFoundHandler())
+		t.Cleanup(srv.Close)
+		_, err := http.Get(srv.URL)
+		require.Error(t, err)
+		require.False(t, isTransient(err), "%v", err)
+	})
+}
+
+func TestWithInterceptor(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": {"entries": {"nodes": []}}}`)
+	}))
+	t.Cleanup(srv.Close)
+
+	var events []string
+	record := func(name string) Interceptor {
+		return func(next http.RoundTripper) http.RoundTripper {
+			return roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				// Built-in transports further in add the headers.
+				events = append(events, fmt.Sprintf("%s before (tenant %q)", name, req.Header.Get(TenantHeader)))
+				resp, err := next.RoundTrip(req)
+				events = append(events, name+" after")
+				return resp, err
+			})
+		}
+	}
+
+	client := (&TwispContainer{GraphQLEndpoint: srv.URL}).NewGraphQLClient(
+		http.Header{TenantHeader: []string{"t1"}},
+		WithInterceptor(record("outer")),
+		WithInterceptor(record("inner")),
+	)
+	_, err := ActivityQuery(context.Background(), client, nil, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		`outer before (tenant "")`,
+		`inner before (tenant "")`,
+		"inner after",
+		"outer after",
+	}, events)
+
+	// Without interceptors the default stack is unchanged.
+	rt := newHTTPClient(nil, clientConfig{}).Transport.(*idempotencyTransport).base.(*retryTransport)
+	require.IsType(t, &headerTransport{}, rt.base)
+}
+
+func TestNewTestClient(t *testing.T) {
+	var got *http.Request
+	client := NewTestClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		got = req
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {"application/json"}},
+			Body: io.NopCloser(strings.NewReader(`{"data": {"entries": {"nodes": [
+				{"metadata": {"effective": "2026-01-15"}, "amount": {"units": "1.00"}, "transaction": {"metadata": {}, "entries": {"nodes": []}}}
+			]}}}`)),
+			Request: req,
+		}, nil
+	}))
+
+	resp, err := ActivityQuery(context.Background(), client, Ptr(journalID.String()), Ptr(account1ID.String()), Ptr("2026-01"))
+	require.NoError(t, err)
+	require.Len(t, resp.Entries.Nodes, 1)
+	require.Equal(t, Decimal("1.00"), resp.Entries.Nodes[0].Amount.Units)
+	require.Equal(t, testEndpoint, got.URL.String())
+
+	client = NewTestClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			Body:       io.NopCloser(strings.NewReader(`{"errors": [{"message": "unavailable"}]}`)),
+			Request:    req,
+		}, nil
+	}))
+	_, err = ActivityQuery(context.Background(), client, nil, nil, nil)
+	require.Equal(t, http.StatusServiceUnavailable, TwispErrorFrom(err).StatusCode)
+}
+
+func TestWithSingleFlight(t *testing.T) {
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		time.Sleep(200 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"data": {"entries": {"nodes": []}, "postTransaction": {"transactionId": "`+uuid.Nil.String()+`"}}}`)
+	}))
+	t.Cleanup(srv.Close)
+
+	tc := &TwispContainer{GraphQLEndpoint: srv.URL}
+	client := tc.NewGraphQLClient(tenantHeader(), WithSingleFlight())
+	ctx := context.Background()
+
+	const n = 10
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for range n {
+		wg.Go(func() {
+			_, err := ActivityQuery(ctx, client, Ptr(journalID.String()), Ptr(account1ID.String()), Ptr("2026-01"))
+			errs <- err
+		})
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	require.Equal(t, int32(1), hits.Load(), "identical queries should share one round trip")
+
+	// Mutations are never coalesced.
+	hits.Store(0)
+	txID := uuid.New()
+	for range 3 {
+		wg.Go(func() {
+			_, err := PostTransaction(ctx, client, txID, NewDate(2026, time.January, 1))
+			assert.NoError(t, err)
+		})
+	}
+	wg.Wait()
+	require.Equal(t, int32(3), hits.Load())
+}
+
+func TestOperationType(t *testing.T) {
+	require.Equal(t, "query", operationType(ActivityQuery_Operation))
+	require.Equal(t, "mutation", operationType(PostTransaction_Operation))
+	require.Equal(t, "query", operationType("{ journal { name } }"))
+	require.Equal(t, "mutation", operationType("# comment\n  mutation X { a }"))
+}
+
+func TestWithWaitStrategy(t *testing.T) {
+	var cfg twispConfig
+	req := containerRequest(cfg)
+	all, ok := req.WaitingFor.(*wait.MultiStrategy)
+	require.True(t, ok, "default wait strategy should combine checks")
+	require.Len(t, all.Strategies, 2)
+	require.IsType(t, &wait.HTTPStrategy{}, all.Strategies[0])
+	require.IsType(t, &wait.LogStrategy{}, all.Strategies[1])
+
+	custom := wait.ForLog("custom")
+	WithWaitStrategy(custom)(&cfg)
+	require.Same(t, custom, containerRequest(cfg).WaitingFor)
+}
+
+func TestWithHealthCheck(t *testing.T) {
+	probe := func(cfg twispConfig) *wait.HTTPStrategy {
+		all := containerRequest(cfg).WaitingFor.(*wait.MultiStrategy)
+		return all.Strategies[0].(*wait.HTTPStrategy)
+	}
+
+	var cfg twispConfig
+	require.Equal(t, "/healthcheck", probe(cfg).Path)
+	require.Equal(t, nat.Port("8080/tcp"), probe(cfg).Port)
+
+	WithHealthCheck("9090", "/v2/health")(&cfg)
+	require.Equal(t, "/v2/health", probe(cfg).Path)
+	require.Equal(t, nat.Port("9090/tcp"), probe(cfg).Port)
+	require.Contains(t, containerRequest(cfg).ExposedPorts, "9090/tcp")
+
+	WithHealthCheck("8081/tcp", "/health")(&cfg)
+	require.Equal(t, nat.Port("8081/tcp"), probe(cfg).Port)
+	require.Len(t, containerRequest(cfg).ExposedPorts, 3)
+}
+
+func TestWithLabels(t *testing.T) {
+	var cfg twispConfig
+	WithLabels(map[string]string{"ci.job": "1234"})(&cfg)
+	req := containerRequest(cfg)
+	require.Equal(t, "true", req.Labels[LabelPackage])
+	require.Equal(t, filepath.Base(os.Args[0]), req.Labels[LabelTestBinary])
+	require.Equal(t, "1234", req.Labels["ci.job"])
+
+	f := labelFilters(map[string]string{"ci.job": "1234"})
+	require.True(t, f.ExactMatch("label", "ci.job=1234"))
+	require.Equal(t, []string{"ci.job=1234"}, f.Get("label"))
+
+	f = labelFilters(nil)
+	require.Equal(t, []string{LabelPackage}, f.Get("label"))
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+// tenantHeader returns headers that run requests as a fresh tenant.
+func tenantHeader() http.Header {
+	return http.Header{TenantHeader: {uuid.NewString()}}
+}
+
 func Ptr[T any](t T) *T {
 	return &t
 }
@@ -359,3 +877,370 @@ func Must[T any](t T, err error) T {
 	}
 	return t
 }
+
+// liveContainer is the Twisp container the package's live tests share
+// through Shared. The first newLiveClient starts it and TestMain stops it.
+var liveContainer struct {
+	once    sync.Once
+	err     error
+	started *TwispContainer
+}
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	if tc := liveContainer.started; tc != nil && !tc.KeepAlive {
+		if err := tc.Terminate(context.Background()); err != nil {
+			fmt.Fprintf(os.Stderr, "terminating shared Twisp container: %v\n", err)
+		}
+	}
+	os.Exit(code)
+}
+
+// newLiveClient returns a context bounded to five minutes and a client for
+// a fresh tenant of the shared Twisp container, starting the container on
+// first use. The tenant keeps the test's records apart from every other
+// test's, so tests can use the well-known IDs freely.
+func newLiveClient(t *testing.T) (context.Context, graphql.Client) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	t.Cleanup(cancel)
+	liveContainer.once.Do(func() {
+		if shared.Load() != nil {
+			return
+		}
+		tc, err := StartTwisp(ctx)
+		if err != nil {
+			liveContainer.err = err
+			return
+		}
+		liveContainer.started = tc
+		shared.Store(tc)
+	})
+	require.NoError(t, liveContainer.err, "StartTwisp")
+	return ctx, Shared(t).NewGraphQLClient(tenantHeader())
+}
+
+func TestWithStartupProgress(t *testing.T) {
+	var cfg twispConfig
+	require.Empty(t, containerRequest(cfg).LifecycleHooks)
+
+	var stages []StartupStage
+	WithStartupProgress(func(e StartupEvent) {
+		require.False(t, e.Time.IsZero())
+		stages = append(stages, e.Stage)
+	})(&cfg)
+	hooks := containerRequest(cfg).LifecycleHooks
+	require.Len(t, hooks, 1)
+
+	ctx := context.Background()
+	require.NoError(t, hooks[0].PreCreates[0](ctx, testcontainers.ContainerRequest{}))
+	require.NoError(t, hooks[0].PostStarts[0](ctx, nil))
+	require.NoError(t, hooks[0].PostReadies[0](ctx, nil))
+	require.Equal(t, []StartupStage{StartupPullComplete, StartupContainerStarted, StartupHealthy}, stages)
+}
+
+func TestStartupProgressLive(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+
+	var stages []StartupStage
+	tc, err := StartTwisp(ctx, WithStartupProgress(func(e StartupEvent) {
+		stages = append(stages, e.Stage)
+	}))
+	require.NoError(t, err, "StartTwisp")
+	t.Cleanup(func() {
+		tc.Cleanup(ctx, t)
+		cancel()
+	})
+
+	if os.Getenv("TWISP_ENDPOINT") != "" {
+		require.Empty(t, stages)
+		return
+	}
+	require.Equal(t, []StartupStage{
+		StartupPullStart,
+		StartupPullComplete,
+		StartupContainerStarted,
+		StartupHealthy,
+	}, stages)
+}
+
+func TestWithImage(t *testing.T) {
+	var cfg twispConfig
+	require.Equal(t, DefaultImage, containerRequest(cfg).Image)
+
+	t.Setenv("EFF_TEST_TWISP_IMAGE", "")
+	WithImageFromEnv("EFF_TEST_TWISP_IMAGE")(&cfg)
+	require.Equal(t, DefaultImage, containerRequest(cfg).Image)
+
+	t.Setenv("EFF_TEST_TWISP_IMAGE", "public.ecr.aws/twisp/local:1.2.3")
+	WithImageFromEnv("EFF_TEST_TWISP_IMAGE")(&cfg)
+	require.Equal(t, "public.ecr.aws/twisp/local:1.2.3", containerRequest(cfg).Image)
+
+	WithImage("registry.example.com/twisp:pinned")(&cfg)
+	require.Equal(t, "registry.example.com/twisp:pinned", containerRequest(cfg).Image)
+}
+
+func TestWithFixedHostPort(t *testing.T) {
+	var cfg twispConfig
+	require.Nil(t, containerRequest(cfg).HostConfigModifier)
+
+	WithFixedHostPort("8080", "18080")(&cfg)
+	WithFixedHostPort("9000/tcp", "19000")(&cfg)
+	req := containerRequest(cfg)
+	require.Contains(t, req.ExposedPorts, "9000/tcp")
+
+	var hc container.HostConfig
+	req.HostConfigModifier(&hc)
+	require.Equal(t, nat.PortMap{
+		"8080/tcp": {{HostPort: "18080"}},
+		"9000/tcp": {{HostPort: "19000"}},
+	}, hc.PortBindings)
+}
+
+func TestWithFixedHostPortLive(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+
+	// Borrow a free port from the kernel; it is released before Docker binds it.
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	hostPort := strconv.Itoa(ln.Addr().(*net.TCPAddr).Port)
+	require.NoError(t, ln.Close())
+
+	tc, err := StartTwisp(ctx, WithFixedHostPort("8080", hostPort))
+	require.NoError(t, err, "StartTwisp")
+	t.Cleanup(func() {
+		tc.Cleanup(ctx, t)
+		cancel()
+	})
+
+	u, err := url.Parse(tc.GraphQLEndpoint)
+	require.NoError(t, err)
+	require.Equal(t, hostPort, u.Port())
+
+	mapped, err := tc.MappedPort(ctx, "8080/tcp")
+	require.NoError(t, err)
+	require.Equal(t, hostPort, mapped.Port())
+}
+
+func TestWithExposedPorts(t *testing.T) {
+	var cfg twispConfig
+	WithExposedPorts("9090", "8080/tcp", "9090/tcp", "5000/udp")(&cfg)
+	require.Equal(t, []string{"3000/tcp", "8080/tcp", "8081/tcp", "9090/tcp", "5000/udp"}, containerRequest(cfg).ExposedPorts)
+
+	_, err := (&TwispContainer{GraphQLEndpoint: "http://localhost:8080"}).EndpointFor(context.Background(), "9090")
+	require.Error(t, err)
+}
+
+func TestWithExposedPortsLive(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+
+	tc, err := StartTwisp(ctx, WithExposedPorts("9090"))
+	require.NoError(t, err, "StartTwisp")
+	t.Cleanup(func() {
+		tc.Cleanup(ctx, t)
+		cancel()
+	})
+
+	extra, err := tc.EndpointFor(ctx, "9090")
+	require.NoError(t, err)
+	u, err := url.Parse(extra)
+	require.NoError(t, err)
+	require.NotEmpty(t, u.Port())
+
+	// The GraphQL port resolves to the same address GraphQLEndpoint uses.
+	graphql, err := tc.EndpointFor(ctx, "8080/tcp")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(tc.GraphQLEndpoint, graphql+"/"), "%s vs %s", graphql, tc.GraphQLEndpoint)
+	require.NotEqual(t, graphql, extra)
+}
+
+func TestWithReuse(t *testing.T) {
+	var cfg twispConfig
+	require.Empty(t, containerRequest(cfg).Name)
+	WithReuse("eff-shared")(&cfg)
+	require.Equal(t, "eff-shared", containerRequest(cfg).Name)
+
+	require.Same(t, reuseLock("eff-shared"), reuseLock("eff-shared"))
+	require.NotSame(t, reuseLock("eff-shared"), reuseLock("eff-other"))
+}
+
+func TestWithInitScriptEndpoint(t *testing.T) {
+	var tenants []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tenants = append(tenants, r.Header.Get(TenantHeader))
+		fmt.Fprint(w, `{"data": {"journal": null}}`)
+	}))
+	t.Cleanup(srv.Close)
+	t.Setenv("TWISP_ENDPOINT", srv.URL)
+
+	var order []int
+	script := func(i int) func(context.Context, graphql.Client) error {
+		return func(ctx context.Context, client graphql.Client) error {
+			order = append(order, i)
+			_, err := GetJournal(ctx, client, journalID)
+			require.ErrorIs(t, err, ErrNotFound)
+			return nil
+		}
+	}
+	tc, err := StartTwisp(context.Background(), WithInitScript(script(1)), WithInitScript(script(2)))
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2}, order)
+	require.NotEmpty(t, tc.InitTenant)
+	require.Equal(t, []string{tc.InitTenant, tc.InitTenant}, tenants)
+
+	boom := errors.New("boom")
+	_, err = StartTwisp(context.Background(), WithInitScript(script(1)), WithInitScript(func(context.Context, graphql.Client) error {
+		return boom
+	}))
+	require.ErrorIs(t, err, boom)
+	require.EqualError(t, err, "running init script 1: boom")
+
+	tc, err = StartTwisp(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, tc.InitTenant)
+}
+
+func TestWithInitScript(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+
+	tc, err := StartTwisp(ctx, WithInitScript(func(ctx context.Context, client graphql.Client) error {
+		_, err := Setup(ctx, client, journalID, tranCodeID, account1ID, account2ID)
+		return err
+	}))
+	require.NoError(t, err, "StartTwisp")
+	t.Cleanup(func() {
+		tc.Cleanup(ctx, t)
+		cancel()
+	})
+
+	client := tc.NewGraphQLClient(http.Header{TenantHeader: []string{tc.InitTenant}})
+	j, err := GetJournal(ctx, client, journalID)
+	require.NoError(t, err)
+	require.Equal(t, journalID, j.JournalId)
+
+	// Other tenants don't see the init script's records.
+	_, err = GetJournal(ctx, tc.NewGraphQLClient(tenantHeader()), journalID)
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestWithReuseConcurrent(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	t.Cleanup(cancel)
+
+	name := "eff-reuse-" + uuid.NewString()
+	const starts = 8
+	containers := make([]*TwispContainer, starts)
+	errs := make([]error, starts)
+	var wg sync.WaitGroup
+	for i := range starts {
+		wg.Go(func() {
+			containers[i], errs[i] = StartTwisp(ctx, WithReuse(name), WithKeepAlive())
+		})
+	}
+	wg.Wait()
+	require.NoError(t, errors.Join(errs...), "StartTwisp")
+	if containers[0].Container == nil {
+		t.Skip("TWISP_ENDPOINT is set; no container to share")
+	}
+	t.Cleanup(func() {
+		if err := containers[0].Terminate(ctx); err != nil {
+			t.Logf("terminate container: %v", err)
+		}
+	})
+
+	id := containers[0].GetContainerID()
+	for _, tc := range containers[1:] {
+		require.Equal(t, id, tc.GetContainerID())
+		require.Equal(t, containers[0].GraphQLEndpoint, tc.GraphQLEndpoint)
+	}
+}
+
+func TestUseDockerHost(t *testing.T) {
+	dockerHost.Lock()
+	resolved, host := dockerHost.resolved, dockerHost.host
+	dockerHost.resolved = false
+	dockerHost.Unlock()
+	t.Cleanup(func() {
+		dockerHost.Lock()
+		dockerHost.resolved, dockerHost.host = resolved, host
+		dockerHost.Unlock()
+	})
+	t.Setenv("DOCKER_HOST", "")
+
+	require.NoError(t, useDockerHost("tcp://dind:2375"))
+	require.Empty(t, os.Getenv("DOCKER_HOST"), "the environment is left alone")
+	require.NoError(t, useDockerHost("tcp://dind:2375"))
+	require.NoError(t, useDockerHost(""))
+	require.ErrorContains(t, useDockerHost("tcp://other:2375"), `already uses "tcp://dind:2375"`)
+}
+
+// TestWithDockerHostLive needs a reachable daemon named by
+// EFF_TEST_DOCKER_HOST, e.g. a docker-in-docker service in CI.
+func TestWithDockerHostLive(t *testing.T) {
+	host := os.Getenv("EFF_TEST_DOCKER_HOST")
+	if host == "" {
+		t.Skip("EFF_TEST_DOCKER_HOST is not set")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	env, hadEnv := os.LookupEnv("DOCKER_HOST")
+
+	tc, err := StartTwisp(ctx, WithDockerHost(host))
+	require.NoError(t, err, "StartTwisp")
+	gotEnv, gotHadEnv := os.LookupEnv("DOCKER_HOST")
+	require.Equal(t, [2]any{env, hadEnv}, [2]any{gotEnv, gotHadEnv}, "DOCKER_HOST is restored")
+	t.Cleanup(func() {
+		tc.Cleanup(ctx, t)
+		cancel()
+	})
+
+	cli, err := testcontainers.NewDockerClientWithOpts(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() { cli.Close() })
+	require.Equal(t, host, cli.DaemonHost())
+
+	_, err = cli.ContainerInspect(ctx, tc.GetContainerID())
+	require.NoError(t, err, "container should run on %s", host)
+}
+
+func TestWaitForSchema(t *testing.T) {
+	defer func(d time.Duration) { schemaPollInterval = d }(schemaPollInterval)
+	schemaPollInterval = time.Millisecond
+
+	var calls int
+	stub := clientFunc(func(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+		calls++
+		if calls < 3 {
+			return errors.New("returned error 400 Bad Request: schema not loaded")
+		}
+		return json.Unmarshal([]byte(`{"__schema": {"queryType": {"name": "Query"}}}`), resp.Data)
+	})
+	require.NoError(t, WaitForSchema(context.Background(), stub))
+	require.Equal(t, 3, calls)
+
+	failing := clientFunc(func(context.Context, *graphql.Request, *graphql.Response) error {
+		return errors.New("schema not loaded")
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := WaitForSchema(ctx, failing)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.ErrorContains(t, err, "schema not loaded")
+}
+
+func TestStartTwispSchemaReady(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+
+	tc, err := StartTwisp(ctx)
+	require.NoError(t, err, "StartTwisp")
+	t.Cleanup(func() {
+		tc.Cleanup(ctx, t)
+		cancel()
+	})
+
+	// No sleeps or retries: the schema must already be loaded.
+	client := tc.NewGraphQLClient(http.Header{
+		"x-twisp-account-id": []string{uuid.New().String()},
+	}, WithNoRetry())
+	_, err = CreateActivityIndex(ctx, client)
+	require.NoError(t, err)
+}